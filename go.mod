@@ -0,0 +1,3 @@
+module family-budget-service
+
+go 1.21
@@ -0,0 +1,129 @@
+// Package internal wires configuration, repositories, services and
+// background components into a runnable application.
+package internal
+
+import (
+	"context"
+	"net/http"
+
+	"family-budget-service/internal/config"
+	"family-budget-service/internal/metrics"
+	"family-budget-service/internal/notification"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/scheduler"
+	"family-budget-service/internal/services"
+	"family-budget-service/internal/web"
+)
+
+// Application bundles the wired service graph.
+type Application struct {
+	Config *config.Config
+
+	// Metrics serves the Prometheus exposition; mount it on /metrics.
+	Metrics *metrics.Registry
+
+	UserService        *services.UserService
+	TransactionService *services.TransactionService
+	ReportService      *services.ReportService
+	BudgetService      *services.BudgetService
+	PreferencesService *services.PreferencesService
+	AuditService       *services.AuditService
+	AttachmentService  *services.AttachmentService
+	SavingsService     *services.SavingsService
+	AccountService     *services.AccountService
+	DashboardService   *services.DashboardService
+	AdminService       *services.AdminService
+
+	scheduler *scheduler.Scheduler
+	handler   http.Handler
+	server    *web.Server
+}
+
+// SetHandler installs the HTTP handler Run serves. Without one Run only
+// drives the background components.
+func (a *Application) SetHandler(handler http.Handler) {
+	a.handler = handler
+}
+
+// ServerAddr is the bound listen address once Run has started the
+// server; empty before that. It exists mainly so tests can dial a
+// server started on port 0.
+func (a *Application) ServerAddr() string {
+	if a.server == nil {
+		return ""
+	}
+	return a.server.Addr()
+}
+
+// NewApplication wires the application with in-memory storage. Database
+// backends plug in here once configured.
+func NewApplication(cfg *config.Config) *Application {
+	registry := metrics.NewRegistry()
+	queryDuration := registry.Histogram(
+		"repository_query_duration_seconds", "Latency of repository calls, by operation.", "operation", nil)
+
+	users := memory.NewUserRepository()
+	transactions := metrics.InstrumentTransactionRepository(memory.NewTransactionRepository(), queryDuration)
+	categories := memory.NewCategoryRepository()
+	budgets := memory.NewBudgetRepository()
+	reports := memory.NewReportRepository()
+	schedules := memory.NewScheduleRepository()
+	alerts := memory.NewAlertRepository()
+	prefs := memory.NewPreferencesRepository()
+	families := memory.NewFamilyRepository()
+
+	reportService := services.NewReportService(reports, transactions, budgets, schedules, users)
+	reportService.SetPreferencesRepository(prefs)
+	reportService.SetCategoryRepository(categories)
+	reportService.SetMetrics(registry)
+	app := &Application{
+		Config:             cfg,
+		Metrics:            registry,
+		UserService:        services.NewUserService(users, reports, ""),
+		TransactionService: services.NewTransactionService(transactions, users),
+		ReportService:      reportService,
+		BudgetService:      services.NewBudgetService(budgets, alerts, categories),
+		PreferencesService: services.NewPreferencesService(prefs, transactions),
+		AuditService:       services.NewAuditService(memory.NewAuditRepository(), prefs),
+		SavingsService:     services.NewSavingsService(memory.NewSavingsRepository(), transactions),
+		DashboardService:   services.NewDashboardService(transactions),
+		AdminService:       services.NewAdminService(families, users, transactions),
+	}
+	app.AccountService = services.NewAccountService(memory.NewAccountRepository(), app.TransactionService)
+	app.AttachmentService = services.NewAttachmentService(
+		memory.NewAttachmentRepository(), transactions, services.NewDiskStorage(cfg.Web.AttachmentDir))
+	app.TransactionService.SetCurrencyConversion(
+		families, services.NewStaticRateLookup(nil))
+	app.TransactionService.SetBulkCategorization(categories, budgets)
+	app.TransactionService.SetAttachmentCleaner(app.AttachmentService)
+	app.DashboardService.SetPreferencesRepository(prefs)
+	app.BudgetService.SetTransactionRepository(transactions)
+	app.scheduler = scheduler.New(schedules, reportService, cfg.Web.SchedulerInterval, nil)
+	app.scheduler.SetRecurringMaterializer(
+		services.NewRecurringService(memory.NewRecurringRepository(), app.TransactionService))
+	app.scheduler.SetRetentionPurger(app.AuditService)
+	app.scheduler.SetTrashPurger(app.TransactionService)
+	app.scheduler.SetSummarySender(services.NewMonthlySummaryService(
+		families, users, prefs, transactions, budgets, &notification.LogSender{}))
+	return app
+}
+
+// Run starts the HTTP server (when a handler is set) and the background
+// components, then blocks until ctx is canceled or a termination signal
+// arrives. Shutdown first stops accepting connections and drains
+// in-flight requests within the configured timeout, then stops the
+// scheduler. Storage is in-memory, so there is no database to close.
+func (a *Application) Run(ctx context.Context) error {
+	a.scheduler.Start(ctx)
+	defer a.scheduler.Stop()
+
+	if a.handler == nil {
+		<-ctx.Done()
+		return nil
+	}
+	a.server = web.NewServer(a.Config.Web, a.handler)
+	if err := a.server.Start(); err != nil {
+		return err
+	}
+	return a.server.WaitForShutdown(ctx)
+}
@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/config"
+)
+
+func testConfig(t *testing.T) *config.Config {
+	t.Helper()
+	return &config.Config{
+		Web: config.WebConfig{
+			Host:              "127.0.0.1",
+			Port:              "0",
+			SchedulerInterval: time.Hour,
+			AttachmentDir:     t.TempDir(),
+			ShutdownTimeout:   5 * time.Second,
+		},
+	}
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %s", what)
+}
+
+func TestRun_GracefulShutdownDrainsInFlightRequests(t *testing.T) {
+	app := NewApplication(testConfig(t))
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, _ *http.Request) {
+		close(entered)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/ping", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	app.SetHandler(mux)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	runDone := make(chan error, 1)
+	go func() { runDone <- app.Run(ctx) }()
+	waitFor(t, "server to bind", func() bool { return app.ServerAddr() != "" })
+	base := "http://" + app.ServerAddr()
+
+	inFlight := make(chan error, 1)
+	go func() {
+		resp, err := http.Get(base + "/slow")
+		if err == nil {
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("in-flight request status = %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+			resp.Body.Close()
+		}
+		inFlight <- err
+	}()
+	<-entered
+
+	// Trigger shutdown while /slow is still being served. The listener
+	// closes promptly, so new requests start failing.
+	cancel()
+	waitFor(t, "new connections to be refused", func() bool {
+		resp, err := http.Get(base + "/ping")
+		if err == nil {
+			resp.Body.Close()
+		}
+		return err != nil
+	})
+
+	// The request that was already in flight still completes.
+	close(release)
+	if err := <-inFlight; err != nil {
+		t.Fatalf("in-flight request failed: %v", err)
+	}
+
+	select {
+	case err := <-runDone:
+		if err != nil {
+			t.Fatalf("Run() = %v, want nil", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after shutdown")
+	}
+}
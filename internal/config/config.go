@@ -0,0 +1,99 @@
+// Package config loads service configuration from environment variables
+// with development-friendly defaults.
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// WebConfig configures the HTTP server and background jobs.
+type WebConfig struct {
+	Host string
+	Port string
+	// SchedulerInterval is how often the background scheduler polls for
+	// due scheduled reports.
+	SchedulerInterval time.Duration
+	// MaxBodySize caps write-request bodies in bytes; zero falls back to
+	// the handlers' default. MaxImportBodySize is the separate, larger
+	// cap for the import and upload routes.
+	MaxBodySize       int64
+	MaxImportBodySize int64
+	// AttachmentDir is where receipt uploads are stored on disk.
+	AttachmentDir string
+	// RateLimitAttempts and RateLimitWindow throttle auth endpoints per
+	// client; zero values fall back to the handlers' defaults.
+	RateLimitAttempts int
+	RateLimitWindow   time.Duration
+	// AdminToken authenticates the operator analytics routes; empty
+	// leaves them disabled.
+	AdminToken string
+	// ShutdownTimeout is how long shutdown waits for in-flight requests
+	// before the process exits; zero falls back to the server's default.
+	ShutdownTimeout time.Duration
+	// Connection timeouts for the HTTP server; zero values fall back to
+	// the server's defaults.
+	ReadTimeout       time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+}
+
+// Config is the root service configuration.
+type Config struct {
+	Web WebConfig
+}
+
+// Load reads configuration from the environment.
+func Load() *Config {
+	return &Config{
+		Web: WebConfig{
+			Host:              getEnv("SERVER_HOST", "0.0.0.0"),
+			Port:              getEnv("SERVER_PORT", "8080"),
+			SchedulerInterval: getEnvDuration("SCHEDULER_INTERVAL", time.Minute),
+			MaxBodySize:       getEnvInt64("MAX_BODY_SIZE", 0),
+			MaxImportBodySize: getEnvInt64("MAX_IMPORT_BODY_SIZE", 0),
+			AttachmentDir:     getEnv("ATTACHMENT_DIR", "data/attachments"),
+			RateLimitAttempts: int(getEnvInt64("RATE_LIMIT_ATTEMPTS", 0)),
+			RateLimitWindow:   getEnvDuration("RATE_LIMIT_WINDOW", 0),
+			AdminToken:        getEnv("ADMIN_TOKEN", ""),
+			ShutdownTimeout:   getEnvDuration("SHUTDOWN_TIMEOUT", 0),
+			ReadTimeout:       getEnvDuration("SERVER_READ_TIMEOUT", 0),
+			WriteTimeout:      getEnvDuration("SERVER_WRITE_TIMEOUT", 0),
+			IdleTimeout:       getEnvDuration("SERVER_IDLE_TIMEOUT", 0),
+			ReadHeaderTimeout: getEnvDuration("SERVER_READ_HEADER_TIMEOUT", 0),
+		},
+	}
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getEnvInt64(key string, fallback int64) int64 {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n < 0 {
+		return fallback
+	}
+	return n
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return fallback
+	}
+	return d
+}
@@ -0,0 +1,60 @@
+// Package account holds the account (wallet) domain model. Accounts
+// split the family pool into the places money actually lives — checking,
+// cash, credit cards — so balances can be tracked per place.
+package account
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrNotFound is returned when an account does not exist.
+var ErrNotFound = errors.New("account not found")
+
+// Type classifies what kind of account holds the money.
+type Type string
+
+const (
+	TypeChecking   Type = "checking"
+	TypeSavings    Type = "savings"
+	TypeCash       Type = "cash"
+	TypeCreditCard Type = "credit_card"
+)
+
+// ValidType reports whether t is one of the known account types.
+func ValidType(t Type) bool {
+	switch t {
+	case TypeChecking, TypeSavings, TypeCash, TypeCreditCard:
+		return true
+	}
+	return false
+}
+
+// Account is one place a family keeps money.
+type Account struct {
+	ID       uuid.UUID
+	FamilyID uuid.UUID
+	Name     string
+	Type     Type
+	// OpeningBalance is the balance at the moment the account was added;
+	// the current balance is derived from it plus the account's
+	// transactions.
+	OpeningBalance float64
+	// Currency is informational; transaction amounts are already stored
+	// in the family base currency.
+	Currency  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Repository is the persistence contract for accounts.
+type Repository interface {
+	Create(ctx context.Context, a *Account) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Account, error)
+	GetByFamilyID(ctx context.Context, familyID uuid.UUID) ([]*Account, error)
+	Update(ctx context.Context, a *Account) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
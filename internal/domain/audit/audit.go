@@ -0,0 +1,39 @@
+// Package audit holds the audit log domain model. Entries record who
+// changed what, for accountability inside a family; they are append-only
+// and trimmed by a retention purge rather than edited.
+package audit
+
+import (
+	"context"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// Entry is one recorded action.
+type Entry struct {
+	ID       uuid.UUID
+	FamilyID uuid.UUID
+	UserID   uuid.UUID
+	// Action is a short verb like "create", "update" or "delete".
+	Action string
+	// Entity names the kind of record acted on, e.g. "transaction".
+	Entity string
+	// EntityID identifies the record acted on; nil when the action is
+	// not about a single record.
+	EntityID  *uuid.UUID
+	Details   string
+	CreatedAt time.Time
+}
+
+// Repository is the persistence contract for audit entries.
+type Repository interface {
+	Create(ctx context.Context, e *Entry) error
+	GetByFamilyID(ctx context.Context, familyID uuid.UUID) ([]*Entry, error)
+	// FamilyIDs lists every family with at least one entry, so the purge
+	// can apply per-family retention windows.
+	FamilyIDs(ctx context.Context) ([]uuid.UUID, error)
+	// DeleteOlderThan removes a family's entries created before cutoff
+	// and reports how many were removed.
+	DeleteOlderThan(ctx context.Context, familyID uuid.UUID, cutoff time.Time) (int, error)
+}
@@ -0,0 +1,38 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// Alert errors shared by repository implementations and the service.
+var (
+	ErrAlertNotFound           = errors.New("budget alert not found")
+	ErrDuplicateAlertThreshold = errors.New("budget already has an alert at this threshold")
+)
+
+// Alert is a user-configured notification threshold on a budget. When
+// spending crosses ThresholdPercent of the cap the alert trips and (if
+// NotifyEnabled) a notification goes out.
+type Alert struct {
+	ID               uuid.UUID
+	BudgetID         uuid.UUID
+	ThresholdPercent float64
+	NotifyEnabled    bool
+	Triggered        bool
+	TriggeredAt      *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// AlertRepository is the persistence contract for budget alerts.
+type AlertRepository interface {
+	Create(ctx context.Context, a *Alert) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Alert, error)
+	GetByBudgetID(ctx context.Context, budgetID uuid.UUID) ([]*Alert, error)
+	Update(ctx context.Context, a *Alert) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
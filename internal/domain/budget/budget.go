@@ -0,0 +1,95 @@
+// Package budget holds the budget domain model. A budget caps spending
+// for a category (or the whole family when CategoryID is nil) over a
+// date window, and tracks how much of the cap is already spent.
+package budget
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// Period is the cadence a budget covers.
+type Period string
+
+const (
+	PeriodWeekly  Period = "weekly"
+	PeriodMonthly Period = "monthly"
+	PeriodYearly  Period = "yearly"
+	PeriodCustom  Period = "custom"
+)
+
+// ErrNotFound is returned when a budget does not exist.
+var ErrNotFound = errors.New("budget not found")
+
+// ErrPeriodOverlap rejects a budget whose date range overlaps another
+// active budget with the same scope (same category, or both
+// family-wide).
+var ErrPeriodOverlap = errors.New("budget period overlap")
+
+// ErrVersionConflict rejects an update whose version is stale: another
+// writer advanced the budget since the client loaded it.
+var ErrVersionConflict = errors.New("budget was modified concurrently")
+
+// Budget caps spending for a family (and optionally a single category)
+// over a date window.
+type Budget struct {
+	ID         uuid.UUID
+	Name       string
+	Amount     float64
+	Spent      float64
+	Period     Period
+	CategoryID *uuid.UUID // nil means family-wide
+	FamilyID   uuid.UUID
+	StartDate  time.Time
+	EndDate    time.Time
+	IsActive   bool
+	// Rollover opts the budget into carrying its unspent balance into
+	// the next period. RolloverAmount is the balance received from the
+	// prior period; it raises (or, when negative, lowers) the effective
+	// cap without touching the configured Amount.
+	Rollover       bool
+	RolloverAmount float64
+	// Version backs optimistic concurrency: updates must carry the
+	// version they loaded and bump it on success, so two members editing
+	// the same budget cannot silently clobber each other.
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// EffectiveAmount is the cap actually enforced this period: the
+// configured amount plus whatever rolled over from the prior period.
+func (b *Budget) EffectiveAmount() float64 {
+	return b.Amount + b.RolloverAmount
+}
+
+// GetSpentPercentage returns spent as a percentage of the effective cap.
+func (b *Budget) GetSpentPercentage() float64 {
+	if b.EffectiveAmount() <= 0 {
+		return 0
+	}
+	return b.Spent / b.EffectiveAmount() * 100
+}
+
+// GetRemainingAmount returns how much of the effective cap is left.
+func (b *Budget) GetRemainingAmount() float64 {
+	return b.EffectiveAmount() - b.Spent
+}
+
+// IsOverBudget reports whether spending exceeded the effective cap.
+func (b *Budget) IsOverBudget() bool {
+	return b.Spent > b.EffectiveAmount()
+}
+
+// Repository is the persistence contract for budgets.
+type Repository interface {
+	Create(ctx context.Context, b *Budget) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Budget, error)
+	GetByFamilyID(ctx context.Context, familyID uuid.UUID) ([]*Budget, error)
+	GetActiveBudgets(ctx context.Context, familyID uuid.UUID) ([]*Budget, error)
+	Update(ctx context.Context, b *Budget) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
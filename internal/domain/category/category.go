@@ -0,0 +1,51 @@
+// Package category holds the category domain model. Categories classify
+// transactions and come in two kinds matching the transaction types; they
+// may form a shallow hierarchy via ParentID.
+package category
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// Type restricts which transactions a category can classify.
+type Type string
+
+const (
+	TypeIncome  Type = "income"
+	TypeExpense Type = "expense"
+)
+
+// ErrNotFound is returned when a category does not exist.
+var ErrNotFound = errors.New("category not found")
+
+// Category classifies transactions within a family.
+type Category struct {
+	ID       uuid.UUID
+	Name     string
+	Type     Type
+	Color    string // hex color for UI, e.g. "#FF5733"
+	Icon     string // icon identifier for UI
+	ParentID *uuid.UUID
+	FamilyID uuid.UUID
+	IsActive bool
+	// IsAlwaysBudgeted marks categories the family budgets every period.
+	// Copy-previous-period and auto-advance flows carry these forward
+	// automatically; others need an explicit new budget.
+	IsAlwaysBudgeted bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// Repository is the persistence contract for categories.
+type Repository interface {
+	Create(ctx context.Context, c *Category) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Category, error)
+	GetByFamilyID(ctx context.Context, familyID uuid.UUID) ([]*Category, error)
+	GetByType(ctx context.Context, familyID uuid.UUID, categoryType Type) ([]*Category, error)
+	Update(ctx context.Context, c *Category) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,69 @@
+// Package preferences holds per-family tunables that users configure
+// explicitly, as opposed to values derived from their data. They drive
+// the dashboard goal bars and the settings page.
+package preferences
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrNotFound is returned when a family has no stored preferences yet.
+var ErrNotFound = errors.New("preferences not found")
+
+// Preferences are the financial targets of a family. Zero values mean
+// "not set": consumers hide the corresponding goal rather than showing
+// 0% progress against a hardcoded target.
+type Preferences struct {
+	ID       uuid.UUID
+	FamilyID uuid.UUID
+	// MonthlyIncomeGoal is the income the family aims to bring in per
+	// month; zero means no goal configured.
+	MonthlyIncomeGoal float64
+	// MonthlyExpenseCap is the spending ceiling the family tries to stay
+	// under per month; zero means no cap configured.
+	MonthlyExpenseCap float64
+	// Currency overrides the family's default display currency; empty
+	// keeps the currency stored on the family record.
+	Currency string
+	// WeekStartDay is the first day of the week for weekly periods;
+	// 0 is Sunday, 1 is Monday (the default when unset).
+	WeekStartDay int
+	// DashboardPeriod is the period the dashboard opens on, e.g.
+	// "monthly" or "weekly"; empty means the dashboard default.
+	DashboardPeriod string
+	// Timezone is the IANA zone name (e.g. "Europe/Berlin") period
+	// boundaries and daily bucketing are computed in. Transactions stay
+	// stored in UTC. Empty means UTC.
+	Timezone string
+	// ExportFilenameTemplate names report exports using {name}, {type},
+	// {period} and {date} tokens; empty means the service default.
+	ExportFilenameTemplate string
+	// AuditRetentionDays is how long the family's audit log entries are
+	// kept before the purge removes them; zero means the service
+	// default.
+	AuditRetentionDays int
+	// ExcludeReimbursed drops reimbursed expenses from expense report
+	// totals, so money that came back does not inflate net spending.
+	ExcludeReimbursed bool
+	// MemberSpendingLimits caps monthly expense totals per member, keyed
+	// by user ID. Only child accounts are enforced against their cap;
+	// an absent or zero entry means no limit.
+	MemberSpendingLimits map[uuid.UUID]float64
+	// MonthlySummaryOptIns marks the members who asked for the monthly
+	// summary email, keyed by user ID. Absent means opted out.
+	MonthlySummaryOptIns map[uuid.UUID]bool
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// Repository is the persistence contract for family preferences. Each
+// family has at most one preferences record.
+type Repository interface {
+	GetByFamilyID(ctx context.Context, familyID uuid.UUID) (*Preferences, error)
+	Upsert(ctx context.Context, p *Preferences) error
+	Delete(ctx context.Context, familyID uuid.UUID) error
+}
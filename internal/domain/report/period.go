@@ -0,0 +1,76 @@
+package report
+
+import "time"
+
+// PeriodOptions carries per-family preferences affecting how period
+// windows are anchored.
+type PeriodOptions struct {
+	// MonthStartDay is the day of month a monthly period begins on
+	// (payday-aligned budgeting). Zero or one means calendar months.
+	// Days beyond a month's length clamp to its last day.
+	MonthStartDay int
+	// WeekStartDay is the weekday a weekly period begins on, 0 (Sunday)
+	// through 6 (Saturday). Zero keeps the Sunday-start default.
+	WeekStartDay int
+}
+
+// CalculatePeriodDates resolves a period to the concrete window
+// containing now. Custom periods are caller-defined and return now for
+// both bounds.
+func CalculatePeriodDates(period Period, now time.Time, opts PeriodOptions) (start, end time.Time) {
+	switch period {
+	case PeriodDaily:
+		start = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(0, 0, 1)
+	case PeriodWeekly:
+		// Days since the configured week start, wrapping so a Monday
+		// start observed on Sunday reaches back six days.
+		back := (int(now.Weekday()) - opts.WeekStartDay + 7) % 7
+		start = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, -back)
+		return start, start.AddDate(0, 0, 7)
+	case PeriodMonthly:
+		return monthlyPeriodDates(now, opts.MonthStartDay)
+	case PeriodQuarterly:
+		quarterMonth := now.Month() - (now.Month()-1)%3
+		start = time.Date(now.Year(), quarterMonth, 1, 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(0, 3, 0)
+	case PeriodYearly:
+		start = time.Date(now.Year(), 1, 1, 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(1, 0, 0)
+	default:
+		return now, now
+	}
+}
+
+// monthlyPeriodDates anchors the monthly window on startDay. A window
+// runs from startDay of one month up to (exclusive) startDay of the
+// next, so a family paid on the 25th budgets Jan 25 – Feb 25.
+func monthlyPeriodDates(now time.Time, startDay int) (start, end time.Time) {
+	if startDay <= 1 {
+		start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(0, 1, 0)
+	}
+
+	// Month arithmetic goes through the first of the month so clamped
+	// anchors (Jan 31 → Feb 29) never normalize into the wrong month.
+	firstOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	anchor := monthAnchor(now.Year(), now.Month(), startDay, now.Location())
+	if now.Before(anchor) {
+		// Still inside the window that opened last month.
+		prev := firstOfMonth.AddDate(0, -1, 0)
+		anchor = monthAnchor(prev.Year(), prev.Month(), startDay, now.Location())
+	}
+	next := time.Date(anchor.Year(), anchor.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, 1, 0)
+	return anchor, monthAnchor(next.Year(), next.Month(), startDay, now.Location())
+}
+
+// monthAnchor returns startDay within the given month, clamped to the
+// month's last day.
+func monthAnchor(year int, month time.Month, startDay int, loc *time.Location) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, loc).Day()
+	day := startDay
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
@@ -0,0 +1,116 @@
+package report
+
+import (
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalculatePeriodDates_MonthlyCalendarDefault(t *testing.T) {
+	start, end := CalculatePeriodDates(PeriodMonthly, date(2024, 3, 15), PeriodOptions{})
+	if !start.Equal(date(2024, 3, 1)) || !end.Equal(date(2024, 4, 1)) {
+		t.Errorf("window = %v – %v, want Mar 1 – Apr 1", start, end)
+	}
+}
+
+func TestCalculatePeriodDates_MonthlyCustomStartDay(t *testing.T) {
+	tests := []struct {
+		name      string
+		now       time.Time
+		startDay  int
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name: "after payday", now: date(2024, 3, 27), startDay: 25,
+			wantStart: date(2024, 3, 25), wantEnd: date(2024, 4, 25),
+		},
+		{
+			name: "before payday wraps to previous month", now: date(2024, 3, 10), startDay: 25,
+			wantStart: date(2024, 2, 25), wantEnd: date(2024, 3, 25),
+		},
+		{
+			name: "start day clamps in February", now: date(2024, 2, 29), startDay: 31,
+			wantStart: date(2024, 2, 29), wantEnd: date(2024, 3, 31),
+		},
+		{
+			name: "January window ends on clamped February day", now: date(2024, 2, 10), startDay: 31,
+			wantStart: date(2024, 1, 31), wantEnd: date(2024, 2, 29),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := CalculatePeriodDates(PeriodMonthly, tt.now, PeriodOptions{MonthStartDay: tt.startDay})
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("window = %v – %v, want %v – %v", start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestCalculatePeriodDates_WeeklyWeekStart(t *testing.T) {
+	// Wednesday 2024-06-12: a Sunday-start week opened Jun 9, a
+	// Monday-start week opened Jun 10.
+	now := date(2024, 6, 12)
+
+	start, end := CalculatePeriodDates(PeriodWeekly, now, PeriodOptions{})
+	if !start.Equal(date(2024, 6, 9)) || !end.Equal(date(2024, 6, 16)) {
+		t.Errorf("Sunday-start window = %v – %v, want Jun 9 – Jun 16", start, end)
+	}
+
+	start, end = CalculatePeriodDates(PeriodWeekly, now, PeriodOptions{WeekStartDay: 1})
+	if !start.Equal(date(2024, 6, 10)) || !end.Equal(date(2024, 6, 17)) {
+		t.Errorf("Monday-start window = %v – %v, want Jun 10 – Jun 17", start, end)
+	}
+
+	// Observed on the Sunday itself, a Monday-start week reaches back
+	// six days instead of starting today.
+	start, _ = CalculatePeriodDates(PeriodWeekly, date(2024, 6, 16), PeriodOptions{WeekStartDay: 1})
+	if !start.Equal(date(2024, 6, 10)) {
+		t.Errorf("Monday-start on a Sunday = %v, want Jun 10", start)
+	}
+}
+
+func TestCalculatePeriodDates_QuarterlyAlignsToCalendarQuarters(t *testing.T) {
+	// April sits in Q2: Apr 1 up to (exclusive) Jul 1, i.e. through Jun 30.
+	start, end := CalculatePeriodDates(PeriodQuarterly, date(2024, 4, 20), PeriodOptions{})
+	if !start.Equal(date(2024, 4, 1)) || !end.Equal(date(2024, 7, 1)) {
+		t.Errorf("Q2 window = %v – %v, want Apr 1 – Jul 1", start, end)
+	}
+
+	// Quarter edges: March closes Q1, October opens Q4.
+	start, end = CalculatePeriodDates(PeriodQuarterly, date(2024, 3, 31), PeriodOptions{})
+	if !start.Equal(date(2024, 1, 1)) || !end.Equal(date(2024, 4, 1)) {
+		t.Errorf("Q1 window = %v – %v, want Jan 1 – Apr 1", start, end)
+	}
+	start, end = CalculatePeriodDates(PeriodQuarterly, date(2024, 10, 1), PeriodOptions{})
+	if !start.Equal(date(2024, 10, 1)) || !end.Equal(date(2025, 1, 1)) {
+		t.Errorf("Q4 window = %v – %v, want Oct 1 – Jan 1", start, end)
+	}
+}
+
+func TestPeriodValid(t *testing.T) {
+	for _, p := range []Period{PeriodDaily, PeriodWeekly, PeriodMonthly, PeriodQuarterly, PeriodYearly, PeriodCustom} {
+		if !p.Valid() {
+			t.Errorf("%q should be valid", p)
+		}
+	}
+	if Period("fortnightly").Valid() {
+		t.Error("unknown period should be invalid")
+	}
+}
+
+func TestCalculatePeriodDates_DailyAndYearly(t *testing.T) {
+	now := time.Date(2024, 6, 15, 13, 45, 0, 0, time.UTC)
+	start, end := CalculatePeriodDates(PeriodDaily, now, PeriodOptions{})
+	if !start.Equal(date(2024, 6, 15)) || !end.Equal(date(2024, 6, 16)) {
+		t.Errorf("daily window = %v – %v", start, end)
+	}
+	start, end = CalculatePeriodDates(PeriodYearly, now, PeriodOptions{})
+	if !start.Equal(date(2024, 1, 1)) || !end.Equal(date(2025, 1, 1)) {
+		t.Errorf("yearly window = %v – %v", start, end)
+	}
+}
@@ -0,0 +1,117 @@
+// Package report holds the saved-report domain model. Reports are
+// generated snapshots over a date window; once stored they are immutable
+// apart from ownership changes driven by user lifecycle events.
+package report
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// Type identifies what a report aggregates.
+type Type string
+
+const (
+	TypeExpenses      Type = "expenses"
+	TypeIncome        Type = "income"
+	TypeBudget        Type = "budget"
+	TypeCashFlow      Type = "cash_flow"
+	TypeCategoryBreak Type = "category_break"
+)
+
+// Valid reports whether t is a known report type.
+func (t Type) Valid() bool {
+	switch t {
+	case TypeExpenses, TypeIncome, TypeBudget, TypeCashFlow, TypeCategoryBreak:
+		return true
+	}
+	return false
+}
+
+// Period identifies the time window granularity of a report.
+type Period string
+
+const (
+	PeriodDaily     Period = "daily"
+	PeriodWeekly    Period = "weekly"
+	PeriodMonthly   Period = "monthly"
+	PeriodQuarterly Period = "quarterly"
+	PeriodYearly    Period = "yearly"
+	PeriodCustom    Period = "custom"
+)
+
+// Valid reports whether p is a known report period.
+func (p Period) Valid() bool {
+	switch p {
+	case PeriodDaily, PeriodWeekly, PeriodMonthly, PeriodQuarterly, PeriodYearly, PeriodCustom:
+		return true
+	}
+	return false
+}
+
+// ErrNotFound is returned when a report does not exist.
+var ErrNotFound = errors.New("report not found")
+
+// Report is a generated, persisted report.
+type Report struct {
+	ID          uuid.UUID
+	Name        string
+	Type        Type
+	Period      Period
+	FamilyID    uuid.UUID
+	UserID      uuid.UUID // author; Nil when anonymized
+	StartDate   time.Time
+	EndDate     time.Time
+	Data        Data
+	GeneratedAt time.Time
+}
+
+// Data is the computed payload of a report.
+type Data struct {
+	TotalIncome       float64
+	TotalExpenses     float64
+	NetIncome         float64
+	CategoryBreakdown []CategoryBreakdownItem
+	DailyBreakdown    []DailyBreakdownItem
+	TopTransactions   []TransactionSummary
+}
+
+// CategoryBreakdownItem is one category's share of a report.
+type CategoryBreakdownItem struct {
+	CategoryID   uuid.UUID
+	CategoryName string
+	Amount       float64
+	Percentage   float64
+	Count        int
+}
+
+// DailyBreakdownItem is one day's totals inside a report window.
+type DailyBreakdownItem struct {
+	Date     time.Time
+	Income   float64
+	Expenses float64
+}
+
+// TransactionSummary is a lightweight transaction reference embedded in
+// report data.
+type TransactionSummary struct {
+	TransactionID uuid.UUID
+	Amount        float64
+	Description   string
+	CategoryName  string
+	UserName      string
+	Date          time.Time
+}
+
+// Repository is the persistence contract for reports.
+type Repository interface {
+	Create(ctx context.Context, r *Report) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Report, error)
+	GetByFamilyID(ctx context.Context, familyID uuid.UUID) ([]*Report, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*Report, error)
+	Update(ctx context.Context, r *Report) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
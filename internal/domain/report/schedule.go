@@ -0,0 +1,85 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// ScheduleFrequency is how often a scheduled report fires.
+type ScheduleFrequency string
+
+const (
+	FrequencyDaily   ScheduleFrequency = "daily"
+	FrequencyWeekly  ScheduleFrequency = "weekly"
+	FrequencyMonthly ScheduleFrequency = "monthly"
+)
+
+// Valid reports whether f is a known frequency.
+func (f ScheduleFrequency) Valid() bool {
+	switch f {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+		return true
+	}
+	return false
+}
+
+// Next returns the run time following from for this frequency.
+func (f ScheduleFrequency) Next(from time.Time) time.Time {
+	switch f {
+	case FrequencyDaily:
+		return from.AddDate(0, 0, 1)
+	case FrequencyWeekly:
+		return from.AddDate(0, 0, 7)
+	case FrequencyMonthly:
+		return from.AddDate(0, 1, 0)
+	}
+	return from
+}
+
+// WindowStart returns the beginning of the reporting window that ends at
+// end, i.e. one frequency interval earlier.
+func (f ScheduleFrequency) WindowStart(end time.Time) time.Time {
+	switch f {
+	case FrequencyDaily:
+		return end.AddDate(0, 0, -1)
+	case FrequencyWeekly:
+		return end.AddDate(0, 0, -7)
+	case FrequencyMonthly:
+		return end.AddDate(0, -1, 0)
+	}
+	return end
+}
+
+// ErrScheduleNotFound is returned when a schedule does not exist.
+var ErrScheduleNotFound = errors.New("scheduled report not found")
+
+// Schedule is a recurring report definition. Each execution generates
+// and saves a Report for the window ending at NextRun, then advances
+// NextRun by the frequency.
+type Schedule struct {
+	ID         uuid.UUID
+	Name       string
+	ReportType Type
+	Period     Period
+	Frequency  ScheduleFrequency
+	FamilyID   uuid.UUID
+	UserID     uuid.UUID // recipient / author of generated reports
+	NextRun    time.Time
+	IsActive   bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ScheduleRepository is the persistence contract for report schedules.
+type ScheduleRepository interface {
+	Create(ctx context.Context, s *Schedule) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Schedule, error)
+	GetByFamilyID(ctx context.Context, familyID uuid.UUID) ([]*Schedule, error)
+	// GetDue returns active schedules whose NextRun is at or before now.
+	GetDue(ctx context.Context, now time.Time) ([]*Schedule, error)
+	Update(ctx context.Context, s *Schedule) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,43 @@
+// Package savings holds the savings-goal domain model. A goal is a
+// target amount the family wants set aside by a date; progress is
+// derived from transactions rather than tracked by hand.
+package savings
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrNotFound is returned when a savings goal does not exist.
+var ErrNotFound = errors.New("savings goal not found")
+
+// Goal is one savings target of a family.
+type Goal struct {
+	ID           uuid.UUID
+	FamilyID     uuid.UUID
+	Name         string
+	TargetAmount float64
+	// TargetDate is when the family wants the target reached.
+	TargetDate time.Time
+	// StartDate anchors progress: only transactions from it onward
+	// count toward the goal.
+	StartDate time.Time
+	// CategoryID, when set, derives progress from expenses recorded in
+	// that dedicated savings category. Nil derives it from net income
+	// (income minus expenses) instead.
+	CategoryID *uuid.UUID
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Repository is the persistence contract for savings goals.
+type Repository interface {
+	Create(ctx context.Context, g *Goal) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Goal, error)
+	GetByFamilyID(ctx context.Context, familyID uuid.UUID) ([]*Goal, error)
+	Update(ctx context.Context, g *Goal) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,36 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrAttachmentNotFound is returned when an attachment does not exist.
+var ErrAttachmentNotFound = errors.New("attachment not found")
+
+// Attachment is a file (receipt image or PDF) attached to a transaction.
+// The bytes live in blob storage under the attachment's ID; this record
+// carries the metadata needed to list, serve and authorize them.
+type Attachment struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+	// FamilyID is denormalized from the transaction so ownership checks
+	// on download need no extra lookup.
+	FamilyID    uuid.UUID
+	FileName    string
+	ContentType string
+	Size        int64
+	CreatedAt   time.Time
+}
+
+// AttachmentRepository is the persistence contract for attachment
+// metadata.
+type AttachmentRepository interface {
+	Create(ctx context.Context, a *Attachment) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Attachment, error)
+	GetByTransactionID(ctx context.Context, transactionID uuid.UUID) ([]*Attachment, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,79 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// RecurringFrequency is how often a recurring template fires.
+type RecurringFrequency string
+
+const (
+	RecurringDaily   RecurringFrequency = "daily"
+	RecurringWeekly  RecurringFrequency = "weekly"
+	RecurringMonthly RecurringFrequency = "monthly"
+)
+
+// Valid reports whether f is a known frequency.
+func (f RecurringFrequency) Valid() bool {
+	switch f {
+	case RecurringDaily, RecurringWeekly, RecurringMonthly:
+		return true
+	}
+	return false
+}
+
+// Next returns the occurrence following after.
+func (f RecurringFrequency) Next(after time.Time) time.Time {
+	switch f {
+	case RecurringDaily:
+		return after.AddDate(0, 0, 1)
+	case RecurringWeekly:
+		return after.AddDate(0, 0, 7)
+	default:
+		return after.AddDate(0, 1, 0)
+	}
+}
+
+// ErrRecurringNotFound is returned when a recurring template does not
+// exist.
+var ErrRecurringNotFound = errors.New("recurring template not found")
+
+// RecurringTemplate describes a transaction the family wants created
+// automatically: rent, salary, subscriptions. Materialized occurrences
+// become ordinary transactions and are never altered when the template
+// changes or goes away.
+type RecurringTemplate struct {
+	ID          uuid.UUID
+	Amount      float64
+	Type        Type
+	Description string
+	CategoryID  uuid.UUID
+	UserID      uuid.UUID
+	FamilyID    uuid.UUID
+	Frequency   RecurringFrequency
+	StartDate   time.Time
+	// EndDate is the last date occurrences may fall on; nil runs forever.
+	EndDate *time.Time
+	// NextOccurrence is when the template fires next.
+	NextOccurrence time.Time
+	IsActive       bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// RecurringRepository is the persistence contract for recurring
+// templates.
+type RecurringRepository interface {
+	Create(ctx context.Context, t *RecurringTemplate) error
+	GetByID(ctx context.Context, id uuid.UUID) (*RecurringTemplate, error)
+	GetByFamilyID(ctx context.Context, familyID uuid.UUID) ([]*RecurringTemplate, error)
+	// GetDue returns active templates whose next occurrence is not after
+	// now.
+	GetDue(ctx context.Context, now time.Time) ([]*RecurringTemplate, error)
+	Update(ctx context.Context, t *RecurringTemplate) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
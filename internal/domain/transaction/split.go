@@ -0,0 +1,55 @@
+package transaction
+
+import (
+	"errors"
+	"math"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrSplitSumMismatch rejects splits whose portions do not add up to the
+// transaction amount.
+var ErrSplitSumMismatch = errors.New("split amounts must sum to the transaction amount")
+
+// splitSumTolerance absorbs float rounding when comparing split sums
+// against the transaction amount; anything beyond half a cent is a real
+// mismatch.
+const splitSumTolerance = 0.005
+
+// Split allocates part of a transaction to a category, so one
+// supermarket run can land in both groceries and household.
+type Split struct {
+	CategoryID uuid.UUID
+	Amount     float64
+	Note       string
+}
+
+// ValidateSplits checks that t's splits, when present, sum exactly to
+// its amount and carry positive portions with categories.
+func (t *Transaction) ValidateSplits() error {
+	if len(t.Splits) == 0 {
+		return nil
+	}
+	var sum float64
+	for _, s := range t.Splits {
+		if s.CategoryID.IsNil() || s.Amount <= 0 {
+			return ErrSplitSumMismatch
+		}
+		sum += s.Amount
+	}
+	if math.Abs(sum-t.Amount) > splitSumTolerance {
+		return ErrSplitSumMismatch
+	}
+	return nil
+}
+
+// Allocations returns the transaction's per-category amounts: its splits
+// when present, otherwise a single allocation of the whole amount to its
+// category. Budget attribution and category breakdowns share this so a
+// split portion is never double-counted.
+func (t *Transaction) Allocations() []Split {
+	if len(t.Splits) > 0 {
+		return t.Splits
+	}
+	return []Split{{CategoryID: t.CategoryID, Amount: t.Amount}}
+}
@@ -0,0 +1,123 @@
+package transaction
+
+import (
+	"sort"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// FamilyStats are lifetime aggregates over a family's transactions,
+// backing the family overview page.
+type FamilyStats struct {
+	TransactionCount int
+	TotalIncome      float64
+	TotalExpenses    float64
+	// OldestTransaction is nil for families without any transactions.
+	OldestTransaction *time.Time
+	// MostUsedCategoryID is the category with the most transactions;
+	// Nil when the family has none.
+	MostUsedCategoryID uuid.UUID
+}
+
+// ComputeFamilyStats aggregates stats over transactions in one pass.
+// Repository implementations without native aggregation (in-memory,
+// tests) share this definition, mirroring Filter.Matches.
+func ComputeFamilyStats(transactions []*Transaction) *FamilyStats {
+	stats := &FamilyStats{}
+	categoryCounts := make(map[uuid.UUID]int)
+	for _, t := range transactions {
+		stats.TransactionCount++
+		switch t.Type {
+		case TypeIncome:
+			stats.TotalIncome += t.Amount
+		case TypeExpense:
+			stats.TotalExpenses += t.Amount
+		}
+		if stats.OldestTransaction == nil || t.Date.Before(*stats.OldestTransaction) {
+			date := t.Date
+			stats.OldestTransaction = &date
+		}
+		categoryCounts[t.CategoryID]++
+	}
+
+	best := 0
+	for categoryID, count := range categoryCounts {
+		if count > best {
+			best = count
+			stats.MostUsedCategoryID = categoryID
+		}
+	}
+	return stats
+}
+
+// MonthlyTotal is one calendar month's income and expense totals inside
+// a year.
+type MonthlyTotal struct {
+	Month    time.Month
+	Income   float64
+	Expenses float64
+}
+
+// ComputeMonthlyTotals buckets a year's transactions by calendar month,
+// always returning twelve entries, January first. Transactions outside
+// the year are ignored. Repository implementations without native
+// aggregation share this definition.
+func ComputeMonthlyTotals(transactions []*Transaction, year int) []MonthlyTotal {
+	totals := make([]MonthlyTotal, 12)
+	for i := range totals {
+		totals[i].Month = time.Month(i + 1)
+	}
+	for _, t := range transactions {
+		if t.Date.Year() != year {
+			continue
+		}
+		bucket := &totals[int(t.Date.Month())-1]
+		switch t.Type {
+		case TypeIncome:
+			bucket.Income += t.Amount
+		case TypeExpense:
+			bucket.Expenses += t.Amount
+		}
+	}
+	return totals
+}
+
+// CategoryTotal is one category's aggregated amount and transaction
+// count inside a filter window.
+type CategoryTotal struct {
+	CategoryID uuid.UUID
+	Amount     float64
+	Count      int
+}
+
+// ComputeCategoryTotals groups transactions by category in one pass,
+// split portions counting toward their own categories. Totals come back
+// largest first. Like ComputeFamilyStats, repository implementations
+// without native aggregation share this definition.
+func ComputeCategoryTotals(transactions []*Transaction) []CategoryTotal {
+	byCategory := make(map[uuid.UUID]*CategoryTotal)
+	for _, t := range transactions {
+		for _, allocation := range t.Allocations() {
+			entry, ok := byCategory[allocation.CategoryID]
+			if !ok {
+				entry = &CategoryTotal{CategoryID: allocation.CategoryID}
+				byCategory[allocation.CategoryID] = entry
+			}
+			entry.Amount += allocation.Amount
+			entry.Count++
+		}
+	}
+
+	totals := make([]CategoryTotal, 0, len(byCategory))
+	for _, entry := range byCategory {
+		totals = append(totals, *entry)
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		if totals[i].Amount != totals[j].Amount {
+			return totals[i].Amount > totals[j].Amount
+		}
+		return totals[i].CategoryID.String() < totals[j].CategoryID.String()
+	})
+	return totals
+}
@@ -0,0 +1,226 @@
+// Package transaction holds the transaction domain model. Transactions
+// are the raw facts of the system: every report, budget total and
+// dashboard number is derived from them.
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// Type distinguishes money coming in from money going out.
+type Type string
+
+const (
+	TypeIncome  Type = "income"
+	TypeExpense Type = "expense"
+	// TypeTransfer moves money between two of the family's accounts. It
+	// affects account balances but is neither income nor expense, so
+	// reports and statistics never count it.
+	TypeTransfer Type = "transfer"
+)
+
+// ErrNotFound is returned when a transaction does not exist.
+var ErrNotFound = errors.New("transaction not found")
+
+// ErrInvalidType rejects transactions whose type is unknown or whose
+// fields do not fit the type (e.g. a transfer carrying a category).
+var ErrInvalidType = errors.New("invalid transaction type")
+
+// Transaction is a single money movement recorded by a family member.
+type Transaction struct {
+	ID          uuid.UUID
+	Amount      float64
+	Type        Type
+	Description string
+	CategoryID  uuid.UUID
+	UserID      uuid.UUID
+	FamilyID    uuid.UUID
+	Date        time.Time
+	Tags        []string
+	// OriginalCurrency, OriginalAmount and ExchangeRate are set when the
+	// transaction was recorded in a foreign currency. Amount always holds
+	// the family-base-currency value (OriginalAmount * ExchangeRate), so
+	// every aggregation works on Amount alone. Empty OriginalCurrency
+	// means the transaction was entered in the base currency.
+	OriginalCurrency string
+	OriginalAmount   float64
+	ExchangeRate     float64
+	// Splits divides the amount across several categories; empty means
+	// the whole amount belongs to CategoryID.
+	Splits []Split
+	// BankTransactionID is the bank's own identifier (OFX FITID) for
+	// imported transactions; empty for hand-entered ones. Imports key
+	// deduplication on it.
+	BankTransactionID string
+	// AccountID is the account the money moved through (the source for
+	// transfers); nil for transactions recorded before accounts existed.
+	// ToAccountID is set only on transfers and names the destination.
+	AccountID   *uuid.UUID
+	ToAccountID *uuid.UUID
+	// Reimbursable marks an expense the family fronted and expects to
+	// get back. ReimbursedBy links the income transaction that settled
+	// it; nil means the reimbursement is still outstanding.
+	Reimbursable bool
+	ReimbursedBy *uuid.UUID
+	// DeletedAt marks a soft-deleted transaction. Trashed transactions
+	// are excluded from queries and aggregations but stay restorable
+	// until the purge removes them for good.
+	DeletedAt *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Filter narrows repository queries. Zero/nil fields are ignored; slice
+// fields match any of their values (OR within a field, AND across
+// fields). All queries are family-scoped.
+type Filter struct {
+	FamilyID    uuid.UUID
+	CategoryIDs []uuid.UUID
+	UserIDs     []uuid.UUID
+	Type        *Type
+	DateFrom    *time.Time
+	DateTo      *time.Time
+	AmountFrom  *float64
+	AmountTo    *float64
+	Description string
+	// Tags matches transactions carrying any of the listed tags. Tags
+	// are compared in normalized form; see NormalizeTag.
+	Tags []string
+	// AccountID matches transactions involving the account: recorded on
+	// it, or transferring into it.
+	AccountID *uuid.UUID
+	Limit     int
+	Offset    int
+	// IncludeDeleted also matches soft-deleted transactions. Off by
+	// default so lists, reports and budget math never see the trash.
+	IncludeDeleted bool
+}
+
+// NormalizeTag canonicalizes a tag for storage and comparison: tags are
+// case-insensitive and surrounding whitespace never distinguishes them.
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// Matches reports whether t satisfies every set field of f. Repository
+// implementations without native query support (in-memory, tests) share
+// this definition so filter semantics cannot drift.
+func (f Filter) Matches(t *Transaction) bool {
+	if !f.IncludeDeleted && t.DeletedAt != nil {
+		return false
+	}
+	if !f.FamilyID.IsNil() && t.FamilyID != f.FamilyID {
+		return false
+	}
+	if len(f.CategoryIDs) > 0 && !containsUUID(f.CategoryIDs, t.CategoryID) {
+		return false
+	}
+	if len(f.UserIDs) > 0 && !containsUUID(f.UserIDs, t.UserID) {
+		return false
+	}
+	if f.Type != nil && t.Type != *f.Type {
+		return false
+	}
+	if f.DateFrom != nil && t.Date.Before(*f.DateFrom) {
+		return false
+	}
+	if f.DateTo != nil && t.Date.After(*f.DateTo) {
+		return false
+	}
+	if f.AmountFrom != nil && t.Amount < *f.AmountFrom {
+		return false
+	}
+	if f.AmountTo != nil && t.Amount > *f.AmountTo {
+		return false
+	}
+	if len(f.Tags) > 0 && !hasAnyTag(t.Tags, f.Tags) {
+		return false
+	}
+	if f.AccountID != nil && !t.InvolvesAccount(*f.AccountID) {
+		return false
+	}
+	return true
+}
+
+// ValidateType checks that t's type is known and that the fields tied to
+// it are consistent. Transfers move money between two distinct accounts
+// and carry no category — categories themselves stay income or expense,
+// there is no "transfer" category type.
+func (t *Transaction) ValidateType() error {
+	switch t.Type {
+	case TypeIncome, TypeExpense:
+		return nil
+	case TypeTransfer:
+		if !t.CategoryID.IsNil() {
+			return fmt.Errorf("%w: transfers do not belong to a category", ErrInvalidType)
+		}
+		if t.AccountID == nil || t.ToAccountID == nil {
+			return fmt.Errorf("%w: transfers need a source and a destination account", ErrInvalidType)
+		}
+		if *t.AccountID == *t.ToAccountID {
+			return fmt.Errorf("%w: transfer source and destination are the same account", ErrInvalidType)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidType, t.Type)
+	}
+}
+
+// InvolvesAccount reports whether t touches the account: it was recorded
+// on it, or it is a transfer into it.
+func (t *Transaction) InvolvesAccount(accountID uuid.UUID) bool {
+	if t.AccountID != nil && *t.AccountID == accountID {
+		return true
+	}
+	return t.ToAccountID != nil && *t.ToAccountID == accountID
+}
+
+func hasAnyTag(tags, wanted []string) bool {
+	for _, w := range wanted {
+		for _, tag := range tags {
+			if tag == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containsUUID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Repository is the persistence contract for transactions.
+type Repository interface {
+	Create(ctx context.Context, t *Transaction) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Transaction, error)
+	GetByFamilyID(ctx context.Context, familyID uuid.UUID) ([]*Transaction, error)
+	GetByFilter(ctx context.Context, filter Filter) ([]*Transaction, error)
+	// GetFamilyStats aggregates lifetime statistics for a family in a
+	// single repository-side pass.
+	GetFamilyStats(ctx context.Context, familyID uuid.UUID) (*FamilyStats, error)
+	// GetCategoryTotals aggregates per-category sums and counts for a
+	// filter window in a single repository-side pass, so reports need
+	// not load every matching transaction.
+	GetCategoryTotals(ctx context.Context, filter Filter) ([]CategoryTotal, error)
+	// GetTotalByFamilyAndDateRange sums one transaction type over a
+	// family window repository-side, for callers that need a single
+	// total and no rows.
+	GetTotalByFamilyAndDateRange(ctx context.Context, familyID uuid.UUID, start, end time.Time, txType Type) (float64, error)
+	// GetMonthlyTotals buckets one year of a family's income and
+	// expenses by calendar month in a single repository-side pass.
+	GetMonthlyTotals(ctx context.Context, familyID uuid.UUID, year int) ([]MonthlyTotal, error)
+	Update(ctx context.Context, t *Transaction) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,82 @@
+// Package user holds the user and family domain model. A family is the
+// tenancy unit of the service: every other entity is scoped to a family,
+// and every user belongs to exactly one family with a role.
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// Role defines what a family member is allowed to do.
+type Role string
+
+const (
+	// RoleAdmin manages the family: members, settings, budgets.
+	RoleAdmin Role = "admin"
+	// RoleMember is a regular adult member.
+	RoleMember Role = "member"
+	// RoleChild has restricted, mostly read-only access.
+	RoleChild Role = "child"
+)
+
+// Sentinel errors shared by all user repository implementations.
+var (
+	ErrNotFound       = errors.New("user not found")
+	ErrEmailTaken     = errors.New("email already in use")
+	ErrFamilyNotFound = errors.New("family not found")
+)
+
+// User is a member of a family.
+type User struct {
+	ID        uuid.UUID
+	Email     string
+	Password  string // bcrypt hash, never the plain text
+	FirstName string
+	LastName  string
+	Role      Role
+	FamilyID  uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// FullName returns the display name used in reports and templates.
+func (u *User) FullName() string {
+	return u.FirstName + " " + u.LastName
+}
+
+// Family is the top-level tenant owning users, categories, transactions,
+// budgets and reports.
+type Family struct {
+	ID       uuid.UUID
+	Name     string
+	Currency string // ISO 4217 code, e.g. "USD"
+	// PeriodStartDay anchors monthly budget/report periods for families
+	// that budget payday-to-payday. Zero or one means calendar months.
+	PeriodStartDay int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Repository is the persistence contract for users.
+type Repository interface {
+	Create(ctx context.Context, u *User) error
+	GetByID(ctx context.Context, id uuid.UUID) (*User, error)
+	GetByEmail(ctx context.Context, email string) (*User, error)
+	GetByFamilyID(ctx context.Context, familyID uuid.UUID) ([]*User, error)
+	Update(ctx context.Context, u *User) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// FamilyRepository is the persistence contract for families.
+type FamilyRepository interface {
+	Create(ctx context.Context, f *Family) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Family, error)
+	// List returns every family, for background jobs that run per family.
+	List(ctx context.Context) ([]*Family, error)
+	Update(ctx context.Context, f *Family) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
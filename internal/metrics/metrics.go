@@ -0,0 +1,121 @@
+// Package metrics is a small dependency-free metrics registry exposing
+// histograms in the Prometheus text format. A registry is created once
+// at wiring time and served on /metrics; tests build their own registry
+// so nothing leaks between runs.
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// DefBuckets are the default latency buckets in seconds, spanning
+// sub-millisecond repository calls up to multi-second report runs.
+var DefBuckets = []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5}
+
+// Registry holds the registered metrics and serves them over HTTP.
+type Registry struct {
+	mu         sync.Mutex
+	histograms []*Histogram
+}
+
+// NewRegistry builds an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Histogram registers and returns a labeled histogram. Buckets must be
+// sorted ascending; nil falls back to DefBuckets.
+func (r *Registry) Histogram(name, help, label string, buckets []float64) *Histogram {
+	if buckets == nil {
+		buckets = DefBuckets
+	}
+	h := &Histogram{
+		name:    name,
+		help:    help,
+		label:   label,
+		buckets: buckets,
+		series:  make(map[string]*histogramSeries),
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.histograms = append(r.histograms, h)
+	return h
+}
+
+// Histogram is a labeled histogram: one series of bucket counts, sum
+// and count per label value.
+type Histogram struct {
+	name    string
+	help    string
+	label   string
+	buckets []float64
+
+	mu     sync.Mutex
+	series map[string]*histogramSeries
+}
+
+type histogramSeries struct {
+	counts []uint64 // cumulative per bucket, +Inf excluded
+	sum    float64
+	count  uint64
+}
+
+// Observe records one observation under the given label value.
+func (h *Histogram) Observe(labelValue string, v float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.series[labelValue]
+	if !ok {
+		s = &histogramSeries{counts: make([]uint64, len(h.buckets))}
+		h.series[labelValue] = s
+	}
+	for i, upper := range h.buckets {
+		if v <= upper {
+			s.counts[i]++
+		}
+	}
+	s.sum += v
+	s.count++
+}
+
+// ServeHTTP renders every registered metric in the Prometheus text
+// exposition format, so the registry itself can be mounted on /metrics.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	r.mu.Lock()
+	histograms := make([]*Histogram, len(r.histograms))
+	copy(histograms, r.histograms)
+	r.mu.Unlock()
+	for _, h := range histograms {
+		h.write(w)
+	}
+}
+
+// write renders one histogram's series, label values sorted for stable
+// output.
+func (h *Histogram) write(w http.ResponseWriter) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n", h.name, h.help)
+	fmt.Fprintf(w, "# TYPE %s histogram\n", h.name)
+	labels := make([]string, 0, len(h.series))
+	for labelValue := range h.series {
+		labels = append(labels, labelValue)
+	}
+	sort.Strings(labels)
+	for _, labelValue := range labels {
+		s := h.series[labelValue]
+		for i, upper := range h.buckets {
+			fmt.Fprintf(w, "%s_bucket{%s=%q,le=%q} %d\n",
+				h.name, h.label, labelValue, strconv.FormatFloat(upper, 'g', -1, 64), s.counts[i])
+		}
+		fmt.Fprintf(w, "%s_bucket{%s=%q,le=\"+Inf\"} %d\n", h.name, h.label, labelValue, s.count)
+		fmt.Fprintf(w, "%s_sum{%s=%q} %s\n", h.name, h.label, labelValue, strconv.FormatFloat(s.sum, 'g', -1, 64))
+		fmt.Fprintf(w, "%s_count{%s=%q} %d\n", h.name, h.label, labelValue, s.count)
+	}
+}
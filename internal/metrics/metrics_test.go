@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRegistry_ServesHistogramExposition(t *testing.T) {
+	registry := NewRegistry()
+	h := registry.Histogram("demo_duration_seconds", "Demo timing.", "operation", []float64{0.1, 1})
+	h.Observe("load", 0.05)
+	h.Observe("load", 0.5)
+	h.Observe("load", 2)
+	h.Observe("save", 0.01)
+
+	rec := httptest.NewRecorder()
+	registry.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+
+	for _, want := range []string{
+		"# HELP demo_duration_seconds Demo timing.",
+		"# TYPE demo_duration_seconds histogram",
+		`demo_duration_seconds_bucket{operation="load",le="0.1"} 1`,
+		`demo_duration_seconds_bucket{operation="load",le="1"} 2`,
+		`demo_duration_seconds_bucket{operation="load",le="+Inf"} 3`,
+		`demo_duration_seconds_sum{operation="load"} 2.55`,
+		`demo_duration_seconds_count{operation="load"} 3`,
+		`demo_duration_seconds_count{operation="save"} 1`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("exposition missing %q\n%s", want, body)
+		}
+	}
+}
+
+func TestRegistriesAreIndependent(t *testing.T) {
+	first := NewRegistry()
+	first.Histogram("only_in_first_seconds", "One registry.", "op", nil).Observe("x", 0.1)
+
+	rec := httptest.NewRecorder()
+	NewRegistry().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if strings.Contains(rec.Body.String(), "only_in_first_seconds") {
+		t.Errorf("fresh registry leaked another registry's metrics")
+	}
+}
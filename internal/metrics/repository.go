@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// instrumentedTransactionRepository decorates a transaction.Repository,
+// recording each call's latency labeled by operation.
+type instrumentedTransactionRepository struct {
+	next     transaction.Repository
+	duration *Histogram
+}
+
+// InstrumentTransactionRepository wraps next so every repository call
+// observes its duration on the given histogram.
+func InstrumentTransactionRepository(next transaction.Repository, duration *Histogram) transaction.Repository {
+	return &instrumentedTransactionRepository{next: next, duration: duration}
+}
+
+// observe records the time since start under the operation label.
+func (r *instrumentedTransactionRepository) observe(operation string, start time.Time) {
+	r.duration.Observe(operation, time.Since(start).Seconds())
+}
+
+func (r *instrumentedTransactionRepository) Create(ctx context.Context, t *transaction.Transaction) error {
+	defer r.observe("create", time.Now())
+	return r.next.Create(ctx, t)
+}
+
+func (r *instrumentedTransactionRepository) GetByID(ctx context.Context, id uuid.UUID) (*transaction.Transaction, error) {
+	defer r.observe("get_by_id", time.Now())
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *instrumentedTransactionRepository) GetByFamilyID(ctx context.Context, familyID uuid.UUID) ([]*transaction.Transaction, error) {
+	defer r.observe("get_by_family_id", time.Now())
+	return r.next.GetByFamilyID(ctx, familyID)
+}
+
+func (r *instrumentedTransactionRepository) GetByFilter(ctx context.Context, filter transaction.Filter) ([]*transaction.Transaction, error) {
+	defer r.observe("get_by_filter", time.Now())
+	return r.next.GetByFilter(ctx, filter)
+}
+
+func (r *instrumentedTransactionRepository) GetFamilyStats(ctx context.Context, familyID uuid.UUID) (*transaction.FamilyStats, error) {
+	defer r.observe("get_family_stats", time.Now())
+	return r.next.GetFamilyStats(ctx, familyID)
+}
+
+func (r *instrumentedTransactionRepository) GetCategoryTotals(ctx context.Context, filter transaction.Filter) ([]transaction.CategoryTotal, error) {
+	defer r.observe("get_category_totals", time.Now())
+	return r.next.GetCategoryTotals(ctx, filter)
+}
+
+func (r *instrumentedTransactionRepository) GetTotalByFamilyAndDateRange(ctx context.Context, familyID uuid.UUID, start, end time.Time, txType transaction.Type) (float64, error) {
+	defer r.observe("get_total_by_family_and_date_range", time.Now())
+	return r.next.GetTotalByFamilyAndDateRange(ctx, familyID, start, end, txType)
+}
+
+func (r *instrumentedTransactionRepository) GetMonthlyTotals(ctx context.Context, familyID uuid.UUID, year int) ([]transaction.MonthlyTotal, error) {
+	defer r.observe("get_monthly_totals", time.Now())
+	return r.next.GetMonthlyTotals(ctx, familyID, year)
+}
+
+func (r *instrumentedTransactionRepository) Update(ctx context.Context, t *transaction.Transaction) error {
+	defer r.observe("update", time.Now())
+	return r.next.Update(ctx, t)
+}
+
+func (r *instrumentedTransactionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	defer r.observe("delete", time.Now())
+	return r.next.Delete(ctx, id)
+}
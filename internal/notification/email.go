@@ -0,0 +1,58 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+)
+
+// EmailSender sends a single email. A real SMTP implementation plugs in
+// here; LogSender is the development default.
+type EmailSender interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// LogSender is an EmailSender that only logs, for development and tests.
+type LogSender struct {
+	Logger *slog.Logger
+}
+
+func (s *LogSender) Send(_ context.Context, to, subject, _ string) error {
+	logger := s.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.Info("email notification", "to", to, "subject", subject)
+	return nil
+}
+
+// RecipientResolver maps a notification's addressee to email addresses.
+// Implemented by the user service.
+type RecipientResolver interface {
+	ResolveEmails(ctx context.Context, n Notification) ([]string, error)
+}
+
+// EmailNotifier delivers notifications by email.
+type EmailNotifier struct {
+	sender    EmailSender
+	recipient RecipientResolver
+}
+
+// NewEmailNotifier builds an email channel.
+func NewEmailNotifier(sender EmailSender, recipient RecipientResolver) *EmailNotifier {
+	return &EmailNotifier{sender: sender, recipient: recipient}
+}
+
+func (e *EmailNotifier) Channel() string { return "email" }
+
+func (e *EmailNotifier) Notify(ctx context.Context, n Notification) error {
+	emails, err := e.recipient.ResolveEmails(ctx, n)
+	if err != nil {
+		return err
+	}
+	for _, to := range emails {
+		if err := e.sender.Send(ctx, to, n.Title, n.Message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
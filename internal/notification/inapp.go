@@ -0,0 +1,25 @@
+package notification
+
+import "context"
+
+// InboxStore persists in-app notifications for later reading. The inbox
+// endpoints build on this store.
+type InboxStore interface {
+	Add(ctx context.Context, n Notification) error
+}
+
+// InAppNotifier delivers notifications into the in-app inbox.
+type InAppNotifier struct {
+	store InboxStore
+}
+
+// NewInAppNotifier builds the in-app channel.
+func NewInAppNotifier(store InboxStore) *InAppNotifier {
+	return &InAppNotifier{store: store}
+}
+
+func (a *InAppNotifier) Channel() string { return "in_app" }
+
+func (a *InAppNotifier) Notify(ctx context.Context, n Notification) error {
+	return a.store.Add(ctx, n)
+}
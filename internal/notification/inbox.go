@@ -0,0 +1,31 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrInboxItemNotFound is returned when an inbox item does not exist or
+// belongs to another user.
+var ErrInboxItemNotFound = errors.New("inbox item not found")
+
+// InboxItem is a delivered in-app notification with its read state.
+type InboxItem struct {
+	Notification
+	Read   bool
+	ReadAt *time.Time
+}
+
+// InboxRepository persists the in-app inbox. Adding is the InAppNotifier
+// delivery path; listing and marking read back the inbox endpoints.
+type InboxRepository interface {
+	InboxStore
+	// ListByUser returns a user's inbox, newest first. unreadOnly
+	// restricts it to unread items.
+	ListByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool) ([]*InboxItem, error)
+	// MarkRead flags an item read. The item must belong to userID.
+	MarkRead(ctx context.Context, id, userID uuid.UUID) error
+}
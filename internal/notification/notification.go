@@ -0,0 +1,77 @@
+// Package notification routes outbound notifications (budget alerts,
+// finished scheduled reports, import results) through pluggable delivery
+// channels. Services build a Notification and hand it to the Dispatcher;
+// which channels actually deliver it is wiring, not service logic.
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// Type classifies what a notification is about.
+type Type string
+
+const (
+	TypeBudgetThreshold Type = "budget_threshold"
+	TypeReportReady     Type = "report_ready"
+	TypeImportResult    Type = "import_result"
+)
+
+// Notification is one outbound message addressed to a family member.
+type Notification struct {
+	ID        uuid.UUID
+	FamilyID  uuid.UUID
+	UserID    uuid.UUID // recipient; Nil means every family member
+	Type      Type
+	Title     string
+	Message   string
+	CreatedAt time.Time
+}
+
+// NewBudgetThresholdAlert builds the notification sent when a budget
+// crosses an alert threshold.
+func NewBudgetThresholdAlert(familyID, userID uuid.UUID, budgetName string, percentage float64) Notification {
+	return Notification{
+		ID:        uuid.New(),
+		FamilyID:  familyID,
+		UserID:    userID,
+		Type:      TypeBudgetThreshold,
+		Title:     "Budget threshold reached",
+		Message:   fmt.Sprintf("%s reached %.0f%% of its limit", budgetName, percentage),
+		CreatedAt: time.Now(),
+	}
+}
+
+// Notifier delivers a notification over one channel.
+type Notifier interface {
+	// Channel names the delivery channel, e.g. "email".
+	Channel() string
+	Notify(ctx context.Context, n Notification) error
+}
+
+// Dispatcher fans a notification out to every configured channel.
+type Dispatcher struct {
+	notifiers []Notifier
+}
+
+// NewDispatcher builds a Dispatcher over the given channels.
+func NewDispatcher(notifiers ...Notifier) *Dispatcher {
+	return &Dispatcher{notifiers: notifiers}
+}
+
+// Dispatch delivers n over every channel, attempting all of them even if
+// some fail, and returns the joined delivery errors.
+func (d *Dispatcher) Dispatch(ctx context.Context, n Notification) error {
+	var errs []error
+	for _, notifier := range d.notifiers {
+		if err := notifier.Notify(ctx, n); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", notifier.Channel(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
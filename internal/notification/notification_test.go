@@ -0,0 +1,65 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// fakeNotifier records everything dispatched through it.
+type fakeNotifier struct {
+	name     string
+	received []Notification
+	fail     error
+}
+
+func (f *fakeNotifier) Channel() string { return f.name }
+
+func (f *fakeNotifier) Notify(_ context.Context, n Notification) error {
+	if f.fail != nil {
+		return f.fail
+	}
+	f.received = append(f.received, n)
+	return nil
+}
+
+func TestDispatcher_BudgetThresholdReachesAllChannels(t *testing.T) {
+	email := &fakeNotifier{name: "email"}
+	inApp := &fakeNotifier{name: "in_app"}
+	d := NewDispatcher(email, inApp)
+
+	alert := NewBudgetThresholdAlert(uuid.New(), uuid.New(), "Groceries", 92)
+	if err := d.Dispatch(context.Background(), alert); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+
+	for _, ch := range []*fakeNotifier{email, inApp} {
+		if len(ch.received) != 1 {
+			t.Fatalf("channel %s received %d notifications, want 1", ch.name, len(ch.received))
+		}
+		got := ch.received[0]
+		if got.Type != TypeBudgetThreshold {
+			t.Errorf("channel %s type = %s, want %s", ch.name, got.Type, TypeBudgetThreshold)
+		}
+		if got.Message == "" || got.Title == "" {
+			t.Errorf("channel %s got empty content: %+v", ch.name, got)
+		}
+	}
+}
+
+func TestDispatcher_ContinuesPastFailingChannel(t *testing.T) {
+	boom := errors.New("smtp down")
+	email := &fakeNotifier{name: "email", fail: boom}
+	inApp := &fakeNotifier{name: "in_app"}
+	d := NewDispatcher(email, inApp)
+
+	err := d.Dispatch(context.Background(), NewBudgetThresholdAlert(uuid.New(), uuid.New(), "Transport", 101))
+	if !errors.Is(err, boom) {
+		t.Errorf("err = %v, want wrapped smtp failure", err)
+	}
+	if len(inApp.received) != 1 {
+		t.Errorf("in_app received %d, want delivery despite email failure", len(inApp.received))
+	}
+}
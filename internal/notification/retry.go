@@ -0,0 +1,131 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrDeadLetterNotFound is returned when a dead-letter record does not
+// exist.
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// RetryPolicy bounds delivery retries. Backoff doubles after every failed
+// attempt starting from InitialBackoff.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+}
+
+// DefaultRetryPolicy retries three times starting at one second.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second}
+}
+
+// DeadLetter records a notification that exhausted its delivery retries,
+// kept for inspection and manual redelivery.
+type DeadLetter struct {
+	ID           uuid.UUID
+	Channel      string
+	Notification Notification
+	Attempts     int
+	LastError    string
+	FailedAt     time.Time
+}
+
+// DeadLetterRepository persists failed deliveries.
+type DeadLetterRepository interface {
+	Add(ctx context.Context, d *DeadLetter) error
+	GetByID(ctx context.Context, id uuid.UUID) (*DeadLetter, error)
+	List(ctx context.Context) ([]*DeadLetter, error)
+	Remove(ctx context.Context, id uuid.UUID) error
+}
+
+// RetryingNotifier wraps a channel with bounded exponential-backoff
+// retries. Deliveries that fail every attempt land in the dead-letter
+// repository instead of being lost.
+type RetryingNotifier struct {
+	inner      Notifier
+	policy     RetryPolicy
+	deadLetter DeadLetterRepository
+
+	// sleep is swapped out in tests to avoid real waiting.
+	sleep func(time.Duration)
+}
+
+// NewRetryingNotifier wraps inner with retry handling. A zero-valued
+// policy falls back to the default.
+func NewRetryingNotifier(inner Notifier, policy RetryPolicy, deadLetter DeadLetterRepository) *RetryingNotifier {
+	if policy.MaxAttempts <= 0 {
+		policy = DefaultRetryPolicy()
+	}
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = time.Second
+	}
+	return &RetryingNotifier{
+		inner:      inner,
+		policy:     policy,
+		deadLetter: deadLetter,
+		sleep:      time.Sleep,
+	}
+}
+
+func (r *RetryingNotifier) Channel() string { return r.inner.Channel() }
+
+// Notify attempts delivery up to MaxAttempts times with doubling backoff
+// between attempts. After the final failure the notification is
+// dead-lettered and the last error returned.
+func (r *RetryingNotifier) Notify(ctx context.Context, n Notification) error {
+	backoff := r.policy.InitialBackoff
+	var lastErr error
+	for attempt := 1; attempt <= r.policy.MaxAttempts; attempt++ {
+		lastErr = r.inner.Notify(ctx, n)
+		if lastErr == nil {
+			return nil
+		}
+		if attempt < r.policy.MaxAttempts {
+			select {
+			case <-ctx.Done():
+				lastErr = ctx.Err()
+				attempt = r.policy.MaxAttempts // stop retrying
+			default:
+				r.sleep(backoff)
+				backoff *= 2
+			}
+		}
+	}
+
+	dead := &DeadLetter{
+		ID:           uuid.New(),
+		Channel:      r.inner.Channel(),
+		Notification: n,
+		Attempts:     r.policy.MaxAttempts,
+		LastError:    lastErr.Error(),
+		FailedAt:     time.Now(),
+	}
+	if err := r.deadLetter.Add(ctx, dead); err != nil {
+		return fmt.Errorf("dead-letter notification: %w (delivery error: %s)", err, lastErr)
+	}
+	return fmt.Errorf("delivery failed after %d attempts: %w", r.policy.MaxAttempts, lastErr)
+}
+
+// RetryDeadLetter redelivers a dead-lettered notification once and
+// removes the record on success.
+func (r *RetryingNotifier) RetryDeadLetter(ctx context.Context, id uuid.UUID) error {
+	dead, err := r.deadLetter.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := r.inner.Notify(ctx, dead.Notification); err != nil {
+		return fmt.Errorf("redeliver dead letter: %w", err)
+	}
+	return r.deadLetter.Remove(ctx, id)
+}
+
+// DeadLetters lists the currently dead-lettered deliveries.
+func (r *RetryingNotifier) DeadLetters(ctx context.Context) ([]*DeadLetter, error) {
+	return r.deadLetter.List(ctx)
+}
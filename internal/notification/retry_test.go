@@ -0,0 +1,137 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// flakyNotifier fails a configured number of deliveries before
+// succeeding.
+type flakyNotifier struct {
+	failures int
+	calls    int
+}
+
+func (f *flakyNotifier) Channel() string { return "webhook" }
+
+func (f *flakyNotifier) Notify(_ context.Context, _ Notification) error {
+	f.calls++
+	if f.calls <= f.failures {
+		return errors.New("endpoint unavailable")
+	}
+	return nil
+}
+
+// mapDeadLetters is a minimal in-test DeadLetterRepository.
+type mapDeadLetters struct {
+	letters map[uuid.UUID]*DeadLetter
+}
+
+func newMapDeadLetters() *mapDeadLetters {
+	return &mapDeadLetters{letters: make(map[uuid.UUID]*DeadLetter)}
+}
+
+func (m *mapDeadLetters) Add(_ context.Context, d *DeadLetter) error {
+	m.letters[d.ID] = d
+	return nil
+}
+
+func (m *mapDeadLetters) GetByID(_ context.Context, id uuid.UUID) (*DeadLetter, error) {
+	d, ok := m.letters[id]
+	if !ok {
+		return nil, ErrDeadLetterNotFound
+	}
+	return d, nil
+}
+
+func (m *mapDeadLetters) List(_ context.Context) ([]*DeadLetter, error) {
+	out := make([]*DeadLetter, 0, len(m.letters))
+	for _, d := range m.letters {
+		out = append(out, d)
+	}
+	return out, nil
+}
+
+func (m *mapDeadLetters) Remove(_ context.Context, id uuid.UUID) error {
+	if _, ok := m.letters[id]; !ok {
+		return ErrDeadLetterNotFound
+	}
+	delete(m.letters, id)
+	return nil
+}
+
+func newTestRetrier(inner Notifier, dead DeadLetterRepository, maxAttempts int) (*RetryingNotifier, *[]time.Duration) {
+	r := NewRetryingNotifier(inner, RetryPolicy{MaxAttempts: maxAttempts, InitialBackoff: 10 * time.Millisecond}, dead)
+	var slept []time.Duration
+	r.sleep = func(d time.Duration) { slept = append(slept, d) }
+	return r, &slept
+}
+
+func TestRetryingNotifier_RetriesUntilSuccess(t *testing.T) {
+	inner := &flakyNotifier{failures: 2}
+	dead := newMapDeadLetters()
+	r, slept := newTestRetrier(inner, dead, 3)
+
+	if err := r.Notify(context.Background(), NewBudgetThresholdAlert(uuid.New(), uuid.New(), "Groceries", 90)); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	if inner.calls != 3 {
+		t.Errorf("calls = %d, want 3 (two failures then success)", inner.calls)
+	}
+	if len(*slept) != 2 || (*slept)[0] != 10*time.Millisecond || (*slept)[1] != 20*time.Millisecond {
+		t.Errorf("backoffs = %v, want doubling 10ms then 20ms", *slept)
+	}
+	if letters, _ := dead.List(context.Background()); len(letters) != 0 {
+		t.Errorf("dead letters = %d, want none on eventual success", len(letters))
+	}
+}
+
+func TestRetryingNotifier_DeadLettersAfterMaxAttempts(t *testing.T) {
+	inner := &flakyNotifier{failures: 10}
+	dead := newMapDeadLetters()
+	r, _ := newTestRetrier(inner, dead, 3)
+
+	alert := NewBudgetThresholdAlert(uuid.New(), uuid.New(), "Groceries", 90)
+	if err := r.Notify(context.Background(), alert); err == nil {
+		t.Fatal("Notify succeeded, want an error after exhausting retries")
+	}
+	if inner.calls != 3 {
+		t.Errorf("calls = %d, want exactly the max attempts", inner.calls)
+	}
+
+	letters, _ := dead.List(context.Background())
+	if len(letters) != 1 {
+		t.Fatalf("dead letters = %d, want 1", len(letters))
+	}
+	d := letters[0]
+	if d.Attempts != 3 || d.LastError == "" || d.Notification.ID != alert.ID {
+		t.Errorf("dead letter = %+v, want the failed notification with attempt count and error", d)
+	}
+}
+
+func TestRetryDeadLetter_RemovesRecordOnSuccess(t *testing.T) {
+	inner := &flakyNotifier{failures: 3}
+	dead := newMapDeadLetters()
+	r, _ := newTestRetrier(inner, dead, 3)
+
+	alert := NewBudgetThresholdAlert(uuid.New(), uuid.New(), "Groceries", 90)
+	_ = r.Notify(context.Background(), alert) // exhausts retries, dead-letters
+
+	letters, _ := dead.List(context.Background())
+	if len(letters) != 1 {
+		t.Fatalf("dead letters = %d, want 1 before retry", len(letters))
+	}
+
+	// The endpoint has recovered; manual retry succeeds and clears the
+	// record.
+	if err := r.RetryDeadLetter(context.Background(), letters[0].ID); err != nil {
+		t.Fatalf("RetryDeadLetter: %v", err)
+	}
+	if remaining, _ := dead.List(context.Background()); len(remaining) != 0 {
+		t.Errorf("dead letters = %d after successful retry, want 0", len(remaining))
+	}
+}
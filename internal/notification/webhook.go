@@ -0,0 +1,66 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WebhookNotifier POSTs notifications as JSON to a configured endpoint.
+type WebhookNotifier struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewWebhookNotifier builds a webhook channel. A nil client gets a
+// default with a conservative timeout.
+func NewWebhookNotifier(endpoint string, client *http.Client) *WebhookNotifier {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &WebhookNotifier{endpoint: endpoint, client: client}
+}
+
+func (w *WebhookNotifier) Channel() string { return "webhook" }
+
+func (w *WebhookNotifier) Notify(ctx context.Context, n Notification) error {
+	payload, err := json.Marshal(webhookPayload{
+		ID:       n.ID.String(),
+		FamilyID: n.FamilyID.String(),
+		Type:     string(n.Type),
+		Title:    n.Title,
+		Message:  n.Message,
+		SentAt:   n.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("deliver webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook endpoint returned %d", resp.StatusCode)
+	}
+	return nil
+}
+
+type webhookPayload struct {
+	ID       string    `json:"id"`
+	FamilyID string    `json:"family_id"`
+	Type     string    `json:"type"`
+	Title    string    `json:"title"`
+	Message  string    `json:"message"`
+	SentAt   time.Time `json:"sent_at"`
+}
@@ -0,0 +1,233 @@
+// Package contract defines a behavioral test suite shared by every
+// repository implementation (in-memory, SQLite, Mongo). Implementations
+// wire their constructors into the suite from their own test files so
+// behavioral drift between backends is caught by the same assertions.
+package contract
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/pkg/uuid"
+)
+
+// Repositories bundles fresh, empty repository instances for one suite
+// run. Each factory call must return isolated storage.
+type Repositories struct {
+	Users        user.Repository
+	Families     user.FamilyRepository
+	Categories   category.Repository
+	Transactions transaction.Repository
+	Budgets      budget.Repository
+	Reports      report.Repository
+}
+
+// Factory returns a fresh set of empty repositories.
+type Factory func(t *testing.T) Repositories
+
+// Run executes the whole contract suite against the factory.
+func Run(t *testing.T, factory Factory) {
+	t.Run("Users", func(t *testing.T) { runUserContract(t, factory) })
+	t.Run("Categories", func(t *testing.T) { runCategoryContract(t, factory) })
+	t.Run("Transactions", func(t *testing.T) { runTransactionContract(t, factory) })
+	t.Run("Budgets", func(t *testing.T) { runBudgetContract(t, factory) })
+	t.Run("Reports", func(t *testing.T) { runReportContract(t, factory) })
+}
+
+func runUserContract(t *testing.T, factory Factory) {
+	ctx := context.Background()
+
+	t.Run("CreateGetUpdateDelete", func(t *testing.T) {
+		repos := factory(t)
+		u := &user.User{
+			ID: uuid.New(), Email: "member@example.com", FirstName: "Ann",
+			Role: user.RoleMember, FamilyID: uuid.New(),
+		}
+		if err := repos.Users.Create(ctx, u); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		got, err := repos.Users.GetByID(ctx, u.ID)
+		if err != nil || got.Email != u.Email {
+			t.Fatalf("GetByID = %+v, %v", got, err)
+		}
+		got.FirstName = "Anna"
+		if err := repos.Users.Update(ctx, got); err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+		again, _ := repos.Users.GetByID(ctx, u.ID)
+		if again.FirstName != "Anna" {
+			t.Errorf("update not persisted: %q", again.FirstName)
+		}
+		if err := repos.Users.Delete(ctx, u.ID); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		if _, err := repos.Users.GetByID(ctx, u.ID); !errors.Is(err, user.ErrNotFound) {
+			t.Errorf("after delete err = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repos := factory(t)
+		if _, err := repos.Users.GetByID(ctx, uuid.New()); !errors.Is(err, user.ErrNotFound) {
+			t.Errorf("GetByID err = %v, want ErrNotFound", err)
+		}
+		if err := repos.Users.Delete(ctx, uuid.New()); !errors.Is(err, user.ErrNotFound) {
+			t.Errorf("Delete err = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("EmailLookup", func(t *testing.T) {
+		repos := factory(t)
+		u := &user.User{ID: uuid.New(), Email: "lookup@example.com", FamilyID: uuid.New()}
+		if err := repos.Users.Create(ctx, u); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		got, err := repos.Users.GetByEmail(ctx, "lookup@example.com")
+		if err != nil || got.ID != u.ID {
+			t.Errorf("GetByEmail = %+v, %v", got, err)
+		}
+	})
+}
+
+func runCategoryContract(t *testing.T, factory Factory) {
+	ctx := context.Background()
+
+	t.Run("ListByFamilyAndType", func(t *testing.T) {
+		repos := factory(t)
+		familyID := uuid.New()
+		seed := []*category.Category{
+			{ID: uuid.New(), Name: "Food", Type: category.TypeExpense, FamilyID: familyID, IsActive: true},
+			{ID: uuid.New(), Name: "Salary", Type: category.TypeIncome, FamilyID: familyID, IsActive: true},
+			{ID: uuid.New(), Name: "Other family", Type: category.TypeExpense, FamilyID: uuid.New(), IsActive: true},
+		}
+		for _, c := range seed {
+			if err := repos.Categories.Create(ctx, c); err != nil {
+				t.Fatalf("Create: %v", err)
+			}
+		}
+		all, err := repos.Categories.GetByFamilyID(ctx, familyID)
+		if err != nil || len(all) != 2 {
+			t.Errorf("GetByFamilyID = %d categories, %v; want 2", len(all), err)
+		}
+		expenses, err := repos.Categories.GetByType(ctx, familyID, category.TypeExpense)
+		if err != nil || len(expenses) != 1 || expenses[0].Name != "Food" {
+			t.Errorf("GetByType = %+v, %v; want only Food", expenses, err)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repos := factory(t)
+		if _, err := repos.Categories.GetByID(ctx, uuid.New()); !errors.Is(err, category.ErrNotFound) {
+			t.Errorf("GetByID err = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func runTransactionContract(t *testing.T, factory Factory) {
+	ctx := context.Background()
+
+	t.Run("FilterByTypeDateAndCategory", func(t *testing.T) {
+		repos := factory(t)
+		familyID := uuid.New()
+		food, salary := uuid.New(), uuid.New()
+		jan := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+		feb := time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)
+		seed := []*transaction.Transaction{
+			{ID: uuid.New(), FamilyID: familyID, CategoryID: food, Type: transaction.TypeExpense, Amount: 50, Date: jan},
+			{ID: uuid.New(), FamilyID: familyID, CategoryID: food, Type: transaction.TypeExpense, Amount: 70, Date: feb},
+			{ID: uuid.New(), FamilyID: familyID, CategoryID: salary, Type: transaction.TypeIncome, Amount: 1000, Date: feb},
+		}
+		for _, tx := range seed {
+			if err := repos.Transactions.Create(ctx, tx); err != nil {
+				t.Fatalf("Create: %v", err)
+			}
+		}
+
+		expense := transaction.TypeExpense
+		got, err := repos.Transactions.GetByFilter(ctx, transaction.Filter{
+			FamilyID: familyID, Type: &expense, DateFrom: &feb,
+		})
+		if err != nil || len(got) != 1 || got[0].Amount != 70 {
+			t.Errorf("filtered = %+v, %v; want single 70 expense", got, err)
+		}
+
+		got, err = repos.Transactions.GetByFilter(ctx, transaction.Filter{
+			FamilyID: familyID, CategoryIDs: []uuid.UUID{food},
+		})
+		if err != nil || len(got) != 2 {
+			t.Errorf("category filter = %d rows, %v; want 2", len(got), err)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repos := factory(t)
+		if _, err := repos.Transactions.GetByID(ctx, uuid.New()); !errors.Is(err, transaction.ErrNotFound) {
+			t.Errorf("GetByID err = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func runBudgetContract(t *testing.T, factory Factory) {
+	ctx := context.Background()
+
+	t.Run("ActiveScoping", func(t *testing.T) {
+		repos := factory(t)
+		familyID := uuid.New()
+		active := &budget.Budget{ID: uuid.New(), Name: "Groceries", Amount: 500, FamilyID: familyID, IsActive: true}
+		inactive := &budget.Budget{ID: uuid.New(), Name: "Old", Amount: 100, FamilyID: familyID, IsActive: false}
+		for _, b := range []*budget.Budget{active, inactive} {
+			if err := repos.Budgets.Create(ctx, b); err != nil {
+				t.Fatalf("Create: %v", err)
+			}
+		}
+		got, err := repos.Budgets.GetActiveBudgets(ctx, familyID)
+		if err != nil || len(got) != 1 || got[0].ID != active.ID {
+			t.Errorf("GetActiveBudgets = %+v, %v; want only the active budget", got, err)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repos := factory(t)
+		if _, err := repos.Budgets.GetByID(ctx, uuid.New()); !errors.Is(err, budget.ErrNotFound) {
+			t.Errorf("GetByID err = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func runReportContract(t *testing.T, factory Factory) {
+	ctx := context.Background()
+
+	t.Run("OwnershipLookups", func(t *testing.T) {
+		repos := factory(t)
+		familyID, userID := uuid.New(), uuid.New()
+		r := &report.Report{
+			ID: uuid.New(), Name: "Monthly", Type: report.TypeExpenses,
+			Period: report.PeriodMonthly, FamilyID: familyID, UserID: userID,
+		}
+		if err := repos.Reports.Create(ctx, r); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		byFamily, err := repos.Reports.GetByFamilyID(ctx, familyID)
+		if err != nil || len(byFamily) != 1 {
+			t.Errorf("GetByFamilyID = %d, %v; want 1", len(byFamily), err)
+		}
+		byUser, err := repos.Reports.GetByUserID(ctx, userID)
+		if err != nil || len(byUser) != 1 {
+			t.Errorf("GetByUserID = %d, %v; want 1", len(byUser), err)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repos := factory(t)
+		if _, err := repos.Reports.GetByID(ctx, uuid.New()); !errors.Is(err, report.ErrNotFound) {
+			t.Errorf("GetByID err = %v, want ErrNotFound", err)
+		}
+	})
+}
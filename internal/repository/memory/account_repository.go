@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"family-budget-service/internal/domain/account"
+	"family-budget-service/pkg/uuid"
+)
+
+// AccountRepository is an in-memory account.Repository.
+type AccountRepository struct {
+	mu       sync.RWMutex
+	accounts map[uuid.UUID]account.Account
+}
+
+// NewAccountRepository builds an empty in-memory account repository.
+func NewAccountRepository() *AccountRepository {
+	return &AccountRepository{accounts: make(map[uuid.UUID]account.Account)}
+}
+
+func (r *AccountRepository) Create(_ context.Context, a *account.Account) error {
+	if a == nil || a.ID.IsNil() || a.FamilyID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.accounts[a.ID] = *a
+	return nil
+}
+
+func (r *AccountRepository) GetByID(_ context.Context, id uuid.UUID) (*account.Account, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	a, ok := r.accounts[id]
+	if !ok {
+		return nil, account.ErrNotFound
+	}
+	return &a, nil
+}
+
+func (r *AccountRepository) GetByFamilyID(_ context.Context, familyID uuid.UUID) ([]*account.Account, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*account.Account
+	for _, a := range r.accounts {
+		if a.FamilyID == familyID {
+			a := a
+			out = append(out, &a)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func (r *AccountRepository) Update(_ context.Context, a *account.Account) error {
+	if a == nil || a.ID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.accounts[a.ID]; !ok {
+		return account.ErrNotFound
+	}
+	r.accounts[a.ID] = *a
+	return nil
+}
+
+func (r *AccountRepository) Delete(_ context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.accounts[id]; !ok {
+		return account.ErrNotFound
+	}
+	delete(r.accounts, id)
+	return nil
+}
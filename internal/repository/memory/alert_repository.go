@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/pkg/uuid"
+)
+
+// AlertRepository is an in-memory budget.AlertRepository.
+type AlertRepository struct {
+	mu     sync.RWMutex
+	alerts map[uuid.UUID]budget.Alert
+}
+
+// NewAlertRepository builds an empty in-memory alert repository.
+func NewAlertRepository() *AlertRepository {
+	return &AlertRepository{alerts: make(map[uuid.UUID]budget.Alert)}
+}
+
+func (r *AlertRepository) Create(_ context.Context, a *budget.Alert) error {
+	if a == nil || a.ID.IsNil() || a.BudgetID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.alerts[a.ID] = *a
+	return nil
+}
+
+func (r *AlertRepository) GetByID(_ context.Context, id uuid.UUID) (*budget.Alert, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	a, ok := r.alerts[id]
+	if !ok {
+		return nil, budget.ErrAlertNotFound
+	}
+	return &a, nil
+}
+
+func (r *AlertRepository) GetByBudgetID(_ context.Context, budgetID uuid.UUID) ([]*budget.Alert, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*budget.Alert
+	for _, a := range r.alerts {
+		if a.BudgetID == budgetID {
+			a := a
+			out = append(out, &a)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ThresholdPercent < out[j].ThresholdPercent })
+	return out, nil
+}
+
+func (r *AlertRepository) Update(_ context.Context, a *budget.Alert) error {
+	if a == nil || a.ID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.alerts[a.ID]; !ok {
+		return budget.ErrAlertNotFound
+	}
+	r.alerts[a.ID] = *a
+	return nil
+}
+
+func (r *AlertRepository) Delete(_ context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.alerts[id]; !ok {
+		return budget.ErrAlertNotFound
+	}
+	delete(r.alerts, id)
+	return nil
+}
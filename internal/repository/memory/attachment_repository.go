@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// AttachmentRepository is an in-memory transaction.AttachmentRepository.
+type AttachmentRepository struct {
+	mu          sync.RWMutex
+	attachments map[uuid.UUID]transaction.Attachment
+}
+
+// NewAttachmentRepository builds an empty in-memory attachment
+// repository.
+func NewAttachmentRepository() *AttachmentRepository {
+	return &AttachmentRepository{attachments: make(map[uuid.UUID]transaction.Attachment)}
+}
+
+func (r *AttachmentRepository) Create(_ context.Context, a *transaction.Attachment) error {
+	if a == nil || a.ID.IsNil() || a.TransactionID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.attachments[a.ID] = *a
+	return nil
+}
+
+func (r *AttachmentRepository) GetByID(_ context.Context, id uuid.UUID) (*transaction.Attachment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	a, ok := r.attachments[id]
+	if !ok {
+		return nil, transaction.ErrAttachmentNotFound
+	}
+	return &a, nil
+}
+
+func (r *AttachmentRepository) GetByTransactionID(_ context.Context, transactionID uuid.UUID) ([]*transaction.Attachment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*transaction.Attachment
+	for _, a := range r.attachments {
+		if a.TransactionID == transactionID {
+			a := a
+			out = append(out, &a)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out, nil
+}
+
+func (r *AttachmentRepository) Delete(_ context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.attachments[id]; !ok {
+		return transaction.ErrAttachmentNotFound
+	}
+	delete(r.attachments, id)
+	return nil
+}
@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"family-budget-service/internal/domain/audit"
+	"family-budget-service/pkg/uuid"
+)
+
+// AuditRepository is an in-memory audit.Repository.
+type AuditRepository struct {
+	mu      sync.RWMutex
+	entries map[uuid.UUID]audit.Entry
+}
+
+// NewAuditRepository builds an empty in-memory audit repository.
+func NewAuditRepository() *AuditRepository {
+	return &AuditRepository{entries: make(map[uuid.UUID]audit.Entry)}
+}
+
+func (r *AuditRepository) Create(_ context.Context, e *audit.Entry) error {
+	if e == nil || e.ID.IsNil() || e.FamilyID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries[e.ID] = *e
+	return nil
+}
+
+func (r *AuditRepository) GetByFamilyID(_ context.Context, familyID uuid.UUID) ([]*audit.Entry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*audit.Entry
+	for _, e := range r.entries {
+		if e.FamilyID == familyID {
+			e := e
+			out = append(out, &e)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out, nil
+}
+
+func (r *AuditRepository) FamilyIDs(_ context.Context) ([]uuid.UUID, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	seen := make(map[uuid.UUID]bool)
+	var out []uuid.UUID
+	for _, e := range r.entries {
+		if !seen[e.FamilyID] {
+			seen[e.FamilyID] = true
+			out = append(out, e.FamilyID)
+		}
+	}
+	return out, nil
+}
+
+func (r *AuditRepository) DeleteOlderThan(_ context.Context, familyID uuid.UUID, cutoff time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	removed := 0
+	for id, e := range r.entries {
+		if e.FamilyID == familyID && e.CreatedAt.Before(cutoff) {
+			delete(r.entries, id)
+			removed++
+		}
+	}
+	return removed, nil
+}
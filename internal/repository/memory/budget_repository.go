@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/pkg/uuid"
+)
+
+// BudgetRepository is an in-memory budget.Repository.
+type BudgetRepository struct {
+	mu      sync.RWMutex
+	budgets map[uuid.UUID]budget.Budget
+}
+
+// NewBudgetRepository builds an empty in-memory budget repository.
+func NewBudgetRepository() *BudgetRepository {
+	return &BudgetRepository{budgets: make(map[uuid.UUID]budget.Budget)}
+}
+
+func (r *BudgetRepository) Create(_ context.Context, b *budget.Budget) error {
+	if b == nil || b.ID.IsNil() || b.FamilyID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if b.Version == 0 {
+		b.Version = 1
+	}
+	r.budgets[b.ID] = *b
+	return nil
+}
+
+func (r *BudgetRepository) GetByID(_ context.Context, id uuid.UUID) (*budget.Budget, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	b, ok := r.budgets[id]
+	if !ok {
+		return nil, budget.ErrNotFound
+	}
+	return &b, nil
+}
+
+func (r *BudgetRepository) GetByFamilyID(_ context.Context, familyID uuid.UUID) ([]*budget.Budget, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*budget.Budget
+	for _, b := range r.budgets {
+		if b.FamilyID == familyID {
+			b := b
+			out = append(out, &b)
+		}
+	}
+	return out, nil
+}
+
+func (r *BudgetRepository) GetActiveBudgets(_ context.Context, familyID uuid.UUID) ([]*budget.Budget, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*budget.Budget
+	for _, b := range r.budgets {
+		if b.FamilyID == familyID && b.IsActive {
+			b := b
+			out = append(out, &b)
+		}
+	}
+	return out, nil
+}
+
+func (r *BudgetRepository) Update(_ context.Context, b *budget.Budget) error {
+	if b == nil || b.ID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.budgets[b.ID]
+	if !ok {
+		return budget.ErrNotFound
+	}
+	// Optimistic concurrency: the caller must hold the stored version.
+	// A database-backed repository expresses the same check as a WHERE
+	// version = ? clause with rowsAffected == 0 meaning conflict.
+	if b.Version != stored.Version {
+		return budget.ErrVersionConflict
+	}
+	b.Version++
+	r.budgets[b.ID] = *b
+	return nil
+}
+
+func (r *BudgetRepository) Delete(_ context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.budgets[id]; !ok {
+		return budget.ErrNotFound
+	}
+	delete(r.budgets, id)
+	return nil
+}
@@ -0,0 +1,89 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/pkg/uuid"
+)
+
+// CategoryRepository is an in-memory category.Repository.
+type CategoryRepository struct {
+	mu         sync.RWMutex
+	categories map[uuid.UUID]category.Category
+}
+
+// NewCategoryRepository builds an empty in-memory category repository.
+func NewCategoryRepository() *CategoryRepository {
+	return &CategoryRepository{categories: make(map[uuid.UUID]category.Category)}
+}
+
+func (r *CategoryRepository) Create(_ context.Context, c *category.Category) error {
+	if c == nil || c.ID.IsNil() || c.FamilyID.IsNil() || c.Name == "" {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.categories[c.ID] = *c
+	return nil
+}
+
+func (r *CategoryRepository) GetByID(_ context.Context, id uuid.UUID) (*category.Category, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.categories[id]
+	if !ok {
+		return nil, category.ErrNotFound
+	}
+	return &c, nil
+}
+
+func (r *CategoryRepository) GetByFamilyID(_ context.Context, familyID uuid.UUID) ([]*category.Category, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*category.Category
+	for _, c := range r.categories {
+		if c.FamilyID == familyID {
+			c := c
+			out = append(out, &c)
+		}
+	}
+	return out, nil
+}
+
+func (r *CategoryRepository) GetByType(_ context.Context, familyID uuid.UUID, categoryType category.Type) ([]*category.Category, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*category.Category
+	for _, c := range r.categories {
+		if c.FamilyID == familyID && c.Type == categoryType {
+			c := c
+			out = append(out, &c)
+		}
+	}
+	return out, nil
+}
+
+func (r *CategoryRepository) Update(_ context.Context, c *category.Category) error {
+	if c == nil || c.ID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.categories[c.ID]; !ok {
+		return category.ErrNotFound
+	}
+	r.categories[c.ID] = *c
+	return nil
+}
+
+func (r *CategoryRepository) Delete(_ context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.categories[id]; !ok {
+		return category.ErrNotFound
+	}
+	delete(r.categories, id)
+	return nil
+}
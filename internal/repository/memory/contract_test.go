@@ -0,0 +1,23 @@
+package memory_test
+
+import (
+	"testing"
+
+	"family-budget-service/internal/repository/contract"
+	"family-budget-service/internal/repository/memory"
+)
+
+// TestMemoryRepositories_Contract runs the shared repository contract
+// suite against the in-memory implementations.
+func TestMemoryRepositories_Contract(t *testing.T) {
+	contract.Run(t, func(_ *testing.T) contract.Repositories {
+		return contract.Repositories{
+			Users:        memory.NewUserRepository(),
+			Families:     memory.NewFamilyRepository(),
+			Categories:   memory.NewCategoryRepository(),
+			Transactions: memory.NewTransactionRepository(),
+			Budgets:      memory.NewBudgetRepository(),
+			Reports:      memory.NewReportRepository(),
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"family-budget-service/internal/notification"
+	"family-budget-service/pkg/uuid"
+)
+
+// DeadLetterRepository is an in-memory notification.DeadLetterRepository.
+type DeadLetterRepository struct {
+	mu      sync.RWMutex
+	letters map[uuid.UUID]notification.DeadLetter
+}
+
+// NewDeadLetterRepository builds an empty in-memory dead-letter
+// repository.
+func NewDeadLetterRepository() *DeadLetterRepository {
+	return &DeadLetterRepository{letters: make(map[uuid.UUID]notification.DeadLetter)}
+}
+
+func (r *DeadLetterRepository) Add(_ context.Context, d *notification.DeadLetter) error {
+	if d == nil || d.ID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.letters[d.ID] = *d
+	return nil
+}
+
+func (r *DeadLetterRepository) GetByID(_ context.Context, id uuid.UUID) (*notification.DeadLetter, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	d, ok := r.letters[id]
+	if !ok {
+		return nil, notification.ErrDeadLetterNotFound
+	}
+	return &d, nil
+}
+
+func (r *DeadLetterRepository) List(_ context.Context) ([]*notification.DeadLetter, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]*notification.DeadLetter, 0, len(r.letters))
+	for _, d := range r.letters {
+		d := d
+		out = append(out, &d)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].FailedAt.Before(out[j].FailedAt) })
+	return out, nil
+}
+
+func (r *DeadLetterRepository) Remove(_ context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.letters[id]; !ok {
+		return notification.ErrDeadLetterNotFound
+	}
+	delete(r.letters, id)
+	return nil
+}
@@ -0,0 +1,6 @@
+// Package memory provides in-memory implementations of the repository
+// interfaces for unit tests and demo mode. They are safe for concurrent
+// use, enforce the same invariants as the database-backed repositories
+// (required IDs, family scoping, email uniqueness) and hand out copies so
+// callers cannot mutate stored state behind the repository's back.
+package memory
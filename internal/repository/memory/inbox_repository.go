@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"family-budget-service/internal/notification"
+	"family-budget-service/pkg/uuid"
+)
+
+// InboxRepository is an in-memory notification.InboxRepository.
+type InboxRepository struct {
+	mu    sync.RWMutex
+	items map[uuid.UUID]notification.InboxItem
+}
+
+// NewInboxRepository builds an empty in-memory inbox.
+func NewInboxRepository() *InboxRepository {
+	return &InboxRepository{items: make(map[uuid.UUID]notification.InboxItem)}
+}
+
+func (r *InboxRepository) Add(_ context.Context, n notification.Notification) error {
+	if n.ID.IsNil() || n.FamilyID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.items[n.ID] = notification.InboxItem{Notification: n}
+	return nil
+}
+
+func (r *InboxRepository) ListByUser(_ context.Context, userID uuid.UUID, unreadOnly bool) ([]*notification.InboxItem, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*notification.InboxItem
+	for _, item := range r.items {
+		if item.UserID != userID {
+			continue
+		}
+		if unreadOnly && item.Read {
+			continue
+		}
+		item := item
+		out = append(out, &item)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+	return out, nil
+}
+
+func (r *InboxRepository) MarkRead(_ context.Context, id, userID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	item, ok := r.items[id]
+	if !ok || item.UserID != userID {
+		return notification.ErrInboxItemNotFound
+	}
+	now := time.Now()
+	item.Read = true
+	item.ReadAt = &now
+	r.items[id] = item
+	return nil
+}
@@ -0,0 +1,313 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestUserRepository_EnforcesRequiredFieldsAndUniqueEmail(t *testing.T) {
+	repo := NewUserRepository()
+	ctx := context.Background()
+	familyID := uuid.New()
+
+	if err := repo.Create(ctx, &user.User{ID: uuid.New(), FamilyID: familyID}); !errors.Is(err, ErrInvalidEntity) {
+		t.Errorf("create without email: err = %v, want ErrInvalidEntity", err)
+	}
+
+	u := &user.User{ID: uuid.New(), Email: "a@example.com", FamilyID: familyID, Role: user.RoleAdmin}
+	if err := repo.Create(ctx, u); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	dup := &user.User{ID: uuid.New(), Email: "A@Example.com", FamilyID: familyID, Role: user.RoleMember}
+	if err := repo.Create(ctx, dup); !errors.Is(err, user.ErrEmailTaken) {
+		t.Errorf("duplicate email: err = %v, want ErrEmailTaken", err)
+	}
+}
+
+func TestUserRepository_FamilyScoping(t *testing.T) {
+	repo := NewUserRepository()
+	ctx := context.Background()
+	familyA, familyB := uuid.New(), uuid.New()
+
+	for i, familyID := range []uuid.UUID{familyA, familyA, familyB} {
+		u := &user.User{ID: uuid.New(), Email: string(rune('a'+i)) + "@example.com", FamilyID: familyID}
+		if err := repo.Create(ctx, u); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+
+	members, err := repo.GetByFamilyID(ctx, familyA)
+	if err != nil {
+		t.Fatalf("GetByFamilyID: %v", err)
+	}
+	if len(members) != 2 {
+		t.Errorf("family A has %d members, want 2", len(members))
+	}
+}
+
+func TestTransactionRepository_GetByFilterScopesAndSorts(t *testing.T) {
+	repo := NewTransactionRepository()
+	ctx := context.Background()
+	familyA, familyB := uuid.New(), uuid.New()
+	categoryID := uuid.New()
+
+	dates := []time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	for _, d := range dates {
+		err := repo.Create(ctx, &transaction.Transaction{
+			ID: uuid.New(), FamilyID: familyA, CategoryID: categoryID,
+			Type: transaction.TypeExpense, Amount: 10, Date: d,
+		})
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+	if err := repo.Create(ctx, &transaction.Transaction{
+		ID: uuid.New(), FamilyID: familyB, CategoryID: categoryID,
+		Type: transaction.TypeExpense, Amount: 99, Date: dates[0],
+	}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	got, err := repo.GetByFilter(ctx, transaction.Filter{FamilyID: familyA})
+	if err != nil {
+		t.Fatalf("GetByFilter: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("family A has %d transactions, want 3", len(got))
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i].Date.After(got[i-1].Date) {
+			t.Errorf("results not sorted by date descending: %v after %v", got[i].Date, got[i-1].Date)
+		}
+	}
+}
+
+func TestTransactionRepository_FamilyIndexSurvivesUpdatesAndDeletes(t *testing.T) {
+	repo := NewTransactionRepository()
+	ctx := context.Background()
+	familyA, familyB := uuid.New(), uuid.New()
+
+	ids := make([]uuid.UUID, 4)
+	for i := range ids {
+		ids[i] = uuid.New()
+		if err := repo.Create(ctx, &transaction.Transaction{
+			ID: ids[i], FamilyID: familyA, CategoryID: uuid.New(),
+			Type: transaction.TypeExpense, Amount: 10, Date: time.Now(),
+		}); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+
+	// Move one transaction to another family and delete another; the
+	// index must follow both.
+	moved, _ := repo.GetByID(ctx, ids[0])
+	moved.FamilyID = familyB
+	if err := repo.Update(ctx, moved); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if err := repo.Delete(ctx, ids[1]); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	gotA, err := repo.GetByFilter(ctx, transaction.Filter{FamilyID: familyA})
+	if err != nil {
+		t.Fatalf("GetByFilter: %v", err)
+	}
+	if len(gotA) != 2 {
+		t.Errorf("family A has %d transactions, want 2", len(gotA))
+	}
+	gotB, err := repo.GetByFilter(ctx, transaction.Filter{FamilyID: familyB})
+	if err != nil {
+		t.Fatalf("GetByFilter: %v", err)
+	}
+	if len(gotB) != 1 {
+		t.Errorf("family B has %d transactions, want 1", len(gotB))
+	}
+}
+
+func TestTransactionRepository_GetCategoryTotalsMatchesNaiveGrouping(t *testing.T) {
+	repo := NewTransactionRepository()
+	ctx := context.Background()
+	familyID := uuid.New()
+	categories := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	for i := 0; i < 30; i++ {
+		tx := &transaction.Transaction{
+			ID: uuid.New(), FamilyID: familyID, CategoryID: categories[i%len(categories)],
+			Type: transaction.TypeExpense, Amount: float64(i%7) + 0.5,
+			Date: time.Date(2024, 5, 1+i%28, 0, 0, 0, 0, time.UTC),
+		}
+		if i%10 == 0 {
+			tx.Splits = []transaction.Split{
+				{CategoryID: categories[0], Amount: tx.Amount / 2},
+				{CategoryID: categories[1], Amount: tx.Amount / 2},
+			}
+		}
+		if err := repo.Create(ctx, tx); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+
+	filter := transaction.Filter{FamilyID: familyID}
+	totals, err := repo.GetCategoryTotals(ctx, filter)
+	if err != nil {
+		t.Fatalf("GetCategoryTotals: %v", err)
+	}
+
+	// Naive grouping over the same filtered set must agree exactly.
+	transactions, err := repo.GetByFilter(ctx, filter)
+	if err != nil {
+		t.Fatalf("GetByFilter: %v", err)
+	}
+	wantAmount := make(map[uuid.UUID]float64)
+	wantCount := make(map[uuid.UUID]int)
+	for _, tx := range transactions {
+		for _, allocation := range tx.Allocations() {
+			wantAmount[allocation.CategoryID] += allocation.Amount
+			wantCount[allocation.CategoryID]++
+		}
+	}
+
+	if len(totals) != len(wantAmount) {
+		t.Fatalf("got %d categories, want %d", len(totals), len(wantAmount))
+	}
+	for _, total := range totals {
+		if total.Amount != wantAmount[total.CategoryID] {
+			t.Errorf("category %s amount = %v, want %v", total.CategoryID, total.Amount, wantAmount[total.CategoryID])
+		}
+		if total.Count != wantCount[total.CategoryID] {
+			t.Errorf("category %s count = %d, want %d", total.CategoryID, total.Count, wantCount[total.CategoryID])
+		}
+	}
+	for i := 1; i < len(totals); i++ {
+		if totals[i].Amount > totals[i-1].Amount {
+			t.Errorf("totals not sorted largest first: %v after %v", totals[i].Amount, totals[i-1].Amount)
+		}
+	}
+}
+
+func TestTransactionRepository_GetTotalMatchesSummedTransactions(t *testing.T) {
+	repo := NewTransactionRepository()
+	ctx := context.Background()
+	familyID := uuid.New()
+	start := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+
+	for i := 0; i < 20; i++ {
+		txType := transaction.TypeExpense
+		if i%3 == 0 {
+			txType = transaction.TypeIncome
+		}
+		// Every third transaction falls outside the window.
+		date := start.AddDate(0, 0, i)
+		if i%4 == 0 {
+			date = start.AddDate(0, -1, 0)
+		}
+		if err := repo.Create(ctx, &transaction.Transaction{
+			ID: uuid.New(), FamilyID: familyID, CategoryID: uuid.New(),
+			Type: txType, Amount: float64(i) + 0.25, Date: date,
+		}); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+
+	for _, txType := range []transaction.Type{transaction.TypeIncome, transaction.TypeExpense} {
+		total, err := repo.GetTotalByFamilyAndDateRange(ctx, familyID, start, end, txType)
+		if err != nil {
+			t.Fatalf("GetTotalByFamilyAndDateRange(%s): %v", txType, err)
+		}
+		matching, err := repo.GetByFilter(ctx, transaction.Filter{
+			FamilyID: familyID, Type: &txType, DateFrom: &start, DateTo: &end,
+		})
+		if err != nil {
+			t.Fatalf("GetByFilter: %v", err)
+		}
+		var want float64
+		for _, tx := range matching {
+			want += tx.Amount
+		}
+		if total != want {
+			t.Errorf("%s total = %v, want %v (summed in Go)", txType, total, want)
+		}
+	}
+}
+
+func TestTransactionRepository_GetMonthlyTotalsBucketsMatchSums(t *testing.T) {
+	repo := NewTransactionRepository()
+	ctx := context.Background()
+	familyID := uuid.New()
+	year := 2024
+
+	wantIncome := make(map[time.Month]float64)
+	wantExpenses := make(map[time.Month]float64)
+	for i := 0; i < 40; i++ {
+		month := time.Month(i%12 + 1)
+		txType := transaction.TypeExpense
+		amount := float64(i) + 0.75
+		if i%5 == 0 {
+			txType = transaction.TypeIncome
+		}
+		date := time.Date(year, month, i%27+1, 0, 0, 0, 0, time.UTC)
+		if i%9 == 0 {
+			date = date.AddDate(-1, 0, 0) // outside the year, ignored
+		} else if txType == transaction.TypeIncome {
+			wantIncome[month] += amount
+		} else {
+			wantExpenses[month] += amount
+		}
+		if err := repo.Create(ctx, &transaction.Transaction{
+			ID: uuid.New(), FamilyID: familyID, CategoryID: uuid.New(),
+			Type: txType, Amount: amount, Date: date,
+		}); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+
+	totals, err := repo.GetMonthlyTotals(ctx, familyID, year)
+	if err != nil {
+		t.Fatalf("GetMonthlyTotals: %v", err)
+	}
+	if len(totals) != 12 {
+		t.Fatalf("got %d buckets, want 12", len(totals))
+	}
+	for i, bucket := range totals {
+		if bucket.Month != time.Month(i+1) {
+			t.Errorf("bucket %d is %v, want %v", i, bucket.Month, time.Month(i+1))
+		}
+		if bucket.Income != wantIncome[bucket.Month] {
+			t.Errorf("%v income = %v, want %v", bucket.Month, bucket.Income, wantIncome[bucket.Month])
+		}
+		if bucket.Expenses != wantExpenses[bucket.Month] {
+			t.Errorf("%v expenses = %v, want %v", bucket.Month, bucket.Expenses, wantExpenses[bucket.Month])
+		}
+	}
+}
+
+func TestTransactionRepository_CopiesOnRead(t *testing.T) {
+	repo := NewTransactionRepository()
+	ctx := context.Background()
+	id := uuid.New()
+	if err := repo.Create(ctx, &transaction.Transaction{
+		ID: id, FamilyID: uuid.New(), Type: transaction.TypeExpense, Amount: 10, Date: time.Now(),
+	}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	first, _ := repo.GetByID(ctx, id)
+	first.Amount = 999
+
+	second, _ := repo.GetByID(ctx, id)
+	if second.Amount != 10 {
+		t.Errorf("stored amount mutated through returned pointer: %v", second.Amount)
+	}
+}
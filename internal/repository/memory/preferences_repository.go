@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"family-budget-service/internal/domain/preferences"
+	"family-budget-service/pkg/uuid"
+)
+
+// PreferencesRepository is an in-memory preferences.Repository keyed by
+// family, matching the one-record-per-family contract.
+type PreferencesRepository struct {
+	mu       sync.RWMutex
+	byFamily map[uuid.UUID]preferences.Preferences
+}
+
+// NewPreferencesRepository builds an empty in-memory preferences repository.
+func NewPreferencesRepository() *PreferencesRepository {
+	return &PreferencesRepository{byFamily: make(map[uuid.UUID]preferences.Preferences)}
+}
+
+func (r *PreferencesRepository) GetByFamilyID(_ context.Context, familyID uuid.UUID) (*preferences.Preferences, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.byFamily[familyID]
+	if !ok {
+		return nil, preferences.ErrNotFound
+	}
+	p.MemberSpendingLimits = cloneLimits(p.MemberSpendingLimits)
+	p.MonthlySummaryOptIns = cloneOptIns(p.MonthlySummaryOptIns)
+	return &p, nil
+}
+
+// cloneLimits copies the per-member limit map so callers cannot mutate
+// stored state through the value-copied struct.
+func cloneLimits(limits map[uuid.UUID]float64) map[uuid.UUID]float64 {
+	if limits == nil {
+		return nil
+	}
+	out := make(map[uuid.UUID]float64, len(limits))
+	for id, limit := range limits {
+		out[id] = limit
+	}
+	return out
+}
+
+// cloneOptIns copies the summary opt-in set for the same reason.
+func cloneOptIns(optIns map[uuid.UUID]bool) map[uuid.UUID]bool {
+	if optIns == nil {
+		return nil
+	}
+	out := make(map[uuid.UUID]bool, len(optIns))
+	for id, optedIn := range optIns {
+		out[id] = optedIn
+	}
+	return out
+}
+
+func (r *PreferencesRepository) Upsert(_ context.Context, p *preferences.Preferences) error {
+	if p == nil || p.FamilyID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.byFamily[p.FamilyID]; ok {
+		p.ID = existing.ID
+		p.CreatedAt = existing.CreatedAt
+	} else if p.ID.IsNil() {
+		p.ID = uuid.New()
+	}
+	stored := *p
+	stored.MemberSpendingLimits = cloneLimits(p.MemberSpendingLimits)
+	stored.MonthlySummaryOptIns = cloneOptIns(p.MonthlySummaryOptIns)
+	r.byFamily[p.FamilyID] = stored
+	return nil
+}
+
+func (r *PreferencesRepository) Delete(_ context.Context, familyID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.byFamily[familyID]; !ok {
+		return preferences.ErrNotFound
+	}
+	delete(r.byFamily, familyID)
+	return nil
+}
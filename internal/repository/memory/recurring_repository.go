@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// RecurringRepository is an in-memory transaction.RecurringRepository.
+type RecurringRepository struct {
+	mu        sync.RWMutex
+	templates map[uuid.UUID]transaction.RecurringTemplate
+}
+
+// NewRecurringRepository builds an empty in-memory recurring-template
+// repository.
+func NewRecurringRepository() *RecurringRepository {
+	return &RecurringRepository{templates: make(map[uuid.UUID]transaction.RecurringTemplate)}
+}
+
+func (r *RecurringRepository) Create(_ context.Context, t *transaction.RecurringTemplate) error {
+	if t == nil || t.ID.IsNil() || t.FamilyID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.templates[t.ID] = *t
+	return nil
+}
+
+func (r *RecurringRepository) GetByID(_ context.Context, id uuid.UUID) (*transaction.RecurringTemplate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.templates[id]
+	if !ok {
+		return nil, transaction.ErrRecurringNotFound
+	}
+	return &t, nil
+}
+
+func (r *RecurringRepository) GetByFamilyID(_ context.Context, familyID uuid.UUID) ([]*transaction.RecurringTemplate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*transaction.RecurringTemplate
+	for _, t := range r.templates {
+		if t.FamilyID == familyID {
+			t := t
+			out = append(out, &t)
+		}
+	}
+	return out, nil
+}
+
+func (r *RecurringRepository) GetDue(_ context.Context, now time.Time) ([]*transaction.RecurringTemplate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*transaction.RecurringTemplate
+	for _, t := range r.templates {
+		if t.IsActive && !t.NextOccurrence.After(now) {
+			t := t
+			out = append(out, &t)
+		}
+	}
+	return out, nil
+}
+
+func (r *RecurringRepository) Update(_ context.Context, t *transaction.RecurringTemplate) error {
+	if t == nil || t.ID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.templates[t.ID]; !ok {
+		return transaction.ErrRecurringNotFound
+	}
+	r.templates[t.ID] = *t
+	return nil
+}
+
+func (r *RecurringRepository) Delete(_ context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.templates[id]; !ok {
+		return transaction.ErrRecurringNotFound
+	}
+	delete(r.templates, id)
+	return nil
+}
@@ -0,0 +1,89 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/pkg/uuid"
+)
+
+// ReportRepository is an in-memory report.Repository.
+type ReportRepository struct {
+	mu      sync.RWMutex
+	reports map[uuid.UUID]report.Report
+}
+
+// NewReportRepository builds an empty in-memory report repository.
+func NewReportRepository() *ReportRepository {
+	return &ReportRepository{reports: make(map[uuid.UUID]report.Report)}
+}
+
+func (r *ReportRepository) Create(_ context.Context, rep *report.Report) error {
+	if rep == nil || rep.ID.IsNil() || rep.FamilyID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reports[rep.ID] = *rep
+	return nil
+}
+
+func (r *ReportRepository) GetByID(_ context.Context, id uuid.UUID) (*report.Report, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	rep, ok := r.reports[id]
+	if !ok {
+		return nil, report.ErrNotFound
+	}
+	return &rep, nil
+}
+
+func (r *ReportRepository) GetByFamilyID(_ context.Context, familyID uuid.UUID) ([]*report.Report, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*report.Report
+	for _, rep := range r.reports {
+		if rep.FamilyID == familyID {
+			rep := rep
+			out = append(out, &rep)
+		}
+	}
+	return out, nil
+}
+
+func (r *ReportRepository) GetByUserID(_ context.Context, userID uuid.UUID) ([]*report.Report, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*report.Report
+	for _, rep := range r.reports {
+		if rep.UserID == userID {
+			rep := rep
+			out = append(out, &rep)
+		}
+	}
+	return out, nil
+}
+
+func (r *ReportRepository) Update(_ context.Context, rep *report.Report) error {
+	if rep == nil || rep.ID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.reports[rep.ID]; !ok {
+		return report.ErrNotFound
+	}
+	r.reports[rep.ID] = *rep
+	return nil
+}
+
+func (r *ReportRepository) Delete(_ context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.reports[id]; !ok {
+		return report.ErrNotFound
+	}
+	delete(r.reports, id)
+	return nil
+}
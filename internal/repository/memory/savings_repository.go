@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"family-budget-service/internal/domain/savings"
+	"family-budget-service/pkg/uuid"
+)
+
+// SavingsRepository is an in-memory savings.Repository.
+type SavingsRepository struct {
+	mu    sync.RWMutex
+	goals map[uuid.UUID]savings.Goal
+}
+
+// NewSavingsRepository builds an empty in-memory savings repository.
+func NewSavingsRepository() *SavingsRepository {
+	return &SavingsRepository{goals: make(map[uuid.UUID]savings.Goal)}
+}
+
+func (r *SavingsRepository) Create(_ context.Context, g *savings.Goal) error {
+	if g == nil || g.ID.IsNil() || g.FamilyID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.goals[g.ID] = *g
+	return nil
+}
+
+func (r *SavingsRepository) GetByID(_ context.Context, id uuid.UUID) (*savings.Goal, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	g, ok := r.goals[id]
+	if !ok {
+		return nil, savings.ErrNotFound
+	}
+	return &g, nil
+}
+
+func (r *SavingsRepository) GetByFamilyID(_ context.Context, familyID uuid.UUID) ([]*savings.Goal, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*savings.Goal
+	for _, g := range r.goals {
+		if g.FamilyID == familyID {
+			g := g
+			out = append(out, &g)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].TargetDate.Before(out[j].TargetDate) })
+	return out, nil
+}
+
+func (r *SavingsRepository) Update(_ context.Context, g *savings.Goal) error {
+	if g == nil || g.ID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.goals[g.ID]; !ok {
+		return savings.ErrNotFound
+	}
+	r.goals[g.ID] = *g
+	return nil
+}
+
+func (r *SavingsRepository) Delete(_ context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.goals[id]; !ok {
+		return savings.ErrNotFound
+	}
+	delete(r.goals, id)
+	return nil
+}
@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/pkg/uuid"
+)
+
+// ScheduleRepository is an in-memory report.ScheduleRepository.
+type ScheduleRepository struct {
+	mu        sync.RWMutex
+	schedules map[uuid.UUID]report.Schedule
+}
+
+// NewScheduleRepository builds an empty in-memory schedule repository.
+func NewScheduleRepository() *ScheduleRepository {
+	return &ScheduleRepository{schedules: make(map[uuid.UUID]report.Schedule)}
+}
+
+func (r *ScheduleRepository) Create(_ context.Context, s *report.Schedule) error {
+	if s == nil || s.ID.IsNil() || s.FamilyID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.schedules[s.ID] = *s
+	return nil
+}
+
+func (r *ScheduleRepository) GetByID(_ context.Context, id uuid.UUID) (*report.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.schedules[id]
+	if !ok {
+		return nil, report.ErrScheduleNotFound
+	}
+	return &s, nil
+}
+
+func (r *ScheduleRepository) GetByFamilyID(_ context.Context, familyID uuid.UUID) ([]*report.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*report.Schedule
+	for _, s := range r.schedules {
+		if s.FamilyID == familyID {
+			s := s
+			out = append(out, &s)
+		}
+	}
+	return out, nil
+}
+
+func (r *ScheduleRepository) GetDue(_ context.Context, now time.Time) ([]*report.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*report.Schedule
+	for _, s := range r.schedules {
+		if s.IsActive && !s.NextRun.After(now) {
+			s := s
+			out = append(out, &s)
+		}
+	}
+	return out, nil
+}
+
+func (r *ScheduleRepository) Update(_ context.Context, s *report.Schedule) error {
+	if s == nil || s.ID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.schedules[s.ID]; !ok {
+		return report.ErrScheduleNotFound
+	}
+	r.schedules[s.ID] = *s
+	return nil
+}
+
+func (r *ScheduleRepository) Delete(_ context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.schedules[id]; !ok {
+		return report.ErrScheduleNotFound
+	}
+	delete(r.schedules, id)
+	return nil
+}
@@ -0,0 +1,198 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// TransactionRepository is an in-memory transaction.Repository.
+type TransactionRepository struct {
+	mu           sync.RWMutex
+	transactions map[uuid.UUID]transaction.Transaction
+	// byFamily indexes transaction IDs per family, the in-memory
+	// equivalent of a (family_id, date) composite index: family-scoped
+	// queries walk only that family's rows instead of the whole store.
+	byFamily map[uuid.UUID]map[uuid.UUID]struct{}
+}
+
+// NewTransactionRepository builds an empty in-memory transaction
+// repository.
+func NewTransactionRepository() *TransactionRepository {
+	return &TransactionRepository{
+		transactions: make(map[uuid.UUID]transaction.Transaction),
+		byFamily:     make(map[uuid.UUID]map[uuid.UUID]struct{}),
+	}
+}
+
+// index adds t to the family index; the caller holds the write lock.
+func (r *TransactionRepository) index(t *transaction.Transaction) {
+	ids, ok := r.byFamily[t.FamilyID]
+	if !ok {
+		ids = make(map[uuid.UUID]struct{})
+		r.byFamily[t.FamilyID] = ids
+	}
+	ids[t.ID] = struct{}{}
+}
+
+// unindex removes a transaction from the family index; the caller holds
+// the write lock.
+func (r *TransactionRepository) unindex(familyID, id uuid.UUID) {
+	if ids, ok := r.byFamily[familyID]; ok {
+		delete(ids, id)
+		if len(ids) == 0 {
+			delete(r.byFamily, familyID)
+		}
+	}
+}
+
+func (r *TransactionRepository) Create(_ context.Context, t *transaction.Transaction) error {
+	if t == nil || t.ID.IsNil() || t.FamilyID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.transactions[t.ID] = *t
+	r.index(t)
+	return nil
+}
+
+func (r *TransactionRepository) GetByID(_ context.Context, id uuid.UUID) (*transaction.Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.transactions[id]
+	if !ok {
+		return nil, transaction.ErrNotFound
+	}
+	return &t, nil
+}
+
+func (r *TransactionRepository) GetByFamilyID(ctx context.Context, familyID uuid.UUID) ([]*transaction.Transaction, error) {
+	return r.GetByFilter(ctx, transaction.Filter{FamilyID: familyID})
+}
+
+// GetByFilter applies the shared Filter.Matches semantics, sorted by
+// date descending like the database-backed queries. Family-scoped
+// filters read through the family index rather than scanning every
+// stored transaction.
+func (r *TransactionRepository) GetByFilter(_ context.Context, filter transaction.Filter) ([]*transaction.Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*transaction.Transaction
+	if !filter.FamilyID.IsNil() {
+		for id := range r.byFamily[filter.FamilyID] {
+			t := r.transactions[id]
+			if filter.Matches(&t) {
+				t := t
+				out = append(out, &t)
+			}
+		}
+	} else {
+		for _, t := range r.transactions {
+			if filter.Matches(&t) {
+				t := t
+				out = append(out, &t)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Date.After(out[j].Date) })
+	if filter.Offset > 0 {
+		if filter.Offset >= len(out) {
+			return nil, nil
+		}
+		out = out[filter.Offset:]
+	}
+	if filter.Limit > 0 && len(out) > filter.Limit {
+		out = out[:filter.Limit]
+	}
+	return out, nil
+}
+
+// GetFamilyStats aggregates a family's transactions using the shared
+// domain aggregation.
+func (r *TransactionRepository) GetFamilyStats(ctx context.Context, familyID uuid.UUID) (*transaction.FamilyStats, error) {
+	transactions, err := r.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+	return transaction.ComputeFamilyStats(transactions), nil
+}
+
+// GetTotalByFamilyAndDateRange sums one transaction type over a family
+// window in a single pass.
+func (r *TransactionRepository) GetTotalByFamilyAndDateRange(ctx context.Context, familyID uuid.UUID, start, end time.Time, txType transaction.Type) (float64, error) {
+	transactions, err := r.GetByFilter(ctx, transaction.Filter{
+		FamilyID: familyID,
+		Type:     &txType,
+		DateFrom: &start,
+		DateTo:   &end,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var total float64
+	for _, t := range transactions {
+		total += t.Amount
+	}
+	return total, nil
+}
+
+// GetMonthlyTotals buckets one year of a family's transactions by month
+// using the shared domain aggregation.
+func (r *TransactionRepository) GetMonthlyTotals(ctx context.Context, familyID uuid.UUID, year int) ([]transaction.MonthlyTotal, error) {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0).Add(-time.Nanosecond)
+	transactions, err := r.GetByFilter(ctx, transaction.Filter{
+		FamilyID: familyID,
+		DateFrom: &start,
+		DateTo:   &end,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return transaction.ComputeMonthlyTotals(transactions, year), nil
+}
+
+// GetCategoryTotals aggregates per-category totals for a filter window
+// using the shared domain aggregation.
+func (r *TransactionRepository) GetCategoryTotals(ctx context.Context, filter transaction.Filter) ([]transaction.CategoryTotal, error) {
+	transactions, err := r.GetByFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return transaction.ComputeCategoryTotals(transactions), nil
+}
+
+func (r *TransactionRepository) Update(_ context.Context, t *transaction.Transaction) error {
+	if t == nil || t.ID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.transactions[t.ID]
+	if !ok {
+		return transaction.ErrNotFound
+	}
+	if existing.FamilyID != t.FamilyID {
+		r.unindex(existing.FamilyID, t.ID)
+		r.index(t)
+	}
+	r.transactions[t.ID] = *t
+	return nil
+}
+
+func (r *TransactionRepository) Delete(_ context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.transactions[id]
+	if !ok {
+		return transaction.ErrNotFound
+	}
+	r.unindex(existing.FamilyID, id)
+	delete(r.transactions, id)
+	return nil
+}
@@ -0,0 +1,171 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrInvalidEntity is returned when a stored entity misses required
+// fields, mirroring the validation the database schemas enforce.
+var ErrInvalidEntity = errors.New("invalid entity")
+
+// UserRepository is an in-memory user.Repository.
+type UserRepository struct {
+	mu    sync.RWMutex
+	users map[uuid.UUID]user.User
+}
+
+// NewUserRepository builds an empty in-memory user repository.
+func NewUserRepository() *UserRepository {
+	return &UserRepository{users: make(map[uuid.UUID]user.User)}
+}
+
+func (r *UserRepository) Create(_ context.Context, u *user.User) error {
+	if u == nil || u.ID.IsNil() || u.FamilyID.IsNil() || u.Email == "" {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.users {
+		if strings.EqualFold(existing.Email, u.Email) {
+			return user.ErrEmailTaken
+		}
+	}
+	r.users[u.ID] = *u
+	return nil
+}
+
+func (r *UserRepository) GetByID(_ context.Context, id uuid.UUID) (*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	u, ok := r.users[id]
+	if !ok {
+		return nil, user.ErrNotFound
+	}
+	return &u, nil
+}
+
+func (r *UserRepository) GetByEmail(_ context.Context, email string) (*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, u := range r.users {
+		if strings.EqualFold(u.Email, email) {
+			u := u
+			return &u, nil
+		}
+	}
+	return nil, user.ErrNotFound
+}
+
+func (r *UserRepository) GetByFamilyID(_ context.Context, familyID uuid.UUID) ([]*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*user.User
+	for _, u := range r.users {
+		if u.FamilyID == familyID {
+			u := u
+			out = append(out, &u)
+		}
+	}
+	return out, nil
+}
+
+func (r *UserRepository) Update(_ context.Context, u *user.User) error {
+	if u == nil || u.ID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.users[u.ID]; !ok {
+		return user.ErrNotFound
+	}
+	for id, existing := range r.users {
+		if id != u.ID && strings.EqualFold(existing.Email, u.Email) {
+			return user.ErrEmailTaken
+		}
+	}
+	r.users[u.ID] = *u
+	return nil
+}
+
+func (r *UserRepository) Delete(_ context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.users[id]; !ok {
+		return user.ErrNotFound
+	}
+	delete(r.users, id)
+	return nil
+}
+
+// FamilyRepository is an in-memory user.FamilyRepository.
+type FamilyRepository struct {
+	mu       sync.RWMutex
+	families map[uuid.UUID]user.Family
+}
+
+// NewFamilyRepository builds an empty in-memory family repository.
+func NewFamilyRepository() *FamilyRepository {
+	return &FamilyRepository{families: make(map[uuid.UUID]user.Family)}
+}
+
+func (r *FamilyRepository) Create(_ context.Context, f *user.Family) error {
+	if f == nil || f.ID.IsNil() || f.Name == "" {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.families[f.ID] = *f
+	return nil
+}
+
+func (r *FamilyRepository) GetByID(_ context.Context, id uuid.UUID) (*user.Family, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	f, ok := r.families[id]
+	if !ok {
+		return nil, user.ErrFamilyNotFound
+	}
+	return &f, nil
+}
+
+func (r *FamilyRepository) List(_ context.Context) ([]*user.Family, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*user.Family
+	for _, f := range r.families {
+		f := f
+		out = append(out, &f)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func (r *FamilyRepository) Update(_ context.Context, f *user.Family) error {
+	if f == nil || f.ID.IsNil() {
+		return ErrInvalidEntity
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.families[f.ID]; !ok {
+		return user.ErrFamilyNotFound
+	}
+	r.families[f.ID] = *f
+	return nil
+}
+
+func (r *FamilyRepository) Delete(_ context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.families[id]; !ok {
+		return user.ErrFamilyNotFound
+	}
+	delete(r.families, id)
+	return nil
+}
@@ -0,0 +1,185 @@
+// Package scheduler runs due scheduled reports in the background. It
+// polls the schedule store on a fixed interval and executes everything
+// whose next-run time has passed, guarding against double-firing a
+// schedule whose execution outlives a tick.
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// Executor runs a single scheduled report. Implemented by
+// services.ReportService.
+type Executor interface {
+	ExecuteScheduledReport(ctx context.Context, scheduleID uuid.UUID) (*report.Report, error)
+}
+
+// RecurringMaterializer turns due recurring-transaction templates into
+// real transactions. Implemented by services.RecurringService.
+type RecurringMaterializer interface {
+	MaterializeDueRecurring(ctx context.Context, now time.Time) ([]*transaction.Transaction, error)
+}
+
+// RetentionPurger deletes records that outlived their retention window.
+// Implemented by services.AuditService.
+type RetentionPurger interface {
+	PurgeExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+// SummarySender emails periodic summaries that are due. Implemented by
+// services.MonthlySummaryService, which keeps its own once-per-month
+// guard so ticking it often is safe.
+type SummarySender interface {
+	SendMonthlySummaries(ctx context.Context, now time.Time) (int, error)
+}
+
+// Scheduler periodically fires due report schedules and recurring
+// transactions.
+type Scheduler struct {
+	schedules report.ScheduleRepository
+	executor  Executor
+	recurring RecurringMaterializer
+	retention RetentionPurger
+	trash     RetentionPurger
+	summaries SummarySender
+	interval  time.Duration
+	logger    *slog.Logger
+
+	mu       sync.Mutex
+	inFlight map[uuid.UUID]bool
+
+	wg sync.WaitGroup
+}
+
+// New builds a Scheduler. A non-positive interval falls back to one
+// minute; a nil logger falls back to slog.Default.
+func New(schedules report.ScheduleRepository, executor Executor, interval time.Duration, logger *slog.Logger) *Scheduler {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Scheduler{
+		schedules: schedules,
+		executor:  executor,
+		interval:  interval,
+		logger:    logger,
+		inFlight:  make(map[uuid.UUID]bool),
+	}
+}
+
+// Start launches the polling loop. It returns immediately; the loop
+// stops when ctx is canceled.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.runDue(ctx)
+			}
+		}
+	}()
+}
+
+// Stop blocks until the polling loop and any in-flight executions
+// observed by it have finished.
+func (s *Scheduler) Stop() {
+	s.wg.Wait()
+}
+
+// SetRecurringMaterializer enables recurring-transaction materialization
+// on the scheduler tick. A nil materializer leaves it off.
+func (s *Scheduler) SetRecurringMaterializer(m RecurringMaterializer) {
+	s.recurring = m
+}
+
+// SetRetentionPurger enables retention purging on the scheduler tick. A
+// nil purger leaves it off.
+func (s *Scheduler) SetRetentionPurger(p RetentionPurger) {
+	s.retention = p
+}
+
+// SetTrashPurger enables purging of soft-deleted transactions that
+// outlived the trash retention window. A nil purger leaves it off.
+func (s *Scheduler) SetTrashPurger(p RetentionPurger) {
+	s.trash = p
+}
+
+// SetSummarySender enables monthly summary emails on the scheduler
+// tick. A nil sender leaves them off.
+func (s *Scheduler) SetSummarySender(sender SummarySender) {
+	s.summaries = sender
+}
+
+// runDue executes every due schedule that isn't already running, then
+// materializes due recurring transactions and purges expired records.
+func (s *Scheduler) runDue(ctx context.Context) {
+	if s.recurring != nil {
+		if _, err := s.recurring.MaterializeDueRecurring(ctx, time.Now()); err != nil {
+			s.logger.Error("scheduler: materialize recurring transactions", "error", err)
+		}
+	}
+	if s.retention != nil {
+		if _, err := s.retention.PurgeExpired(ctx, time.Now()); err != nil {
+			s.logger.Error("scheduler: purge expired records", "error", err)
+		}
+	}
+	if s.trash != nil {
+		if _, err := s.trash.PurgeExpired(ctx, time.Now()); err != nil {
+			s.logger.Error("scheduler: purge trashed transactions", "error", err)
+		}
+	}
+	if s.summaries != nil {
+		if _, err := s.summaries.SendMonthlySummaries(ctx, time.Now()); err != nil {
+			s.logger.Error("scheduler: send monthly summaries", "error", err)
+		}
+	}
+	due, err := s.schedules.GetDue(ctx, time.Now())
+	if err != nil {
+		s.logger.Error("scheduler: load due schedules", "error", err)
+		return
+	}
+	for _, schedule := range due {
+		if !s.claim(schedule.ID) {
+			continue // still running from a previous tick
+		}
+		s.wg.Add(1)
+		go func(id uuid.UUID) {
+			defer s.wg.Done()
+			defer s.release(id)
+			if _, err := s.executor.ExecuteScheduledReport(ctx, id); err != nil {
+				s.logger.Error("scheduler: execute scheduled report", "schedule_id", id, "error", err)
+			}
+		}(schedule.ID)
+	}
+}
+
+func (s *Scheduler) claim(id uuid.UUID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.inFlight[id] {
+		return false
+	}
+	s.inFlight[id] = true
+	return true
+}
+
+func (s *Scheduler) release(id uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.inFlight, id)
+}
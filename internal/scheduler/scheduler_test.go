@@ -0,0 +1,109 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services"
+	"family-budget-service/pkg/uuid"
+)
+
+func seedDueSchedule(t *testing.T, schedules *memory.ScheduleRepository) *report.Schedule {
+	t.Helper()
+	s := &report.Schedule{
+		ID:         uuid.New(),
+		Name:       "Due now",
+		ReportType: report.TypeExpenses,
+		Period:     report.PeriodWeekly,
+		Frequency:  report.FrequencyWeekly,
+		FamilyID:   uuid.New(),
+		UserID:     uuid.New(),
+		NextRun:    time.Now().Add(-time.Hour),
+		IsActive:   true,
+	}
+	if err := schedules.Create(context.Background(), s); err != nil {
+		t.Fatalf("seed schedule: %v", err)
+	}
+	return s
+}
+
+func TestScheduler_ExecutesDueSchedules(t *testing.T) {
+	reports := memory.NewReportRepository()
+	schedules := memory.NewScheduleRepository()
+	svc := services.NewReportService(reports, memory.NewTransactionRepository(), nil, schedules, nil)
+	seeded := seedDueSchedule(t, schedules)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := New(schedules, svc, 5*time.Millisecond, nil)
+	s.Start(ctx)
+
+	deadline := time.After(2 * time.Second)
+	for {
+		generated, err := reports.GetByFamilyID(context.Background(), seeded.FamilyID)
+		if err != nil {
+			t.Fatalf("list reports: %v", err)
+		}
+		if len(generated) > 0 {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatal("scheduler never executed the due schedule")
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+	cancel()
+	s.Stop()
+
+	after, err := schedules.GetByID(context.Background(), seeded.ID)
+	if err != nil {
+		t.Fatalf("reload schedule: %v", err)
+	}
+	if !after.NextRun.After(time.Now()) {
+		t.Errorf("NextRun = %v, want advanced into the future", after.NextRun)
+	}
+}
+
+// slowExecutor blocks until released, counting concurrent executions per
+// schedule.
+type slowExecutor struct {
+	mu      sync.Mutex
+	started map[uuid.UUID]int
+	release chan struct{}
+}
+
+func (e *slowExecutor) ExecuteScheduledReport(_ context.Context, id uuid.UUID) (*report.Report, error) {
+	e.mu.Lock()
+	e.started[id]++
+	e.mu.Unlock()
+	<-e.release
+	return &report.Report{ID: uuid.New()}, nil
+}
+
+func TestScheduler_DoesNotDoubleFireSlowSchedule(t *testing.T) {
+	schedules := memory.NewScheduleRepository()
+	seeded := seedDueSchedule(t, schedules)
+
+	exec := &slowExecutor{started: make(map[uuid.UUID]int), release: make(chan struct{})}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := New(schedules, exec, 5*time.Millisecond, nil)
+	s.Start(ctx)
+
+	// Let several ticks pass while the first execution is still blocked;
+	// the schedule stays due the whole time because the executor never
+	// advances NextRun.
+	time.Sleep(60 * time.Millisecond)
+	cancel()
+	close(exec.release)
+	s.Stop()
+
+	exec.mu.Lock()
+	defer exec.mu.Unlock()
+	if got := exec.started[seeded.ID]; got != 1 {
+		t.Errorf("schedule executed %d times concurrently, want 1", got)
+	}
+}
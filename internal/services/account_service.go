@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/account"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrInvalidAccount rejects accounts without a name or with an unknown
+// type.
+var ErrInvalidAccount = errors.New("invalid account")
+
+// ErrInvalidTransfer rejects transfers with a non-positive amount, the
+// same account on both sides, or accounts from different families.
+var ErrInvalidTransfer = errors.New("invalid transfer")
+
+// AccountService manages accounts and the balances derived from their
+// transactions.
+type AccountService struct {
+	accountRepo  account.Repository
+	transactions *TransactionService
+}
+
+// NewAccountService builds an AccountService. Transfers and balance
+// queries go through the transaction service so they share its
+// validation.
+func NewAccountService(accountRepo account.Repository, transactions *TransactionService) *AccountService {
+	return &AccountService{accountRepo: accountRepo, transactions: transactions}
+}
+
+// CreateAccount validates and persists an account. An unset type
+// defaults to checking.
+func (s *AccountService) CreateAccount(ctx context.Context, a *account.Account) error {
+	if a.Type == "" {
+		a.Type = account.TypeChecking
+	}
+	if a.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidAccount)
+	}
+	if !account.ValidType(a.Type) {
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidAccount, a.Type)
+	}
+	if a.ID.IsNil() {
+		a.ID = uuid.New()
+	}
+	now := time.Now()
+	a.CreatedAt = now
+	a.UpdatedAt = now
+	if err := s.accountRepo.Create(ctx, a); err != nil {
+		return fmt.Errorf("create account: %w", err)
+	}
+	return nil
+}
+
+// GetAccountByID returns a single account.
+func (s *AccountService) GetAccountByID(ctx context.Context, id uuid.UUID) (*account.Account, error) {
+	return s.accountRepo.GetByID(ctx, id)
+}
+
+// GetAccountsByFamily returns a family's accounts, sorted by name.
+func (s *AccountService) GetAccountsByFamily(ctx context.Context, familyID uuid.UUID) ([]*account.Account, error) {
+	return s.accountRepo.GetByFamilyID(ctx, familyID)
+}
+
+// DeleteAccount removes an account. Its transactions keep their account
+// reference so history stays intact.
+func (s *AccountService) DeleteAccount(ctx context.Context, id uuid.UUID) error {
+	return s.accountRepo.Delete(ctx, id)
+}
+
+// GetAccountBalance derives an account's current balance: the opening
+// balance plus income recorded on it, minus expenses, adjusted by
+// transfers in and out.
+func (s *AccountService) GetAccountBalance(ctx context.Context, accountID uuid.UUID) (float64, error) {
+	a, err := s.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return 0, err
+	}
+	transactions, err := s.transactions.GetTransactionsByFilter(ctx, dto.TransactionFilterDTO{
+		FamilyID:  a.FamilyID,
+		AccountID: &accountID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	balance := a.OpeningBalance
+	for _, t := range transactions {
+		switch t.Type {
+		case transaction.TypeIncome:
+			balance = AddAmounts(balance, t.Amount)
+		case transaction.TypeExpense:
+			balance = AddAmounts(balance, -t.Amount)
+		case transaction.TypeTransfer:
+			if t.ToAccountID != nil && *t.ToAccountID == accountID {
+				balance = AddAmounts(balance, t.Amount)
+			}
+			if t.AccountID != nil && *t.AccountID == accountID {
+				balance = AddAmounts(balance, -t.Amount)
+			}
+		}
+	}
+	return balance, nil
+}
+
+// Transfer records a money movement between two of the family's accounts
+// as a single transfer transaction, so it never shows up as income or
+// expense in reports.
+func (s *AccountService) Transfer(
+	ctx context.Context,
+	fromAccountID, toAccountID, userID uuid.UUID,
+	amount float64,
+	date time.Time,
+) (*transaction.Transaction, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("%w: amount must be positive", ErrInvalidTransfer)
+	}
+	if fromAccountID == toAccountID {
+		return nil, fmt.Errorf("%w: source and destination are the same account", ErrInvalidTransfer)
+	}
+	from, err := s.accountRepo.GetByID(ctx, fromAccountID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.accountRepo.GetByID(ctx, toAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if from.FamilyID != to.FamilyID {
+		return nil, fmt.Errorf("%w: accounts belong to different families", ErrInvalidTransfer)
+	}
+
+	t := &transaction.Transaction{
+		Amount:      amount,
+		Type:        transaction.TypeTransfer,
+		Description: fmt.Sprintf("transfer %s -> %s", from.Name, to.Name),
+		UserID:      userID,
+		FamilyID:    from.FamilyID,
+		Date:        date,
+		AccountID:   &fromAccountID,
+		ToAccountID: &toAccountID,
+	}
+	if err := s.transactions.CreateTransaction(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
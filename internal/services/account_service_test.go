@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/account"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func newAccountFixture(t *testing.T) (*AccountService, *TransactionService, *memory.UserRepository) {
+	t.Helper()
+	users := memory.NewUserRepository()
+	txService := NewTransactionService(memory.NewTransactionRepository(), users)
+	return NewAccountService(memory.NewAccountRepository(), txService), txService, users
+}
+
+func seedAccount(t *testing.T, svc *AccountService, familyID uuid.UUID, name string, opening float64) *account.Account {
+	t.Helper()
+	a := &account.Account{FamilyID: familyID, Name: name, Type: account.TypeChecking, OpeningBalance: opening}
+	if err := svc.CreateAccount(context.Background(), a); err != nil {
+		t.Fatalf("CreateAccount: %v", err)
+	}
+	return a
+}
+
+func TestAccountBalance_AfterTransactions(t *testing.T) {
+	svc, txService, users := newAccountFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+	checking := seedAccount(t, svc, familyID, "checking", 100)
+	cash := seedAccount(t, svc, familyID, "cash", 50)
+
+	salary := expenseFor(familyID, u.ID)
+	salary.Type = transaction.TypeIncome
+	salary.Amount = 200
+	salary.AccountID = &checking.ID
+	groceries := expenseFor(familyID, u.ID)
+	groceries.Amount = 80
+	groceries.AccountID = &checking.ID
+	coffee := expenseFor(familyID, u.ID)
+	coffee.Amount = 5
+	coffee.AccountID = &cash.ID
+	for _, tx := range []*transaction.Transaction{salary, groceries, coffee} {
+		if err := txService.CreateTransaction(context.Background(), tx); err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+	}
+
+	balance, err := svc.GetAccountBalance(context.Background(), checking.ID)
+	if err != nil || balance != 220 {
+		t.Errorf("checking balance = %v, %v; want 100+200-80 = 220", balance, err)
+	}
+	balance, err = svc.GetAccountBalance(context.Background(), cash.ID)
+	if err != nil || balance != 45 {
+		t.Errorf("cash balance = %v, %v; want 50-5 = 45", balance, err)
+	}
+}
+
+func TestTransfer_MovesBalanceWithoutAffectingNetIncome(t *testing.T) {
+	svc, txService, users := newAccountFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+	checking := seedAccount(t, svc, familyID, "checking", 300)
+	cash := seedAccount(t, svc, familyID, "cash", 0)
+
+	if _, err := svc.Transfer(context.Background(), checking.ID, cash.ID, u.ID, 120, time.Now()); err != nil {
+		t.Fatalf("Transfer: %v", err)
+	}
+
+	balance, err := svc.GetAccountBalance(context.Background(), checking.ID)
+	if err != nil || balance != 180 {
+		t.Errorf("source balance = %v, %v; want 180", balance, err)
+	}
+	balance, err = svc.GetAccountBalance(context.Background(), cash.ID)
+	if err != nil || balance != 120 {
+		t.Errorf("destination balance = %v, %v; want 120", balance, err)
+	}
+
+	stats, err := txService.GetFamilyStatistics(context.Background(), familyID)
+	if err != nil {
+		t.Fatalf("GetFamilyStatistics: %v", err)
+	}
+	if stats.TotalIncome != 0 || stats.TotalExpenses != 0 {
+		t.Errorf("transfer counted as income %v / expenses %v; want neither", stats.TotalIncome, stats.TotalExpenses)
+	}
+}
+
+func TestTransfer_Validation(t *testing.T) {
+	svc, _, users := newAccountFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+	checking := seedAccount(t, svc, familyID, "checking", 100)
+	other := seedAccount(t, svc, uuid.New(), "other family", 100)
+
+	if _, err := svc.Transfer(context.Background(), checking.ID, checking.ID, u.ID, 10, time.Now()); !errors.Is(err, ErrInvalidTransfer) {
+		t.Errorf("same-account err = %v, want ErrInvalidTransfer", err)
+	}
+	if _, err := svc.Transfer(context.Background(), checking.ID, other.ID, u.ID, 10, time.Now()); !errors.Is(err, ErrInvalidTransfer) {
+		t.Errorf("cross-family err = %v, want ErrInvalidTransfer", err)
+	}
+	if _, err := svc.Transfer(context.Background(), checking.ID, other.ID, u.ID, -5, time.Now()); !errors.Is(err, ErrInvalidTransfer) {
+		t.Errorf("negative amount err = %v, want ErrInvalidTransfer", err)
+	}
+}
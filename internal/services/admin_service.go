@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+)
+
+// adminTopCategoryCount bounds the system-wide top category list.
+const adminTopCategoryCount = 5
+
+// SystemStats are read-only aggregates over every family, for operator
+// health dashboards.
+type SystemStats struct {
+	Families      int
+	Users         int
+	Transactions  int
+	TotalIncome   float64
+	TotalExpenses float64
+	// TopCategories are the largest expense categories across all
+	// families, largest first.
+	TopCategories []transaction.CategoryTotal
+}
+
+// AdminService computes operator-facing aggregates across families. It
+// is read-only and must only be exposed behind operator authentication.
+type AdminService struct {
+	familyRepo      user.FamilyRepository
+	userRepo        user.Repository
+	transactionRepo transaction.Repository
+}
+
+// NewAdminService builds an AdminService.
+func NewAdminService(familyRepo user.FamilyRepository, userRepo user.Repository, transactionRepo transaction.Repository) *AdminService {
+	return &AdminService{
+		familyRepo:      familyRepo,
+		userRepo:        userRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// GetSystemStats aggregates counts and volume over the whole system.
+func (s *AdminService) GetSystemStats(ctx context.Context) (*SystemStats, error) {
+	stats := &SystemStats{}
+
+	families, err := s.familyRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list families: %w", err)
+	}
+	stats.Families = len(families)
+	for _, f := range families {
+		members, err := s.userRepo.GetByFamilyID(ctx, f.ID)
+		if err != nil {
+			return nil, fmt.Errorf("load members of family %s: %w", f.ID, err)
+		}
+		stats.Users += len(members)
+	}
+
+	// An empty filter spans every family.
+	transactions, err := s.transactionRepo.GetByFilter(ctx, transaction.Filter{})
+	if err != nil {
+		return nil, fmt.Errorf("load transactions: %w", err)
+	}
+	var expenses []*transaction.Transaction
+	for _, t := range transactions {
+		stats.Transactions++
+		switch t.Type {
+		case transaction.TypeIncome:
+			stats.TotalIncome = AddAmounts(stats.TotalIncome, t.Amount)
+		case transaction.TypeExpense:
+			stats.TotalExpenses = AddAmounts(stats.TotalExpenses, t.Amount)
+			expenses = append(expenses, t)
+		}
+	}
+	totals := transaction.ComputeCategoryTotals(expenses)
+	if len(totals) > adminTopCategoryCount {
+		totals = totals[:adminTopCategoryCount]
+	}
+	stats.TopCategories = totals
+	return stats, nil
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// DefaultMaxAttachmentBytes caps receipt uploads at 5 MiB.
+const DefaultMaxAttachmentBytes = 5 << 20
+
+// ErrUnsupportedAttachmentType rejects uploads that are neither images
+// nor PDFs.
+var ErrUnsupportedAttachmentType = errors.New("unsupported attachment type")
+
+// ErrAttachmentTooLarge rejects uploads over the configured size limit.
+var ErrAttachmentTooLarge = errors.New("attachment too large")
+
+// attachmentContentTypes is the allow-list of uploadable content types.
+var attachmentContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+}
+
+// AttachmentStorage is the pluggable blob store behind attachments.
+// Keys are opaque; the service uses attachment IDs.
+type AttachmentStorage interface {
+	Save(ctx context.Context, key string, r io.Reader) (int64, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// DiskStorage is an AttachmentStorage keeping blobs as flat files under
+// one directory.
+type DiskStorage struct {
+	dir string
+}
+
+// NewDiskStorage builds a DiskStorage rooted at dir. The directory is
+// created on first save.
+func NewDiskStorage(dir string) *DiskStorage {
+	return &DiskStorage{dir: dir}
+}
+
+func (s *DiskStorage) Save(_ context.Context, key string, r io.Reader) (int64, error) {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return 0, fmt.Errorf("create attachment directory: %w", err)
+	}
+	f, err := os.Create(filepath.Join(s.dir, key))
+	if err != nil {
+		return 0, fmt.Errorf("create attachment file: %w", err)
+	}
+	n, err := io.Copy(f, r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return n, fmt.Errorf("write attachment file: %w", err)
+	}
+	return n, nil
+}
+
+func (s *DiskStorage) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	f, err := os.Open(filepath.Join(s.dir, key))
+	if os.IsNotExist(err) {
+		return nil, transaction.ErrAttachmentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open attachment file: %w", err)
+	}
+	return f, nil
+}
+
+func (s *DiskStorage) Delete(_ context.Context, key string) error {
+	if err := os.Remove(filepath.Join(s.dir, key)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove attachment file: %w", err)
+	}
+	return nil
+}
+
+// AttachmentService stores receipts attached to transactions.
+type AttachmentService struct {
+	attachmentRepo  transaction.AttachmentRepository
+	transactionRepo transaction.Repository
+	storage         AttachmentStorage
+
+	maxAttachmentBytes int64
+}
+
+// NewAttachmentService builds an AttachmentService.
+func NewAttachmentService(attachmentRepo transaction.AttachmentRepository, transactionRepo transaction.Repository, storage AttachmentStorage) *AttachmentService {
+	return &AttachmentService{
+		attachmentRepo:     attachmentRepo,
+		transactionRepo:    transactionRepo,
+		storage:            storage,
+		maxAttachmentBytes: DefaultMaxAttachmentBytes,
+	}
+}
+
+// SetMaxAttachmentBytes overrides the upload size limit. Non-positive
+// values restore the default.
+func (s *AttachmentService) SetMaxAttachmentBytes(limit int64) {
+	if limit <= 0 {
+		limit = DefaultMaxAttachmentBytes
+	}
+	s.maxAttachmentBytes = limit
+}
+
+// UploadAttachment validates and stores a receipt for one of the
+// family's transactions.
+func (s *AttachmentService) UploadAttachment(ctx context.Context, familyID, transactionID uuid.UUID, fileName, contentType string, r io.Reader) (*transaction.Attachment, error) {
+	if !attachmentContentTypes[contentType] {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAttachmentType, contentType)
+	}
+	t, err := s.transactionRepo.GetByID(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("load transaction: %w", err)
+	}
+	if t.FamilyID != familyID {
+		return nil, fmt.Errorf("%w: %s", ErrCrossFamilyTransaction, transactionID)
+	}
+
+	a := &transaction.Attachment{
+		ID:            uuid.New(),
+		TransactionID: transactionID,
+		FamilyID:      familyID,
+		FileName:      fileName,
+		ContentType:   contentType,
+		CreatedAt:     time.Now(),
+	}
+	// Read one byte past the limit so an oversized upload fails instead
+	// of being silently truncated.
+	size, err := s.storage.Save(ctx, a.ID.String(), io.LimitReader(r, s.maxAttachmentBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if size > s.maxAttachmentBytes {
+		if err := s.storage.Delete(ctx, a.ID.String()); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: limit %d bytes", ErrAttachmentTooLarge, s.maxAttachmentBytes)
+	}
+	a.Size = size
+	if err := s.attachmentRepo.Create(ctx, a); err != nil {
+		return nil, fmt.Errorf("create attachment: %w", err)
+	}
+	return a, nil
+}
+
+// OpenAttachment returns an attachment's metadata and a reader over its
+// bytes, rejecting callers from another family. The caller closes the
+// reader.
+func (s *AttachmentService) OpenAttachment(ctx context.Context, familyID, attachmentID uuid.UUID) (*transaction.Attachment, io.ReadCloser, error) {
+	a, err := s.attachmentRepo.GetByID(ctx, attachmentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("load attachment: %w", err)
+	}
+	if a.FamilyID != familyID {
+		return nil, nil, fmt.Errorf("%w: %s", ErrCrossFamilyTransaction, a.TransactionID)
+	}
+	blob, err := s.storage.Open(ctx, a.ID.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	return a, blob, nil
+}
+
+// GetAttachmentsByTransaction lists a transaction's attachments.
+func (s *AttachmentService) GetAttachmentsByTransaction(ctx context.Context, transactionID uuid.UUID) ([]*transaction.Attachment, error) {
+	return s.attachmentRepo.GetByTransactionID(ctx, transactionID)
+}
+
+// DeleteTransactionAttachments removes every attachment of a deleted
+// transaction, blobs included. It satisfies AttachmentCleaner.
+func (s *AttachmentService) DeleteTransactionAttachments(ctx context.Context, transactionID uuid.UUID) error {
+	attachments, err := s.attachmentRepo.GetByTransactionID(ctx, transactionID)
+	if err != nil {
+		return fmt.Errorf("load attachments: %w", err)
+	}
+	for _, a := range attachments {
+		if err := s.storage.Delete(ctx, a.ID.String()); err != nil {
+			return err
+		}
+		if err := s.attachmentRepo.Delete(ctx, a.ID); err != nil {
+			return fmt.Errorf("delete attachment %s: %w", a.ID, err)
+		}
+	}
+	return nil
+}
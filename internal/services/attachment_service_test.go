@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func newAttachmentServiceFixture(t *testing.T) (*AttachmentService, *TransactionService, *memory.TransactionRepository, *memory.AttachmentRepository) {
+	t.Helper()
+	transactions := memory.NewTransactionRepository()
+	attachments := memory.NewAttachmentRepository()
+	transactionService := NewTransactionService(transactions, memory.NewUserRepository())
+	svc := NewAttachmentService(attachments, transactions, NewDiskStorage(t.TempDir()))
+	transactionService.SetAttachmentCleaner(svc)
+	return svc, transactionService, transactions, attachments
+}
+
+func TestUploadAttachment_EnforcesSizeLimit(t *testing.T) {
+	svc, _, transactions, _ := newAttachmentServiceFixture(t)
+	familyID := uuid.New()
+	tr := &transaction.Transaction{
+		ID: uuid.New(), Amount: 10, Type: transaction.TypeExpense,
+		CategoryID: uuid.New(), UserID: uuid.New(), FamilyID: familyID, Date: time.Now(),
+	}
+	if err := transactions.Create(context.Background(), tr); err != nil {
+		t.Fatalf("seed transaction: %v", err)
+	}
+	svc.SetMaxAttachmentBytes(8)
+
+	_, err := svc.UploadAttachment(context.Background(), familyID, tr.ID,
+		"receipt.png", "image/png", strings.NewReader("123456789"))
+	if !errors.Is(err, ErrAttachmentTooLarge) {
+		t.Errorf("err = %v, want ErrAttachmentTooLarge", err)
+	}
+	if _, err := svc.UploadAttachment(context.Background(), familyID, tr.ID,
+		"receipt.png", "image/png", strings.NewReader("12345678")); err != nil {
+		t.Errorf("upload at the limit rejected: %v", err)
+	}
+}
+
+func TestResolveDuplicates_RemovesAttachments(t *testing.T) {
+	svc, transactionService, transactions, attachmentRepo := newAttachmentServiceFixture(t)
+	familyID := uuid.New()
+	seed := func() *transaction.Transaction {
+		tr := &transaction.Transaction{
+			ID: uuid.New(), Amount: 10, Type: transaction.TypeExpense,
+			Description: "coffee", CategoryID: uuid.New(), UserID: uuid.New(),
+			FamilyID: familyID, Date: time.Now(),
+		}
+		if err := transactions.Create(context.Background(), tr); err != nil {
+			t.Fatalf("seed transaction: %v", err)
+		}
+		return tr
+	}
+	keep, dupe := seed(), seed()
+	a, err := svc.UploadAttachment(context.Background(), familyID, dupe.ID,
+		"receipt.png", "image/png", strings.NewReader("png bytes"))
+	if err != nil {
+		t.Fatalf("upload: %v", err)
+	}
+
+	if _, err := transactionService.ResolveDuplicates(context.Background(), familyID, keep.ID, []uuid.UUID{dupe.ID}); err != nil {
+		t.Fatalf("ResolveDuplicates: %v", err)
+	}
+	if _, err := attachmentRepo.GetByID(context.Background(), a.ID); !errors.Is(err, transaction.ErrAttachmentNotFound) {
+		t.Errorf("err = %v, want the attachment gone with its transaction", err)
+	}
+	if _, _, err := svc.OpenAttachment(context.Background(), familyID, a.ID); err == nil {
+		t.Errorf("blob should be gone with its transaction")
+	}
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/audit"
+	"family-budget-service/internal/domain/preferences"
+	"family-budget-service/pkg/uuid"
+)
+
+// DefaultAuditRetentionDays is how long audit entries are kept for
+// families that have not configured their own retention.
+const DefaultAuditRetentionDays = 90
+
+// AuditService records audit entries and purges the ones past their
+// family's retention window.
+type AuditService struct {
+	auditRepo       audit.Repository
+	preferencesRepo preferences.Repository
+}
+
+// NewAuditService builds an AuditService. preferencesRepo may be nil;
+// every family then uses the default retention.
+func NewAuditService(auditRepo audit.Repository, preferencesRepo preferences.Repository) *AuditService {
+	return &AuditService{auditRepo: auditRepo, preferencesRepo: preferencesRepo}
+}
+
+// Record appends one audit entry, assigning its ID and timestamp.
+func (s *AuditService) Record(ctx context.Context, e *audit.Entry) error {
+	if e.ID.IsNil() {
+		e.ID = uuid.New()
+	}
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now()
+	}
+	if err := s.auditRepo.Create(ctx, e); err != nil {
+		return fmt.Errorf("record audit entry: %w", err)
+	}
+	return nil
+}
+
+// GetByFamily lists a family's audit entries, oldest first.
+func (s *AuditService) GetByFamily(ctx context.Context, familyID uuid.UUID) ([]*audit.Entry, error) {
+	return s.auditRepo.GetByFamilyID(ctx, familyID)
+}
+
+// PurgeExpired deletes every family's audit entries older than its
+// retention window as of now, and reports how many were removed. It is
+// the scheduler's retention hook.
+func (s *AuditService) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	familyIDs, err := s.auditRepo.FamilyIDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list audited families: %w", err)
+	}
+	purged := 0
+	for _, familyID := range familyIDs {
+		cutoff := now.AddDate(0, 0, -s.retentionDays(ctx, familyID))
+		n, err := s.auditRepo.DeleteOlderThan(ctx, familyID, cutoff)
+		if err != nil {
+			return purged, fmt.Errorf("purge audit entries for family %s: %w", familyID, err)
+		}
+		purged += n
+	}
+	return purged, nil
+}
+
+// retentionDays resolves a family's configured retention, falling back
+// to the service default when unset or unavailable.
+func (s *AuditService) retentionDays(ctx context.Context, familyID uuid.UUID) int {
+	if s.preferencesRepo == nil {
+		return DefaultAuditRetentionDays
+	}
+	p, err := s.preferencesRepo.GetByFamilyID(ctx, familyID)
+	if err != nil || p.AuditRetentionDays <= 0 {
+		return DefaultAuditRetentionDays
+	}
+	return p.AuditRetentionDays
+}
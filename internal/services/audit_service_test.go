@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/audit"
+	"family-budget-service/internal/domain/preferences"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func recordAuditAt(t *testing.T, svc *AuditService, familyID uuid.UUID, at time.Time) *audit.Entry {
+	t.Helper()
+	e := &audit.Entry{
+		FamilyID: familyID, UserID: uuid.New(),
+		Action: "update", Entity: "budget", CreatedAt: at,
+	}
+	if err := svc.Record(context.Background(), e); err != nil {
+		t.Fatalf("record audit entry: %v", err)
+	}
+	return e
+}
+
+func TestPurgeExpired_DefaultRetention(t *testing.T) {
+	svc := NewAuditService(memory.NewAuditRepository(), nil)
+	familyID := uuid.New()
+	now := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	recordAuditAt(t, svc, familyID, now.AddDate(0, 0, -DefaultAuditRetentionDays-1)) // past retention
+	kept := recordAuditAt(t, svc, familyID, now.AddDate(0, 0, -5))
+
+	purged, err := svc.PurgeExpired(context.Background(), now)
+	if err != nil || purged != 1 {
+		t.Fatalf("purged = %d, %v; want 1", purged, err)
+	}
+	remaining, err := svc.GetByFamily(context.Background(), familyID)
+	if err != nil || len(remaining) != 1 || remaining[0].ID != kept.ID {
+		t.Errorf("remaining = %d entries, %v; want only the recent one", len(remaining), err)
+	}
+}
+
+func TestPurgeExpired_PerFamilyRetention(t *testing.T) {
+	prefs := memory.NewPreferencesRepository()
+	svc := NewAuditService(memory.NewAuditRepository(), prefs)
+	shortFamily := uuid.New()
+	defaultFamily := uuid.New()
+	now := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	// shortFamily keeps only a week of history.
+	if err := prefs.Upsert(context.Background(),
+		&preferences.Preferences{FamilyID: shortFamily, AuditRetentionDays: 7}); err != nil {
+		t.Fatalf("seed preferences: %v", err)
+	}
+
+	recordAuditAt(t, svc, shortFamily, now.AddDate(0, 0, -10))   // past the 7-day window
+	recordAuditAt(t, svc, defaultFamily, now.AddDate(0, 0, -10)) // well within the default
+
+	purged, err := svc.PurgeExpired(context.Background(), now)
+	if err != nil || purged != 1 {
+		t.Fatalf("purged = %d, %v; want only the short-retention family's entry", purged, err)
+	}
+	if remaining, _ := svc.GetByFamily(context.Background(), shortFamily); len(remaining) != 0 {
+		t.Errorf("short-retention family kept %d entries, want 0", len(remaining))
+	}
+	if remaining, _ := svc.GetByFamily(context.Background(), defaultFamily); len(remaining) != 1 {
+		t.Errorf("default-retention family kept %d entries, want 1", len(remaining))
+	}
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// BudgetAlertStatus is the alert evaluation of a single budget. Thresholds
+// holds the percentages that were checked — the budget's configured alerts
+// when it has any, otherwise the global defaults.
+type BudgetAlertStatus struct {
+	BudgetID            uuid.UUID
+	BudgetName          string
+	SpentPercent        float64
+	Thresholds          []float64
+	CustomThresholds    bool
+	TriggeredThresholds []float64
+	Triggered           bool
+}
+
+// AlertEvaluation aggregates the alert status of a family's active budgets.
+type AlertEvaluation struct {
+	Budgets        []BudgetAlertStatus
+	TriggeredCount int
+	HealthyCount   int
+}
+
+// defaultAlertThresholds are evaluated for budgets without configured alerts.
+var defaultAlertThresholds = []float64{
+	BudgetWarningThreshold,
+	BudgetCriticalThreshold,
+	BudgetExceededThreshold,
+}
+
+// EvaluateAlerts checks every active budget of a family against its
+// configured alert thresholds, falling back to the global defaults for
+// budgets that have none. A budget at 55% spend with a custom 50% alert
+// counts as triggered even though the default warning level is higher.
+func (s *BudgetService) EvaluateAlerts(ctx context.Context, familyID uuid.UUID) (*AlertEvaluation, error) {
+	budgets, err := s.budgetRepo.GetActiveBudgets(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load active budgets: %w", err)
+	}
+
+	eval := &AlertEvaluation{Budgets: make([]BudgetAlertStatus, 0, len(budgets))}
+	for _, b := range budgets {
+		alerts, err := s.alertRepo.GetByBudgetID(ctx, b.ID)
+		if err != nil {
+			return nil, fmt.Errorf("load alerts for budget %s: %w", b.ID, err)
+		}
+
+		status := BudgetAlertStatus{
+			BudgetID:     b.ID,
+			BudgetName:   b.Name,
+			SpentPercent: b.GetSpentPercentage(),
+		}
+		if len(alerts) > 0 {
+			status.CustomThresholds = true
+			for _, a := range alerts {
+				status.Thresholds = append(status.Thresholds, a.ThresholdPercent)
+			}
+		} else {
+			status.Thresholds = defaultAlertThresholds
+		}
+		for _, threshold := range status.Thresholds {
+			if status.SpentPercent >= threshold {
+				status.TriggeredThresholds = append(status.TriggeredThresholds, threshold)
+			}
+		}
+		status.Triggered = len(status.TriggeredThresholds) > 0
+
+		if status.Triggered {
+			eval.TriggeredCount++
+		} else {
+			eval.HealthyCount++
+		}
+		eval.Budgets = append(eval.Budgets, status)
+	}
+	return eval, nil
+}
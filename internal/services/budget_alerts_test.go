@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func seedActiveBudget(t *testing.T, repo *memory.BudgetRepository, familyID uuid.UUID, amount, spent float64) *budget.Budget {
+	t.Helper()
+	b := &budget.Budget{
+		ID: uuid.New(), Name: "Groceries", Amount: amount, Spent: spent,
+		Period: budget.PeriodMonthly, FamilyID: familyID, IsActive: true,
+		StartDate: time.Now().AddDate(0, 0, -10), EndDate: time.Now().AddDate(0, 0, 20),
+	}
+	if err := repo.Create(context.Background(), b); err != nil {
+		t.Fatalf("seed budget: %v", err)
+	}
+	return b
+}
+
+func TestEvaluateAlerts_CustomThresholdTriggersBelowDefault(t *testing.T) {
+	budgets := memory.NewBudgetRepository()
+	svc := NewBudgetService(budgets, memory.NewAlertRepository(), memory.NewCategoryRepository())
+	familyID := uuid.New()
+	b := seedActiveBudget(t, budgets, familyID, 1000, 550) // 55% spent
+
+	if _, err := svc.CreateAlert(context.Background(), b.ID, 50, true); err != nil {
+		t.Fatalf("CreateAlert: %v", err)
+	}
+
+	eval, err := svc.EvaluateAlerts(context.Background(), familyID)
+	if err != nil {
+		t.Fatalf("EvaluateAlerts: %v", err)
+	}
+	if len(eval.Budgets) != 1 {
+		t.Fatalf("evaluated %d budgets, want 1", len(eval.Budgets))
+	}
+	status := eval.Budgets[0]
+	if !status.CustomThresholds || !status.Triggered {
+		t.Errorf("status = %+v, want triggered against the custom 50%% threshold", status)
+	}
+	if eval.TriggeredCount != 1 || eval.HealthyCount != 0 {
+		t.Errorf("counts = %d triggered, %d healthy; want 1/0", eval.TriggeredCount, eval.HealthyCount)
+	}
+}
+
+func TestEvaluateAlerts_DefaultsApplyWithoutCustomAlerts(t *testing.T) {
+	budgets := memory.NewBudgetRepository()
+	svc := NewBudgetService(budgets, memory.NewAlertRepository(), memory.NewCategoryRepository())
+	familyID := uuid.New()
+	seedActiveBudget(t, budgets, familyID, 1000, 550) // 55% — below the 80% default
+	seedActiveBudget(t, budgets, familyID, 1000, 850) // 85% — past the default warning
+
+	eval, err := svc.EvaluateAlerts(context.Background(), familyID)
+	if err != nil {
+		t.Fatalf("EvaluateAlerts: %v", err)
+	}
+	if eval.TriggeredCount != 1 || eval.HealthyCount != 1 {
+		t.Fatalf("counts = %d triggered, %d healthy; want 1/1", eval.TriggeredCount, eval.HealthyCount)
+	}
+	for _, status := range eval.Budgets {
+		if status.CustomThresholds {
+			t.Errorf("budget %s reports custom thresholds without configured alerts", status.BudgetID)
+		}
+		if len(status.Thresholds) != len(defaultAlertThresholds) {
+			t.Errorf("budget %s evaluated %d thresholds, want the %d defaults",
+				status.BudgetID, len(status.Thresholds), len(defaultAlertThresholds))
+		}
+	}
+}
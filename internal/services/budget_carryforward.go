@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/pkg/uuid"
+)
+
+// CarryForwardBudgets advances expired category budgets into the next
+// period. Only budgets whose category is flagged IsAlwaysBudgeted are
+// carried; the rest stay expired until someone budgets them explicitly.
+// A category that already has a budget covering now is skipped, so the
+// call is safe to repeat. The new budgets are returned.
+func (s *BudgetService) CarryForwardBudgets(ctx context.Context, familyID uuid.UUID, now time.Time) ([]*budget.Budget, error) {
+	budgets, err := s.budgetRepo.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load family budgets: %w", err)
+	}
+
+	covered := make(map[uuid.UUID]bool)
+	latest := make(map[uuid.UUID]*budget.Budget)
+	for _, b := range budgets {
+		if b.CategoryID == nil {
+			continue
+		}
+		if !b.EndDate.Before(now) {
+			covered[*b.CategoryID] = true
+			continue
+		}
+		// Successors chain from the most recent expired period so a
+		// lapsed category carries its latest cap (and rollover), not an
+		// older one.
+		if b.IsActive && (latest[*b.CategoryID] == nil || b.EndDate.After(latest[*b.CategoryID].EndDate)) {
+			latest[*b.CategoryID] = b
+		}
+	}
+
+	// Memoize category lookups for this run; several expired budgets can
+	// point at the same category.
+	loader := newMetadataLoader(s.categoryRepo, nil)
+	var created []*budget.Budget
+	for _, b := range budgets {
+		if b.CategoryID == nil || !b.IsActive || covered[*b.CategoryID] || latest[*b.CategoryID] != b {
+			continue
+		}
+		cat, err := loader.Category(ctx, *b.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+		if cat == nil || !cat.IsAlwaysBudgeted {
+			continue
+		}
+
+		next := nextPeriodBudget(b, now)
+		next.RolloverAmount = s.rolloverBalance(b)
+		if err := s.budgetRepo.Create(ctx, next); err != nil {
+			return nil, fmt.Errorf("carry budget forward: %w", err)
+		}
+		covered[*b.CategoryID] = true
+		created = append(created, next)
+	}
+	return created, nil
+}
+
+// rolloverBalance is what a finished budget passes into its successor:
+// the unspent part of its effective cap, for budgets opted into
+// rollover. Deficits carry forward only when negative rollover is
+// enabled on the service.
+func (s *BudgetService) rolloverBalance(b *budget.Budget) float64 {
+	if !b.Rollover {
+		return 0
+	}
+	remaining := b.GetRemainingAmount()
+	if remaining < 0 && !s.negativeRollover {
+		return 0
+	}
+	return remaining
+}
+
+// nextPeriodBudget copies b into the period containing now, preserving the
+// cap and cadence but resetting the spend. Periods are advanced from the
+// old end date so back-to-back periods stay contiguous.
+func nextPeriodBudget(b *budget.Budget, now time.Time) *budget.Budget {
+	start := b.EndDate.AddDate(0, 0, 1)
+	end := advancePeriod(start, b.Period)
+	// Catch up if the budget lapsed for more than one period.
+	for end.Before(now) {
+		start = end.AddDate(0, 0, 1)
+		end = advancePeriod(start, b.Period)
+	}
+
+	categoryID := *b.CategoryID
+	created := time.Now()
+	return &budget.Budget{
+		ID:         uuid.New(),
+		Name:       b.Name,
+		Amount:     b.Amount,
+		Period:     b.Period,
+		Rollover:   b.Rollover,
+		CategoryID: &categoryID,
+		FamilyID:   b.FamilyID,
+		StartDate:  start,
+		EndDate:    end,
+		IsActive:   true,
+		CreatedAt:  created,
+		UpdatedAt:  created,
+	}
+}
+
+// advancePeriod returns the inclusive end date of a period starting at
+// start. Custom periods keep a month-long default.
+func advancePeriod(start time.Time, period budget.Period) time.Time {
+	switch period {
+	case budget.PeriodWeekly:
+		return start.AddDate(0, 0, 6)
+	case budget.PeriodYearly:
+		return start.AddDate(1, 0, -1)
+	default:
+		return start.AddDate(0, 1, -1)
+	}
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func newCarryForwardFixture(t *testing.T) (*BudgetService, *memory.BudgetRepository, *memory.CategoryRepository) {
+	t.Helper()
+	budgets := memory.NewBudgetRepository()
+	categories := memory.NewCategoryRepository()
+	return NewBudgetService(budgets, memory.NewAlertRepository(), categories), budgets, categories
+}
+
+func seedCarryCategory(t *testing.T, categories *memory.CategoryRepository, familyID uuid.UUID, name string, alwaysBudgeted bool) *category.Category {
+	t.Helper()
+	c := &category.Category{
+		ID: uuid.New(), Name: name, Type: category.TypeExpense,
+		FamilyID: familyID, IsActive: true, IsAlwaysBudgeted: alwaysBudgeted,
+	}
+	if err := categories.Create(context.Background(), c); err != nil {
+		t.Fatalf("seed category: %v", err)
+	}
+	return c
+}
+
+func seedExpiredBudget(t *testing.T, budgets *memory.BudgetRepository, familyID, categoryID uuid.UUID, amount float64, end time.Time) *budget.Budget {
+	t.Helper()
+	b := &budget.Budget{
+		ID: uuid.New(), Name: "Budget", Amount: amount, Spent: amount / 2,
+		Period: budget.PeriodMonthly, CategoryID: &categoryID, FamilyID: familyID,
+		StartDate: end.AddDate(0, -1, 1), EndDate: end, IsActive: true,
+	}
+	if err := budgets.Create(context.Background(), b); err != nil {
+		t.Fatalf("seed budget: %v", err)
+	}
+	return b
+}
+
+func TestCarryForwardBudgets_OnlyFlaggedCategoriesAdvance(t *testing.T) {
+	svc, budgets, categories := newCarryForwardFixture(t)
+	familyID := uuid.New()
+	now := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+	lastPeriodEnd := time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC)
+
+	groceries := seedCarryCategory(t, categories, familyID, "Groceries", true)
+	vacation := seedCarryCategory(t, categories, familyID, "Vacation", false)
+	seedExpiredBudget(t, budgets, familyID, groceries.ID, 600, lastPeriodEnd)
+	seedExpiredBudget(t, budgets, familyID, vacation.ID, 2000, lastPeriodEnd)
+
+	created, err := svc.CarryForwardBudgets(context.Background(), familyID, now)
+	if err != nil {
+		t.Fatalf("CarryForwardBudgets: %v", err)
+	}
+	if len(created) != 1 {
+		t.Fatalf("created %d budgets, want only the flagged category", len(created))
+	}
+	next := created[0]
+	if next.CategoryID == nil || *next.CategoryID != groceries.ID {
+		t.Errorf("carried category = %v, want groceries", next.CategoryID)
+	}
+	if next.Amount != 600 || next.Spent != 0 {
+		t.Errorf("carried budget = amount %.0f spent %.0f, want 600 with spend reset", next.Amount, next.Spent)
+	}
+	if !next.StartDate.Equal(lastPeriodEnd.AddDate(0, 0, 1)) {
+		t.Errorf("StartDate = %v, want the day after the old period", next.StartDate)
+	}
+	if next.EndDate.Before(now) {
+		t.Errorf("EndDate = %v, want a period covering now (%v)", next.EndDate, now)
+	}
+}
+
+func TestCarryForwardBudgets_Idempotent(t *testing.T) {
+	svc, budgets, categories := newCarryForwardFixture(t)
+	familyID := uuid.New()
+	now := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+
+	groceries := seedCarryCategory(t, categories, familyID, "Groceries", true)
+	seedExpiredBudget(t, budgets, familyID, groceries.ID, 600, time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC))
+
+	if _, err := svc.CarryForwardBudgets(context.Background(), familyID, now); err != nil {
+		t.Fatalf("first carry: %v", err)
+	}
+	again, err := svc.CarryForwardBudgets(context.Background(), familyID, now)
+	if err != nil {
+		t.Fatalf("second carry: %v", err)
+	}
+	if len(again) != 0 {
+		t.Errorf("second carry created %d budgets, want 0", len(again))
+	}
+}
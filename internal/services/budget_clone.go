@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrInvalidAdjustPercent rejects clone adjustments at or below -100
+// percent, which would produce a zero or negative cap.
+var ErrInvalidAdjustPercent = errors.New("adjust percent must be greater than -100")
+
+// CloneBudgetsToNextPeriod copies a family's active budgets into the
+// period directly after each one's window: the new window starts the day
+// after the old end date, spans the budget's cadence, and spent resets
+// to zero. adjustPercent scales the cloned caps (+10 raises every cap by
+// ten percent, 0 keeps them identical); rollover balances do not carry —
+// that is CarryForwardBudgets' job. A budget whose next window would
+// overlap an existing budget in the same scope is skipped, so the call
+// is safe to repeat at each month's start. The clones are returned.
+func (s *BudgetService) CloneBudgetsToNextPeriod(ctx context.Context, familyID uuid.UUID, adjustPercent float64) ([]*budget.Budget, error) {
+	if adjustPercent <= -100 {
+		return nil, fmt.Errorf("%w: got %.1f", ErrInvalidAdjustPercent, adjustPercent)
+	}
+	actives, err := s.budgetRepo.GetActiveBudgets(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load active budgets: %w", err)
+	}
+
+	now := time.Now()
+	var created []*budget.Budget
+	for _, b := range actives {
+		// Only budgets covering the current period are cloned; the freshly
+		// created successors would otherwise spawn successors of their own
+		// on the next call.
+		if now.Before(b.StartDate) || now.After(b.EndDate) {
+			continue
+		}
+		clone := cloneForNextPeriod(b, adjustPercent)
+		if err := s.ValidateBudgetPeriod(ctx, clone); errors.Is(err, budget.ErrPeriodOverlap) {
+			continue
+		} else if err != nil {
+			return created, err
+		}
+		if err := s.budgetRepo.Create(ctx, clone); err != nil {
+			return created, fmt.Errorf("clone budget %q: %w", b.Name, err)
+		}
+		created = append(created, clone)
+	}
+	return created, nil
+}
+
+// cloneForNextPeriod copies b into the period directly after its own,
+// with the cap scaled by adjustPercent and the spend reset.
+func cloneForNextPeriod(b *budget.Budget, adjustPercent float64) *budget.Budget {
+	start := b.EndDate.AddDate(0, 0, 1)
+	now := time.Now()
+	clone := &budget.Budget{
+		ID:        uuid.New(),
+		Name:      b.Name,
+		Amount:    RoundAmount(b.Amount * (1 + adjustPercent/100)),
+		Period:    b.Period,
+		Rollover:  b.Rollover,
+		FamilyID:  b.FamilyID,
+		StartDate: start,
+		EndDate:   advancePeriod(start, b.Period),
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if b.CategoryID != nil {
+		categoryID := *b.CategoryID
+		clone.CategoryID = &categoryID
+	}
+	return clone
+}
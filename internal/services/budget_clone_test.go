@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestCloneBudgetsToNextPeriod_CopiesWindowAndResetsSpent(t *testing.T) {
+	budgets := memory.NewBudgetRepository()
+	svc := NewBudgetService(budgets, nil, nil)
+	familyID := uuid.New()
+	categoryID := uuid.New()
+	original := seedLimitBudget(t, budgets, familyID, categoryID, 200, 150)
+
+	created, err := svc.CloneBudgetsToNextPeriod(context.Background(), familyID, 0)
+	if err != nil {
+		t.Fatalf("CloneBudgetsToNextPeriod() error = %v", err)
+	}
+	if len(created) != 1 {
+		t.Fatalf("created %d budgets, want 1", len(created))
+	}
+	clone := created[0]
+	wantStart := original.EndDate.AddDate(0, 0, 1)
+	if !clone.StartDate.Equal(wantStart) {
+		t.Errorf("StartDate = %v, want the day after the old end %v", clone.StartDate, wantStart)
+	}
+	if !clone.EndDate.Equal(wantStart.AddDate(0, 1, -1)) {
+		t.Errorf("EndDate = %v, want a monthly window from %v", clone.EndDate, wantStart)
+	}
+	if clone.Spent != 0 {
+		t.Errorf("Spent = %v, want 0", clone.Spent)
+	}
+	if clone.Amount != 200 {
+		t.Errorf("Amount = %v, want the original 200", clone.Amount)
+	}
+	if clone.CategoryID == nil || *clone.CategoryID != categoryID {
+		t.Errorf("CategoryID = %v, want the original category", clone.CategoryID)
+	}
+
+	// Repeating the clone finds the next window already covered and
+	// creates nothing.
+	again, err := svc.CloneBudgetsToNextPeriod(context.Background(), familyID, 0)
+	if err != nil || len(again) != 0 {
+		t.Fatalf("second clone = %d budgets, %v; want none", len(again), err)
+	}
+}
+
+func TestCloneBudgetsToNextPeriod_AppliesPercentAdjustment(t *testing.T) {
+	budgets := memory.NewBudgetRepository()
+	svc := NewBudgetService(budgets, nil, nil)
+	familyID := uuid.New()
+	familyWide := &budget.Budget{
+		ID: uuid.New(), Name: "Everything", Amount: 1000, Spent: 400,
+		FamilyID: familyID, Period: budget.PeriodMonthly, IsActive: true,
+		StartDate: time.Now().AddDate(0, 0, -10), EndDate: time.Now().AddDate(0, 0, 20),
+	}
+	if err := budgets.Create(context.Background(), familyWide); err != nil {
+		t.Fatalf("seed budget: %v", err)
+	}
+
+	created, err := svc.CloneBudgetsToNextPeriod(context.Background(), familyID, 10)
+	if err != nil || len(created) != 1 {
+		t.Fatalf("CloneBudgetsToNextPeriod() = %d budgets, %v; want 1", len(created), err)
+	}
+	if created[0].Amount != 1100 {
+		t.Errorf("Amount = %v, want 1100 after a +10%% adjustment", created[0].Amount)
+	}
+	if created[0].CategoryID != nil {
+		t.Errorf("CategoryID = %v, want family-wide clone", created[0].CategoryID)
+	}
+
+	if _, err := svc.CloneBudgetsToNextPeriod(context.Background(), familyID, -100); !errors.Is(err, ErrInvalidAdjustPercent) {
+		t.Fatalf("adjust -100 error = %v, want ErrInvalidAdjustPercent", err)
+	}
+}
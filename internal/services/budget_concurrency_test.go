@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestUpdateBudget_VersionedUpdateSucceeds(t *testing.T) {
+	budgets := memory.NewBudgetRepository()
+	svc := NewBudgetService(budgets, nil, nil)
+	familyID := uuid.New()
+	b := &budget.Budget{
+		ID: uuid.New(), Name: "Groceries", Amount: 500, FamilyID: familyID,
+		Period: budget.PeriodMonthly, IsActive: true,
+		StartDate: time.Now().AddDate(0, 0, -10), EndDate: time.Now().AddDate(0, 0, 20),
+	}
+	if err := svc.CreateBudget(context.Background(), b); err != nil {
+		t.Fatalf("CreateBudget: %v", err)
+	}
+
+	loaded, err := svc.GetBudgetByID(context.Background(), b.ID)
+	if err != nil {
+		t.Fatalf("GetBudgetByID: %v", err)
+	}
+	loaded.Amount = 600
+	if err := svc.UpdateBudget(context.Background(), loaded); err != nil {
+		t.Fatalf("UpdateBudget: %v", err)
+	}
+	current, err := svc.GetBudgetByID(context.Background(), b.ID)
+	if err != nil || current.Amount != 600 {
+		t.Fatalf("budget after update = %+v, %v; want amount 600", current, err)
+	}
+	if current.Version <= b.Version {
+		t.Errorf("version = %d after update, want advanced past %d", current.Version, b.Version)
+	}
+}
+
+func TestUpdateBudget_StaleVersionConflicts(t *testing.T) {
+	budgets := memory.NewBudgetRepository()
+	svc := NewBudgetService(budgets, nil, nil)
+	familyID := uuid.New()
+	b := &budget.Budget{
+		ID: uuid.New(), Name: "Groceries", Amount: 500, FamilyID: familyID,
+		Period: budget.PeriodMonthly, IsActive: true,
+		StartDate: time.Now().AddDate(0, 0, -10), EndDate: time.Now().AddDate(0, 0, 20),
+	}
+	if err := svc.CreateBudget(context.Background(), b); err != nil {
+		t.Fatalf("CreateBudget: %v", err)
+	}
+
+	// Two members load the same version; the second write is stale.
+	first, _ := svc.GetBudgetByID(context.Background(), b.ID)
+	second, _ := svc.GetBudgetByID(context.Background(), b.ID)
+	first.Amount = 600
+	if err := svc.UpdateBudget(context.Background(), first); err != nil {
+		t.Fatalf("first update: %v", err)
+	}
+	second.Amount = 450
+	if err := svc.UpdateBudget(context.Background(), second); !errors.Is(err, budget.ErrVersionConflict) {
+		t.Fatalf("stale update err = %v, want ErrVersionConflict", err)
+	}
+	current, err := svc.GetBudgetByID(context.Background(), b.ID)
+	if err != nil || current.Amount != 600 {
+		t.Errorf("budget after conflict = %+v, %v; want the first writer's 600 kept", current, err)
+	}
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func newOverlapFixture(t *testing.T) *BudgetService {
+	t.Helper()
+	return NewBudgetService(memory.NewBudgetRepository(), memory.NewAlertRepository(), memory.NewCategoryRepository())
+}
+
+func overlapBudget(familyID uuid.UUID, categoryID *uuid.UUID, start, end time.Time) *budget.Budget {
+	return &budget.Budget{
+		Name: "Budget", Amount: 500, Period: budget.PeriodMonthly,
+		CategoryID: categoryID, FamilyID: familyID,
+		StartDate: start, EndDate: end, IsActive: true,
+	}
+}
+
+func TestCreateBudget_ExactOverlapRejected(t *testing.T) {
+	svc := newOverlapFixture(t)
+	familyID := uuid.New()
+	categoryID := uuid.New()
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	if err := svc.CreateBudget(context.Background(), overlapBudget(familyID, &categoryID, start, end)); err != nil {
+		t.Fatalf("first budget: %v", err)
+	}
+	err := svc.CreateBudget(context.Background(), overlapBudget(familyID, &categoryID, start, end))
+	if !errors.Is(err, budget.ErrPeriodOverlap) {
+		t.Errorf("exact overlap err = %v, want ErrPeriodOverlap", err)
+	}
+}
+
+func TestCreateBudget_PartialOverlapRejected(t *testing.T) {
+	svc := newOverlapFixture(t)
+	familyID := uuid.New()
+	categoryID := uuid.New()
+
+	err := svc.CreateBudget(context.Background(), overlapBudget(familyID, &categoryID,
+		time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)))
+	if err != nil {
+		t.Fatalf("first budget: %v", err)
+	}
+	// Starts inside the existing range.
+	err = svc.CreateBudget(context.Background(), overlapBudget(familyID, &categoryID,
+		time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 14, 0, 0, 0, 0, time.UTC)))
+	if !errors.Is(err, budget.ErrPeriodOverlap) {
+		t.Errorf("partial overlap err = %v, want ErrPeriodOverlap", err)
+	}
+}
+
+func TestCreateBudget_AdjacentRangesAccepted(t *testing.T) {
+	svc := newOverlapFixture(t)
+	familyID := uuid.New()
+	categoryID := uuid.New()
+
+	err := svc.CreateBudget(context.Background(), overlapBudget(familyID, &categoryID,
+		time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)))
+	if err != nil {
+		t.Fatalf("march budget: %v", err)
+	}
+	// April starts the day after March ends: contiguous, not overlapping.
+	err = svc.CreateBudget(context.Background(), overlapBudget(familyID, &categoryID,
+		time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC)))
+	if err != nil {
+		t.Errorf("adjacent budget err = %v, want accepted", err)
+	}
+}
+
+func TestCreateBudget_ScopesAreIndependent(t *testing.T) {
+	svc := newOverlapFixture(t)
+	familyID := uuid.New()
+	categoryID := uuid.New()
+	otherCategory := uuid.New()
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	// A family-wide budget, a category budget and a second category's
+	// budget may all cover the same month.
+	if err := svc.CreateBudget(context.Background(), overlapBudget(familyID, nil, start, end)); err != nil {
+		t.Fatalf("family-wide budget: %v", err)
+	}
+	if err := svc.CreateBudget(context.Background(), overlapBudget(familyID, &categoryID, start, end)); err != nil {
+		t.Errorf("category budget alongside family-wide: %v", err)
+	}
+	if err := svc.CreateBudget(context.Background(), overlapBudget(familyID, &otherCategory, start, end)); err != nil {
+		t.Errorf("second category budget: %v", err)
+	}
+
+	// But two family-wide budgets over the same month conflict.
+	err := svc.CreateBudget(context.Background(), overlapBudget(familyID, nil, start, end))
+	if !errors.Is(err, budget.ErrPeriodOverlap) {
+		t.Errorf("second family-wide err = %v, want ErrPeriodOverlap", err)
+	}
+}
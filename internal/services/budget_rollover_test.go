@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestCarryForwardBudgets_RolloverCarriesUnspentTwoMonths(t *testing.T) {
+	svc, budgets, categories := newCarryForwardFixture(t)
+	familyID := uuid.New()
+	groceries := seedCarryCategory(t, categories, familyID, "Groceries", true)
+
+	marchEnd := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	march := seedExpiredBudget(t, budgets, familyID, groceries.ID, 600, marchEnd)
+	march.Rollover = true
+	march.Spent = 400 // 200 unspent
+	if err := budgets.Update(context.Background(), march); err != nil {
+		t.Fatalf("update march budget: %v", err)
+	}
+
+	created, err := svc.CarryForwardBudgets(context.Background(), familyID,
+		time.Date(2026, 4, 5, 0, 0, 0, 0, time.UTC))
+	if err != nil || len(created) != 1 {
+		t.Fatalf("april carry = %d budgets, %v; want 1", len(created), err)
+	}
+	april := created[0]
+	if !april.Rollover || april.RolloverAmount != 200 {
+		t.Fatalf("april rollover = %v/%v, want true/200", april.Rollover, april.RolloverAmount)
+	}
+	if april.EffectiveAmount() != 800 {
+		t.Errorf("april effective cap = %v, want 800", april.EffectiveAmount())
+	}
+	// Spend percentage runs against the effective cap, not the base one.
+	april.Spent = 400
+	if got := april.GetSpentPercentage(); got != 50 {
+		t.Errorf("april spent%% = %v, want 50 of the 800 effective cap", got)
+	}
+	if err := budgets.Update(context.Background(), april); err != nil {
+		t.Fatalf("update april budget: %v", err)
+	}
+
+	// Second month: April's remaining 400 rolls into May.
+	created, err = svc.CarryForwardBudgets(context.Background(), familyID,
+		time.Date(2026, 5, 3, 0, 0, 0, 0, time.UTC))
+	if err != nil || len(created) != 1 {
+		t.Fatalf("may carry = %d budgets, %v; want 1", len(created), err)
+	}
+	may := created[0]
+	if may.RolloverAmount != 400 || may.EffectiveAmount() != 1000 {
+		t.Errorf("may rollover = %v (effective %v), want 400 carrying to a 1000 cap",
+			may.RolloverAmount, may.EffectiveAmount())
+	}
+}
+
+func TestCarryForwardBudgets_OverspentRolloverPolicy(t *testing.T) {
+	run := func(t *testing.T, negative bool) *budget.Budget {
+		t.Helper()
+		svc, budgets, categories := newCarryForwardFixture(t)
+		svc.SetNegativeRollover(negative)
+		familyID := uuid.New()
+		groceries := seedCarryCategory(t, categories, familyID, "Groceries", true)
+
+		b := seedExpiredBudget(t, budgets, familyID, groceries.ID, 600,
+			time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC))
+		b.Rollover = true
+		b.Spent = 700 // 100 over
+		if err := budgets.Update(context.Background(), b); err != nil {
+			t.Fatalf("update budget: %v", err)
+		}
+
+		created, err := svc.CarryForwardBudgets(context.Background(), familyID,
+			time.Date(2026, 4, 5, 0, 0, 0, 0, time.UTC))
+		if err != nil || len(created) != 1 {
+			t.Fatalf("carry = %d budgets, %v; want 1", len(created), err)
+		}
+		return created[0]
+	}
+
+	if next := run(t, false); next.RolloverAmount != 0 {
+		t.Errorf("default rollover = %v, want 0 for an overspent period", next.RolloverAmount)
+	}
+	if next := run(t, true); next.RolloverAmount != -100 || next.EffectiveAmount() != 500 {
+		t.Errorf("negative rollover = %v (effective %v), want -100 lowering the cap to 500",
+			next.RolloverAmount, next.EffectiveAmount())
+	}
+}
+
+func TestCarryForwardBudgets_NonRolloverBudgetCarriesNothing(t *testing.T) {
+	svc, budgets, categories := newCarryForwardFixture(t)
+	familyID := uuid.New()
+	groceries := seedCarryCategory(t, categories, familyID, "Groceries", true)
+	seedExpiredBudget(t, budgets, familyID, groceries.ID, 600,
+		time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)) // spent 300, no rollover flag
+
+	created, err := svc.CarryForwardBudgets(context.Background(), familyID,
+		time.Date(2026, 4, 5, 0, 0, 0, 0, time.UTC))
+	if err != nil || len(created) != 1 {
+		t.Fatalf("carry = %d budgets, %v; want 1", len(created), err)
+	}
+	if created[0].RolloverAmount != 0 || created[0].Rollover {
+		t.Errorf("non-rollover budget carried %v forward", created[0].RolloverAmount)
+	}
+}
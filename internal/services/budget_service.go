@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// Global alert thresholds, used as defaults when a budget has no
+// user-configured alerts.
+const (
+	BudgetWarningThreshold  = 80.0
+	BudgetCriticalThreshold = 90.0
+	BudgetExceededThreshold = 100.0
+)
+
+// ErrInvalidAlertThreshold is returned for thresholds outside (0, 200].
+var ErrInvalidAlertThreshold = fmt.Errorf("alert threshold must be between 0 and 200 percent")
+
+// BudgetService manages budgets and their alerts.
+type BudgetService struct {
+	budgetRepo   budget.Repository
+	alertRepo    budget.AlertRepository
+	categoryRepo category.Repository
+	// transactionRepo backs budget amount suggestions; nil until wired
+	// via SetTransactionRepository.
+	transactionRepo transaction.Repository
+
+	// allowNegativeSpent lets refunds drive a budget's net spend below
+	// zero. Off by default: spend floors at zero so a refunded category
+	// doesn't show negative utilization.
+	allowNegativeSpent bool
+	// negativeRollover lets an overspent rollover budget carry its
+	// deficit into the next period. Off by default: overspent periods
+	// simply carry nothing forward.
+	negativeRollover bool
+}
+
+// NewBudgetService builds a BudgetService. alertRepo and categoryRepo may
+// be nil for callers that never touch alerts or carry-forward.
+func NewBudgetService(budgetRepo budget.Repository, alertRepo budget.AlertRepository, categoryRepo category.Repository) *BudgetService {
+	return &BudgetService{budgetRepo: budgetRepo, alertRepo: alertRepo, categoryRepo: categoryRepo}
+}
+
+// CreateBudget validates and persists a budget. Budgets sharing a scope
+// — the same category, or both family-wide — must not overlap in time;
+// a family-wide budget and a category budget may coexist.
+func (s *BudgetService) CreateBudget(ctx context.Context, b *budget.Budget) error {
+	if err := s.ValidateBudgetPeriod(ctx, b); err != nil {
+		return err
+	}
+	if b.ID.IsNil() {
+		b.ID = uuid.New()
+	}
+	now := time.Now()
+	b.CreatedAt = now
+	b.UpdatedAt = now
+	if err := s.budgetRepo.Create(ctx, b); err != nil {
+		return fmt.Errorf("create budget: %w", err)
+	}
+	return nil
+}
+
+// UpdateBudget validates and persists changes to a budget. The budget
+// must carry the Version the caller loaded: when another writer advanced
+// the stored version in the meantime, the update is rejected with
+// budget.ErrVersionConflict instead of clobbering the newer edit.
+func (s *BudgetService) UpdateBudget(ctx context.Context, b *budget.Budget) error {
+	if err := s.ValidateBudgetPeriod(ctx, b); err != nil {
+		return err
+	}
+	b.UpdatedAt = time.Now()
+	if err := s.budgetRepo.Update(ctx, b); err != nil {
+		return fmt.Errorf("update budget: %w", err)
+	}
+	return nil
+}
+
+// ValidateBudgetPeriod checks b's date range against the family's other
+// active budgets in the same scope. The check ignores b itself, so it is
+// safe for updates too. Ranges are inclusive: sharing a single day is an
+// overlap, back-to-back periods are not.
+func (s *BudgetService) ValidateBudgetPeriod(ctx context.Context, b *budget.Budget) error {
+	existing, err := s.budgetRepo.GetByFamilyID(ctx, b.FamilyID)
+	if err != nil {
+		return fmt.Errorf("load family budgets: %w", err)
+	}
+	for _, other := range existing {
+		if other.ID == b.ID || !other.IsActive || !sameBudgetScope(b, other) {
+			continue
+		}
+		if !b.StartDate.After(other.EndDate) && !other.StartDate.After(b.EndDate) {
+			return fmt.Errorf("%w: %q covers %s to %s", budget.ErrPeriodOverlap,
+				other.Name, other.StartDate.Format("2006-01-02"), other.EndDate.Format("2006-01-02"))
+		}
+	}
+	return nil
+}
+
+// sameBudgetScope reports whether two budgets cap the same money: both
+// family-wide, or both for the same category.
+func sameBudgetScope(a, b *budget.Budget) bool {
+	if a.CategoryID == nil || b.CategoryID == nil {
+		return a.CategoryID == nil && b.CategoryID == nil
+	}
+	return *a.CategoryID == *b.CategoryID
+}
+
+// GetBudgetByID returns a single budget.
+func (s *BudgetService) GetBudgetByID(ctx context.Context, id uuid.UUID) (*budget.Budget, error) {
+	return s.budgetRepo.GetByID(ctx, id)
+}
+
+// GetBudgetsByFamily returns every budget of a family.
+func (s *BudgetService) GetBudgetsByFamily(ctx context.Context, familyID uuid.UUID) ([]*budget.Budget, error) {
+	return s.budgetRepo.GetByFamilyID(ctx, familyID)
+}
+
+// GetActiveBudgets returns a family's active budgets.
+func (s *BudgetService) GetActiveBudgets(ctx context.Context, familyID uuid.UUID) ([]*budget.Budget, error) {
+	return s.budgetRepo.GetActiveBudgets(ctx, familyID)
+}
+
+// SetAllowNegativeSpent toggles whether refunds may take a budget's
+// spent below zero.
+func (s *BudgetService) SetAllowNegativeSpent(allow bool) {
+	s.allowNegativeSpent = allow
+}
+
+// SetNegativeRollover toggles whether an overspent rollover budget
+// carries its deficit forward as a negative rollover.
+func (s *BudgetService) SetNegativeRollover(allow bool) {
+	s.negativeRollover = allow
+}
+
+// ApplySpentDelta adjusts a budget's spent total by delta — positive for
+// expenses, negative for refunds — and enforces the spent floor policy:
+// unless negative spend is explicitly allowed, the result floors at zero.
+func (s *BudgetService) ApplySpentDelta(ctx context.Context, budgetID uuid.UUID, delta float64) (*budget.Budget, error) {
+	b, err := s.budgetRepo.GetByID(ctx, budgetID)
+	if err != nil {
+		return nil, fmt.Errorf("load budget: %w", err)
+	}
+	b.Spent = s.applySpentPolicy(b.Spent + delta)
+	b.UpdatedAt = time.Now()
+	if err := s.budgetRepo.Update(ctx, b); err != nil {
+		return nil, fmt.Errorf("update budget spend: %w", err)
+	}
+	return b, nil
+}
+
+// UpdateSpentAmount replaces a budget's spent total, subject to the same
+// floor policy as ApplySpentDelta.
+func (s *BudgetService) UpdateSpentAmount(ctx context.Context, budgetID uuid.UUID, spent float64) (*budget.Budget, error) {
+	b, err := s.budgetRepo.GetByID(ctx, budgetID)
+	if err != nil {
+		return nil, fmt.Errorf("load budget: %w", err)
+	}
+	b.Spent = s.applySpentPolicy(spent)
+	b.UpdatedAt = time.Now()
+	if err := s.budgetRepo.Update(ctx, b); err != nil {
+		return nil, fmt.Errorf("update budget spend: %w", err)
+	}
+	return b, nil
+}
+
+func (s *BudgetService) applySpentPolicy(spent float64) float64 {
+	if spent < 0 && !s.allowNegativeSpent {
+		return 0
+	}
+	return spent
+}
+
+// CreateAlert persists a new alert threshold on a budget. A budget
+// cannot carry two alerts at the same threshold.
+func (s *BudgetService) CreateAlert(ctx context.Context, budgetID uuid.UUID, thresholdPercent float64, notify bool) (*budget.Alert, error) {
+	if thresholdPercent <= 0 || thresholdPercent > 200 {
+		return nil, fmt.Errorf("%w: got %.1f", ErrInvalidAlertThreshold, thresholdPercent)
+	}
+	if _, err := s.budgetRepo.GetByID(ctx, budgetID); err != nil {
+		return nil, fmt.Errorf("load budget: %w", err)
+	}
+
+	existing, err := s.alertRepo.GetByBudgetID(ctx, budgetID)
+	if err != nil {
+		return nil, fmt.Errorf("load budget alerts: %w", err)
+	}
+	for _, a := range existing {
+		if a.ThresholdPercent == thresholdPercent {
+			return nil, budget.ErrDuplicateAlertThreshold
+		}
+	}
+
+	now := time.Now()
+	alert := &budget.Alert{
+		ID:               uuid.New(),
+		BudgetID:         budgetID,
+		ThresholdPercent: thresholdPercent,
+		NotifyEnabled:    notify,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if err := s.alertRepo.Create(ctx, alert); err != nil {
+		return nil, fmt.Errorf("create alert: %w", err)
+	}
+	return alert, nil
+}
+
+// GetAlertsByBudget lists the configured alerts of a budget, ordered by
+// threshold.
+func (s *BudgetService) GetAlertsByBudget(ctx context.Context, budgetID uuid.UUID) ([]*budget.Alert, error) {
+	return s.alertRepo.GetByBudgetID(ctx, budgetID)
+}
+
+// DeleteAlert removes a configured alert.
+func (s *BudgetService) DeleteAlert(ctx context.Context, id uuid.UUID) error {
+	return s.alertRepo.Delete(ctx, id)
+}
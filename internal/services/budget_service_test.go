@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestApplySpentDelta_RefundsFloorAtZeroByDefault(t *testing.T) {
+	budgets := memory.NewBudgetRepository()
+	svc := NewBudgetService(budgets, memory.NewAlertRepository(), memory.NewCategoryRepository())
+	b := seedActiveBudget(t, budgets, uuid.New(), 500, 50)
+
+	updated, err := svc.ApplySpentDelta(context.Background(), b.ID, -80)
+	if err != nil {
+		t.Fatalf("ApplySpentDelta: %v", err)
+	}
+	if updated.Spent != 0 {
+		t.Errorf("Spent = %.2f, want floored at 0 after an oversized refund", updated.Spent)
+	}
+
+	stored, err := budgets.GetByID(context.Background(), b.ID)
+	if err != nil || stored.Spent != 0 {
+		t.Errorf("stored Spent = %.2f, %v; want persisted 0", stored.Spent, err)
+	}
+}
+
+func TestApplySpentDelta_NegativeAllowedByConfig(t *testing.T) {
+	budgets := memory.NewBudgetRepository()
+	svc := NewBudgetService(budgets, memory.NewAlertRepository(), memory.NewCategoryRepository())
+	svc.SetAllowNegativeSpent(true)
+	b := seedActiveBudget(t, budgets, uuid.New(), 500, 50)
+
+	updated, err := svc.ApplySpentDelta(context.Background(), b.ID, -80)
+	if err != nil {
+		t.Fatalf("ApplySpentDelta: %v", err)
+	}
+	if updated.Spent != -30 {
+		t.Errorf("Spent = %.2f, want -30 when negative spend is allowed", updated.Spent)
+	}
+}
+
+func TestUpdateSpentAmount_AppliesSameFloorPolicy(t *testing.T) {
+	budgets := memory.NewBudgetRepository()
+	svc := NewBudgetService(budgets, memory.NewAlertRepository(), memory.NewCategoryRepository())
+	b := seedActiveBudget(t, budgets, uuid.New(), 500, 50)
+
+	updated, err := svc.UpdateSpentAmount(context.Background(), b.ID, -10)
+	if err != nil {
+		t.Fatalf("UpdateSpentAmount: %v", err)
+	}
+	if updated.Spent != 0 {
+		t.Errorf("Spent = %.2f, want 0 under the default floor", updated.Spent)
+	}
+
+	if _, err := svc.UpdateSpentAmount(context.Background(), b.ID, 120); err != nil {
+		t.Fatalf("UpdateSpentAmount: %v", err)
+	}
+	stored, err := budgets.GetByID(context.Background(), b.ID)
+	if err != nil || stored.Spent != 120 {
+		t.Errorf("stored Spent = %.2f, %v; want 120", stored.Spent, err)
+	}
+}
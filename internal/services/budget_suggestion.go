@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// suggestionMonths is the trailing window budget suggestions average
+// over.
+const suggestionMonths = 3
+
+// ErrSuggestionsUnavailable is returned when budget suggestions are
+// requested without a wired transaction repository.
+var ErrSuggestionsUnavailable = errors.New("budget suggestions require a transaction repository")
+
+// BudgetSuggestion is the prefill for one category on the new-budget
+// form: the family's average monthly spend in that category.
+type BudgetSuggestion struct {
+	CategoryID      uuid.UUID
+	SuggestedAmount float64
+}
+
+// SetTransactionRepository wires the spending history that backs budget
+// amount suggestions; nil leaves them disabled.
+func (s *BudgetService) SetTransactionRepository(transactionRepo transaction.Repository) {
+	s.transactionRepo = transactionRepo
+}
+
+// SuggestBudgetAmounts returns a suggested budget amount per category:
+// the family's average monthly expense over the trailing
+// suggestionMonths months ending at now, largest first. Categories
+// without spending in the window get no suggestion at all — an empty
+// prefill, never a zero.
+func (s *BudgetService) SuggestBudgetAmounts(ctx context.Context, familyID uuid.UUID, now time.Time) ([]BudgetSuggestion, error) {
+	if s.transactionRepo == nil {
+		return nil, ErrSuggestionsUnavailable
+	}
+	from := now.AddDate(0, -suggestionMonths, 0)
+	expense := transaction.TypeExpense
+	totals, err := s.transactionRepo.GetCategoryTotals(ctx, transaction.Filter{
+		FamilyID: familyID,
+		Type:     &expense,
+		DateFrom: &from,
+		DateTo:   &now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("aggregate category spend: %w", err)
+	}
+
+	suggestions := make([]BudgetSuggestion, 0, len(totals))
+	for _, total := range totals {
+		if total.Amount <= 0 {
+			continue
+		}
+		suggestions = append(suggestions, BudgetSuggestion{
+			CategoryID:      total.CategoryID,
+			SuggestedAmount: RoundAmount(total.Amount / suggestionMonths),
+		})
+	}
+	return suggestions, nil
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestSuggestBudgetAmounts_TrailingThreeMonthAverage(t *testing.T) {
+	transactions := memory.NewTransactionRepository()
+	svc := NewBudgetService(memory.NewBudgetRepository(), nil, nil)
+	svc.SetTransactionRepository(transactions)
+	familyID := uuid.New()
+	groceriesID, idleID := uuid.New(), uuid.New()
+	now := time.Date(2026, 8, 15, 12, 0, 0, 0, time.UTC)
+
+	// 120 + 90 + 90 = 300 over the trailing three months.
+	for i, amount := range []float64{120, 90, 90} {
+		tx := &transaction.Transaction{
+			ID: uuid.New(), Amount: amount, Type: transaction.TypeExpense,
+			CategoryID: groceriesID, UserID: uuid.New(), FamilyID: familyID,
+			Date: now.AddDate(0, -i, 0),
+		}
+		if err := transactions.Create(context.Background(), tx); err != nil {
+			t.Fatalf("seed transaction: %v", err)
+		}
+	}
+	// Older than the window: must not move the average.
+	stale := &transaction.Transaction{
+		ID: uuid.New(), Amount: 900, Type: transaction.TypeExpense,
+		CategoryID: groceriesID, UserID: uuid.New(), FamilyID: familyID,
+		Date: now.AddDate(0, -4, 0),
+	}
+	if err := transactions.Create(context.Background(), stale); err != nil {
+		t.Fatalf("seed transaction: %v", err)
+	}
+
+	suggestions, err := svc.SuggestBudgetAmounts(context.Background(), familyID, now)
+	if err != nil {
+		t.Fatalf("SuggestBudgetAmounts() error = %v", err)
+	}
+	if len(suggestions) != 1 {
+		t.Fatalf("got %d suggestions, want only the category with history", len(suggestions))
+	}
+	if suggestions[0].CategoryID != groceriesID {
+		t.Errorf("CategoryID = %v, want groceries", suggestions[0].CategoryID)
+	}
+	if suggestions[0].SuggestedAmount != 100 {
+		t.Errorf("SuggestedAmount = %v, want the 100 three-month average", suggestions[0].SuggestedAmount)
+	}
+	for _, s := range suggestions {
+		if s.CategoryID == idleID {
+			t.Errorf("category without history got suggestion %v, want none", s.SuggestedAmount)
+		}
+	}
+}
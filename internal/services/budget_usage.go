@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/pkg/uuid"
+)
+
+// Budget usage statuses, derived from the alert thresholds.
+const (
+	BudgetStatusHealthy  = "healthy"
+	BudgetStatusWarning  = "warning"
+	BudgetStatusCritical = "critical"
+	BudgetStatusExceeded = "exceeded"
+)
+
+// UsageStats summarizes how far along a budget period is and how much of
+// the cap has been consumed. ProjectedUtilization extrapolates the current
+// spend rate to the end of the period so consumers can warn before the cap
+// is actually hit: a budget half-spent at the halfway mark projects to 100%.
+type UsageStats struct {
+	BudgetID             uuid.UUID
+	SpentPercent         float64
+	Status               string
+	DaysTotal            int
+	DaysElapsed          int
+	DaysRemaining        int
+	ProjectedUtilization float64
+}
+
+// GetUsageStats computes the usage statistics of a single budget as of now.
+func (s *BudgetService) GetUsageStats(ctx context.Context, budgetID uuid.UUID) (*UsageStats, error) {
+	b, err := s.budgetRepo.GetByID(ctx, budgetID)
+	if err != nil {
+		return nil, fmt.Errorf("load budget: %w", err)
+	}
+	return computeUsageStats(b, time.Now()), nil
+}
+
+// computeUsageStats derives UsageStats for b at the given instant. Day
+// counts are clamped so a budget before its start shows zero elapsed days
+// and a finished one shows zero remaining.
+func computeUsageStats(b *budget.Budget, now time.Time) *UsageStats {
+	spentPercent := b.GetSpentPercentage()
+
+	daysTotal := int(b.EndDate.Sub(b.StartDate).Hours()/24) + 1
+	if daysTotal < 1 {
+		daysTotal = 1
+	}
+	daysElapsed := int(now.Sub(b.StartDate).Hours()/24) + 1
+	if daysElapsed < 0 {
+		daysElapsed = 0
+	}
+	if daysElapsed > daysTotal {
+		daysElapsed = daysTotal
+	}
+
+	projected := spentPercent
+	if daysElapsed > 0 && daysElapsed < daysTotal {
+		projected = spentPercent * float64(daysTotal) / float64(daysElapsed)
+	}
+
+	return &UsageStats{
+		BudgetID:             b.ID,
+		SpentPercent:         spentPercent,
+		Status:               usageStatus(spentPercent),
+		DaysTotal:            daysTotal,
+		DaysElapsed:          daysElapsed,
+		DaysRemaining:        daysTotal - daysElapsed,
+		ProjectedUtilization: projected,
+	}
+}
+
+// usageStatus maps a spent percentage onto the global alert thresholds.
+func usageStatus(spentPercent float64) string {
+	switch {
+	case spentPercent >= BudgetExceededThreshold:
+		return BudgetStatusExceeded
+	case spentPercent >= BudgetCriticalThreshold:
+		return BudgetStatusCritical
+	case spentPercent >= BudgetWarningThreshold:
+		return BudgetStatusWarning
+	default:
+		return BudgetStatusHealthy
+	}
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/pkg/uuid"
+)
+
+func usageBudget(amount, spent float64, start, end time.Time) *budget.Budget {
+	return &budget.Budget{
+		ID:        uuid.New(),
+		Name:      "Groceries",
+		Amount:    amount,
+		Spent:     spent,
+		Period:    budget.PeriodMonthly,
+		FamilyID:  uuid.New(),
+		StartDate: start,
+		EndDate:   end,
+		IsActive:  true,
+	}
+}
+
+func TestComputeUsageStats_HalfElapsedHalfSpentProjectsFull(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC) // 30-day period
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	stats := computeUsageStats(usageBudget(1000, 500, start, end), now)
+
+	if stats.DaysTotal != 30 || stats.DaysElapsed != 15 || stats.DaysRemaining != 15 {
+		t.Fatalf("days = %d total, %d elapsed, %d remaining; want 30/15/15",
+			stats.DaysTotal, stats.DaysElapsed, stats.DaysRemaining)
+	}
+	if math.Abs(stats.ProjectedUtilization-100) > 0.01 {
+		t.Errorf("ProjectedUtilization = %.2f, want ~100", stats.ProjectedUtilization)
+	}
+	if stats.Status != BudgetStatusHealthy {
+		t.Errorf("Status = %q, want healthy at 50%% spent", stats.Status)
+	}
+}
+
+func TestComputeUsageStats_FastSpenderProjectsOverrun(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC) // a third elapsed
+
+	stats := computeUsageStats(usageBudget(900, 600, start, end), now)
+
+	// Two thirds spent in a third of the period projects to 200%.
+	if math.Abs(stats.ProjectedUtilization-200) > 1 {
+		t.Errorf("ProjectedUtilization = %.2f, want ~200", stats.ProjectedUtilization)
+	}
+}
+
+func TestComputeUsageStats_FinishedPeriodUsesActualSpend(t *testing.T) {
+	start := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	stats := computeUsageStats(usageBudget(1000, 950, start, end), now)
+
+	if stats.DaysRemaining != 0 {
+		t.Errorf("DaysRemaining = %d, want 0 after the period", stats.DaysRemaining)
+	}
+	if stats.ProjectedUtilization != stats.SpentPercent {
+		t.Errorf("ProjectedUtilization = %.2f, want actual %.2f once the period is over",
+			stats.ProjectedUtilization, stats.SpentPercent)
+	}
+	if stats.Status != BudgetStatusCritical {
+		t.Errorf("Status = %q, want critical at 95%%", stats.Status)
+	}
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+// Pace indicators comparing the spend rate to the time rate of a budget
+// period.
+const (
+	PaceUnder = "under_pace"
+	PaceOn    = "on_pace"
+	PaceOver  = "over_pace"
+)
+
+// paceTolerancePercent is how far (in percentage points) spending may
+// drift from the elapsed-time line and still count as on pace.
+const paceTolerancePercent = 5.0
+
+// CalculateBudgetUtilization reports spent, remaining and the pace of a
+// budget as of now.
+func (s *BudgetService) CalculateBudgetUtilization(ctx context.Context, budgetID uuid.UUID) (*dto.BudgetUtilizationDTO, error) {
+	b, err := s.budgetRepo.GetByID(ctx, budgetID)
+	if err != nil {
+		return nil, fmt.Errorf("load budget: %w", err)
+	}
+	return computeUtilization(b, time.Now()), nil
+}
+
+// computeUtilization derives the utilization of b at the given instant.
+// The pace compares the percent of the cap consumed against the percent
+// of the period elapsed: spending ahead of the calendar is over pace.
+func computeUtilization(b *budget.Budget, now time.Time) *dto.BudgetUtilizationDTO {
+	stats := computeUsageStats(b, now)
+	percentElapsed := float64(stats.DaysElapsed) / float64(stats.DaysTotal) * 100
+
+	pace := PaceOn
+	switch {
+	case stats.SpentPercent > percentElapsed+paceTolerancePercent:
+		pace = PaceOver
+	case stats.SpentPercent < percentElapsed-paceTolerancePercent:
+		pace = PaceUnder
+	}
+
+	return &dto.BudgetUtilizationDTO{
+		BudgetID:       b.ID,
+		Spent:          b.Spent,
+		Remaining:      b.GetRemainingAmount(),
+		PercentUsed:    stats.SpentPercent,
+		PercentElapsed: percentElapsed,
+		Pace:           pace,
+	}
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+// The pace scenarios all use a 30-day March period observed 12 days in
+// (40% elapsed).
+func paceFixture() (start, end, now time.Time) {
+	start = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end = time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC)
+	now = time.Date(2026, 3, 12, 12, 0, 0, 0, time.UTC)
+	return start, end, now
+}
+
+func TestComputeUtilization_OverPace(t *testing.T) {
+	start, end, now := paceFixture()
+
+	// 60% spent at 40% through the month.
+	u := computeUtilization(usageBudget(1000, 600, start, end), now)
+
+	if u.Pace != PaceOver {
+		t.Errorf("Pace = %q, want over_pace for 60%% spent at 40%% elapsed", u.Pace)
+	}
+	if u.Spent != 600 || u.Remaining != 400 {
+		t.Errorf("spent/remaining = %v/%v, want 600/400", u.Spent, u.Remaining)
+	}
+	if math.Abs(u.PercentElapsed-40) > 0.01 {
+		t.Errorf("PercentElapsed = %.2f, want ~40", u.PercentElapsed)
+	}
+}
+
+func TestComputeUtilization_OnPace(t *testing.T) {
+	start, end, now := paceFixture()
+
+	// 42% spent at 40% elapsed is within the tolerance band.
+	u := computeUtilization(usageBudget(1000, 420, start, end), now)
+
+	if u.Pace != PaceOn {
+		t.Errorf("Pace = %q, want on_pace for 42%% spent at 40%% elapsed", u.Pace)
+	}
+}
+
+func TestComputeUtilization_UnderPace(t *testing.T) {
+	start, end, now := paceFixture()
+
+	u := computeUtilization(usageBudget(1000, 200, start, end), now)
+
+	if u.Pace != PaceUnder {
+		t.Errorf("Pace = %q, want under_pace for 20%% spent at 40%% elapsed", u.Pace)
+	}
+}
+
+func TestCalculateBudgetUtilization_LoadsFromRepository(t *testing.T) {
+	budgets := memory.NewBudgetRepository()
+	svc := NewBudgetService(budgets, memory.NewAlertRepository(), memory.NewCategoryRepository())
+	b := usageBudget(500, 100, time.Now().AddDate(0, 0, -5), time.Now().AddDate(0, 0, 25))
+	if err := budgets.Create(context.Background(), b); err != nil {
+		t.Fatalf("seed budget: %v", err)
+	}
+
+	u, err := svc.CalculateBudgetUtilization(context.Background(), b.ID)
+	if err != nil {
+		t.Fatalf("CalculateBudgetUtilization: %v", err)
+	}
+	if u.BudgetID != b.ID || u.Spent != 100 || u.Remaining != 400 {
+		t.Errorf("utilization = %+v, want spent 100 of 500", u)
+	}
+
+	if _, err := svc.CalculateBudgetUtilization(context.Background(), uuid.New()); err == nil {
+		t.Error("unknown budget should error")
+	}
+}
@@ -0,0 +1,265 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/web/validation"
+	"family-budget-service/pkg/uuid"
+)
+
+// DefaultMaxCategoryDepth caps how deeply categories may nest. A root
+// category has depth 1.
+const DefaultMaxCategoryDepth = 3
+
+// ErrCategoryTooDeep is returned when a parent assignment would nest a
+// category beyond the configured depth limit.
+var ErrCategoryTooDeep = fmt.Errorf("category hierarchy too deep")
+
+// ErrCategoryCycle is returned when a parent assignment would make a
+// category its own ancestor.
+var ErrCategoryCycle = fmt.Errorf("category hierarchy cycle")
+
+// ErrCrossFamilyParent is returned when a category's parent belongs to a
+// different family.
+var ErrCrossFamilyParent = fmt.Errorf("parent category belongs to another family")
+
+// CategoryService manages categories and their lifecycle.
+type CategoryService struct {
+	categoryRepo category.Repository
+	// transactionRepo and budgetRepo back usage checks on delete; nil
+	// until wired via SetUsageRepositories.
+	transactionRepo transaction.Repository
+	budgetRepo      budget.Repository
+
+	maxCategoryDepth int
+}
+
+// NewCategoryService builds a CategoryService.
+func NewCategoryService(categoryRepo category.Repository) *CategoryService {
+	return &CategoryService{
+		categoryRepo:     categoryRepo,
+		maxCategoryDepth: DefaultMaxCategoryDepth,
+	}
+}
+
+// SetMaxCategoryDepth overrides the hierarchy depth limit. Non-positive
+// values restore the default.
+func (s *CategoryService) SetMaxCategoryDepth(depth int) {
+	if depth <= 0 {
+		depth = DefaultMaxCategoryDepth
+	}
+	s.maxCategoryDepth = depth
+}
+
+// CreateCategory validates and persists a category.
+func (s *CategoryService) CreateCategory(ctx context.Context, c *category.Category) error {
+	if err := validateCategoryColor(c); err != nil {
+		return err
+	}
+	if err := validateCategoryIcon(c); err != nil {
+		return err
+	}
+	if err := s.validateParentFamily(ctx, c); err != nil {
+		return err
+	}
+	if err := s.ValidateCategoryHierarchy(ctx, c.ID, c.ParentID); err != nil {
+		return err
+	}
+	return s.categoryRepo.Create(ctx, c)
+}
+
+// UpdateCategory validates and persists changes to a category.
+func (s *CategoryService) UpdateCategory(ctx context.Context, c *category.Category) error {
+	if err := validateCategoryColor(c); err != nil {
+		return err
+	}
+	if err := validateCategoryIcon(c); err != nil {
+		return err
+	}
+	if err := s.validateParentFamily(ctx, c); err != nil {
+		return err
+	}
+	if err := s.ValidateCategoryHierarchy(ctx, c.ID, c.ParentID); err != nil {
+		return err
+	}
+	return s.categoryRepo.Update(ctx, c)
+}
+
+// validateCategoryColor rejects a malformed color code. Color stays
+// optional: empty means the client renders its default.
+func validateCategoryColor(c *category.Category) error {
+	if c.Color == "" {
+		return nil
+	}
+	return validation.ValidateHexColor(c.Color)
+}
+
+// validateCategoryIcon rejects icons outside the curated allow-list.
+// Like color, icon stays optional.
+func validateCategoryIcon(c *category.Category) error {
+	if c.Icon == "" {
+		return nil
+	}
+	return validation.ValidateCategoryIcon(c.Icon)
+}
+
+// validateParentFamily rejects a parent from another family. The check
+// runs against the full category since the child may not be persisted
+// yet.
+func (s *CategoryService) validateParentFamily(ctx context.Context, c *category.Category) error {
+	if c.ParentID == nil || c.ParentID.IsNil() {
+		return nil
+	}
+	parent, err := s.categoryRepo.GetByID(ctx, *c.ParentID)
+	if err != nil {
+		return fmt.Errorf("load parent category %s: %w", *c.ParentID, err)
+	}
+	if parent.FamilyID != c.FamilyID {
+		return fmt.Errorf("%w: parent %s", ErrCrossFamilyParent, parent.ID)
+	}
+	return nil
+}
+
+// ValidateCategoryHierarchy checks that attaching categoryID under
+// parentID keeps the tree acyclic and within the configured depth limit.
+// It walks the parent chain from parentID, rejecting if categoryID
+// appears among the ancestors. A nil parent is always valid (the
+// category becomes a root).
+func (s *CategoryService) ValidateCategoryHierarchy(ctx context.Context, categoryID uuid.UUID, parentID *uuid.UUID) error {
+	if parentID == nil || parentID.IsNil() {
+		return nil
+	}
+	depth := 1 // the category itself
+	current := parentID
+	for current != nil && !current.IsNil() {
+		if *current == categoryID {
+			return fmt.Errorf("%w: %s would become its own ancestor", ErrCategoryCycle, categoryID)
+		}
+		depth++
+		if depth > s.maxCategoryDepth {
+			return fmt.Errorf("%w: depth %d exceeds limit %d", ErrCategoryTooDeep, depth, s.maxCategoryDepth)
+		}
+		c, err := s.categoryRepo.GetByID(ctx, *current)
+		if err != nil {
+			return fmt.Errorf("load parent category %s: %w", *current, err)
+		}
+		current = c.ParentID
+	}
+	return nil
+}
+
+// GetCategoryByID returns a single category.
+func (s *CategoryService) GetCategoryByID(ctx context.Context, id uuid.UUID) (*category.Category, error) {
+	return s.categoryRepo.GetByID(ctx, id)
+}
+
+// GetCategoriesByFamily returns every category of a family.
+func (s *CategoryService) GetCategoriesByFamily(ctx context.Context, familyID uuid.UUID) ([]*category.Category, error) {
+	return s.categoryRepo.GetByFamilyID(ctx, familyID)
+}
+
+// CategoryPreset selects which default category set a new family starts
+// with.
+type CategoryPreset string
+
+const (
+	// PresetDefault is the original generic set.
+	PresetDefault CategoryPreset = "default"
+	// PresetUSHousehold fits a typical US family household.
+	PresetUSHousehold CategoryPreset = "us_household"
+	// PresetStudent fits a student budget.
+	PresetStudent CategoryPreset = "student"
+	// PresetFreelancer fits self-employed users with business costs.
+	PresetFreelancer CategoryPreset = "freelancer"
+)
+
+// ErrUnknownCategoryPreset is returned for an unrecognized preset name.
+var ErrUnknownCategoryPreset = fmt.Errorf("unknown category preset")
+
+// presetCategory is one entry of a default category set.
+type presetCategory struct {
+	name  string
+	typ   category.Type
+	color string
+	icon  string
+}
+
+// categoryPresets defines the selectable default sets. Names, colors and
+// icons are tuned per audience; the default set stays unchanged for
+// existing callers.
+var categoryPresets = map[CategoryPreset][]presetCategory{
+	PresetDefault: {
+		{"Food", category.TypeExpense, "#FF5733", "utensils"},
+		{"Transport", category.TypeExpense, "#33A1FF", "car"},
+		{"Housing", category.TypeExpense, "#8D6E63", "home"},
+		{"Entertainment", category.TypeExpense, "#AB47BC", "film"},
+		{"Health", category.TypeExpense, "#EF5350", "heartbeat"},
+		{"Salary", category.TypeIncome, "#66BB6A", "money-bill"},
+		{"Other income", category.TypeIncome, "#26A69A", "coins"},
+	},
+	PresetUSHousehold: {
+		{"Groceries", category.TypeExpense, "#FF7043", "shopping-cart"},
+		{"Mortgage / Rent", category.TypeExpense, "#8D6E63", "home"},
+		{"Utilities", category.TypeExpense, "#FFCA28", "bolt"},
+		{"Car & Gas", category.TypeExpense, "#33A1FF", "car"},
+		{"Insurance", category.TypeExpense, "#78909C", "shield-alt"},
+		{"Childcare", category.TypeExpense, "#EC407A", "child"},
+		{"Dining out", category.TypeExpense, "#AB47BC", "utensils"},
+		{"Salary", category.TypeIncome, "#66BB6A", "money-bill"},
+		{"Tax refund", category.TypeIncome, "#26A69A", "file-invoice-dollar"},
+	},
+	PresetStudent: {
+		{"Groceries", category.TypeExpense, "#FF7043", "shopping-cart"},
+		{"Rent & Dorm", category.TypeExpense, "#8D6E63", "home"},
+		{"Books & Supplies", category.TypeExpense, "#5C6BC0", "book"},
+		{"Eating out", category.TypeExpense, "#AB47BC", "utensils"},
+		{"Transport", category.TypeExpense, "#33A1FF", "bus"},
+		{"Scholarship", category.TypeIncome, "#66BB6A", "graduation-cap"},
+		{"Part-time job", category.TypeIncome, "#26A69A", "money-bill"},
+	},
+	PresetFreelancer: {
+		{"Groceries", category.TypeExpense, "#FF7043", "shopping-cart"},
+		{"Rent & Office", category.TypeExpense, "#8D6E63", "home"},
+		{"Software & Tools", category.TypeExpense, "#5C6BC0", "laptop"},
+		{"Business travel", category.TypeExpense, "#33A1FF", "plane"},
+		{"Taxes", category.TypeExpense, "#78909C", "file-invoice-dollar"},
+		{"Client income", category.TypeIncome, "#66BB6A", "money-bill"},
+		{"Royalties", category.TypeIncome, "#26A69A", "coins"},
+	},
+}
+
+// CreateDefaultCategories seeds a new family with the chosen preset's
+// categories. An empty preset falls back to PresetDefault.
+func (s *CategoryService) CreateDefaultCategories(ctx context.Context, familyID uuid.UUID, preset CategoryPreset) error {
+	if preset == "" {
+		preset = PresetDefault
+	}
+	entries, ok := categoryPresets[preset]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownCategoryPreset, preset)
+	}
+
+	now := time.Now()
+	for _, entry := range entries {
+		c := &category.Category{
+			ID:        uuid.New(),
+			Name:      entry.name,
+			Type:      entry.typ,
+			Color:     entry.color,
+			Icon:      entry.icon,
+			FamilyID:  familyID,
+			IsActive:  true,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if err := s.categoryRepo.Create(ctx, c); err != nil {
+			return fmt.Errorf("create default category %q: %w", entry.name, err)
+		}
+	}
+	return nil
+}
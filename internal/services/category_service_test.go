@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/web/validation"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestCreateDefaultCategories_Presets(t *testing.T) {
+	tests := []struct {
+		preset   CategoryPreset
+		wantName string
+	}{
+		{PresetDefault, "Food"},
+		{PresetUSHousehold, "Mortgage / Rent"},
+		{PresetStudent, "Books & Supplies"},
+		{PresetFreelancer, "Software & Tools"},
+		{"", "Food"}, // empty preset falls back to the default set
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.preset), func(t *testing.T) {
+			repo := memory.NewCategoryRepository()
+			svc := NewCategoryService(repo)
+			familyID := uuid.New()
+
+			if err := svc.CreateDefaultCategories(context.Background(), familyID, tt.preset); err != nil {
+				t.Fatalf("CreateDefaultCategories: %v", err)
+			}
+			created, err := repo.GetByFamilyID(context.Background(), familyID)
+			if err != nil || len(created) == 0 {
+				t.Fatalf("GetByFamilyID = %d categories, %v", len(created), err)
+			}
+			found := false
+			for _, c := range created {
+				if c.Name == tt.wantName {
+					found = true
+				}
+				if c.Color == "" || c.Icon == "" {
+					t.Errorf("category %q missing color/icon", c.Name)
+				}
+				if !c.IsActive {
+					t.Errorf("category %q should start active", c.Name)
+				}
+			}
+			if !found {
+				t.Errorf("preset %q should include %q", tt.preset, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestCreateDefaultCategories_UnknownPreset(t *testing.T) {
+	svc := NewCategoryService(memory.NewCategoryRepository())
+	err := svc.CreateDefaultCategories(context.Background(), uuid.New(), "retiree")
+	if !errors.Is(err, ErrUnknownCategoryPreset) {
+		t.Errorf("err = %v, want ErrUnknownCategoryPreset", err)
+	}
+}
+
+func seedCategoryChain(t *testing.T, repo *memory.CategoryRepository, familyID uuid.UUID, depth int) []*category.Category {
+	t.Helper()
+	chain := make([]*category.Category, 0, depth)
+	var parent *uuid.UUID
+	for i := 0; i < depth; i++ {
+		c := &category.Category{
+			ID: uuid.New(), Name: fmt.Sprintf("level-%d", i+1),
+			Type: category.TypeExpense, FamilyID: familyID, ParentID: parent, IsActive: true,
+		}
+		if err := repo.Create(context.Background(), c); err != nil {
+			t.Fatalf("seed level %d: %v", i+1, err)
+		}
+		parent = &c.ID
+		chain = append(chain, c)
+	}
+	return chain
+}
+
+func TestValidateCategoryHierarchy_DepthLimit(t *testing.T) {
+	repo := memory.NewCategoryRepository()
+	svc := NewCategoryService(repo)
+	familyID := uuid.New()
+	chain := seedCategoryChain(t, repo, familyID, 3)
+
+	// A child of the depth-2 node lands exactly at the limit of 3.
+	atLimit := &category.Category{
+		ID: uuid.New(), Name: "at limit", Type: category.TypeExpense,
+		FamilyID: familyID, ParentID: &chain[1].ID, IsActive: true,
+	}
+	if err := svc.CreateCategory(context.Background(), atLimit); err != nil {
+		t.Errorf("category at depth limit rejected: %v", err)
+	}
+
+	// A child of the depth-3 node would be depth 4.
+	tooDeep := &category.Category{
+		ID: uuid.New(), Name: "too deep", Type: category.TypeExpense,
+		FamilyID: familyID, ParentID: &chain[2].ID, IsActive: true,
+	}
+	err := svc.CreateCategory(context.Background(), tooDeep)
+	if !errors.Is(err, ErrCategoryTooDeep) {
+		t.Errorf("err = %v, want ErrCategoryTooDeep", err)
+	}
+
+	// The limit is configurable.
+	svc.SetMaxCategoryDepth(4)
+	if err := svc.CreateCategory(context.Background(), tooDeep); err != nil {
+		t.Errorf("depth 4 should pass with a limit of 4: %v", err)
+	}
+}
+
+func TestValidateCategoryHierarchy_Cycles(t *testing.T) {
+	repo := memory.NewCategoryRepository()
+	svc := NewCategoryService(repo)
+	familyID := uuid.New()
+	chain := seedCategoryChain(t, repo, familyID, 3)
+
+	// Direct cycle: a category parented to itself.
+	self := chain[0]
+	self.ParentID = &self.ID
+	if err := svc.UpdateCategory(context.Background(), self); !errors.Is(err, ErrCategoryCycle) {
+		t.Errorf("err = %v, want ErrCategoryCycle for a self-parent", err)
+	}
+
+	// Indirect cycle: reparenting the root under its own grandchild.
+	root := chain[0]
+	root.ParentID = &chain[2].ID
+	if err := svc.UpdateCategory(context.Background(), root); !errors.Is(err, ErrCategoryCycle) {
+		t.Errorf("err = %v, want ErrCategoryCycle for an indirect cycle", err)
+	}
+}
+
+func TestCreateCategory_CrossFamilyParentRejected(t *testing.T) {
+	repo := memory.NewCategoryRepository()
+	svc := NewCategoryService(repo)
+	parentChain := seedCategoryChain(t, repo, uuid.New(), 1)
+
+	orphan := &category.Category{
+		ID: uuid.New(), Name: "orphan", Type: category.TypeExpense,
+		FamilyID: uuid.New(), ParentID: &parentChain[0].ID, IsActive: true,
+	}
+	if err := svc.CreateCategory(context.Background(), orphan); !errors.Is(err, ErrCrossFamilyParent) {
+		t.Errorf("err = %v, want ErrCrossFamilyParent", err)
+	}
+}
+
+func TestCreateCategory_ColorValidation(t *testing.T) {
+	svc := NewCategoryService(memory.NewCategoryRepository())
+	create := func(color string) error {
+		return svc.CreateCategory(context.Background(), &category.Category{
+			ID: uuid.New(), Name: "colored", Type: category.TypeExpense,
+			Color: color, FamilyID: uuid.New(), IsActive: true,
+		})
+	}
+
+	if err := create("#FF5733"); err != nil {
+		t.Errorf("valid color rejected: %v", err)
+	}
+	if err := create(""); err != nil {
+		t.Errorf("empty color should stay optional: %v", err)
+	}
+	if err := create("FF5733"); !errors.Is(err, validation.ErrInvalidHexColor) {
+		t.Errorf("err = %v, want ErrInvalidHexColor without '#'", err)
+	}
+}
+
+func TestCreateCategory_IconValidation(t *testing.T) {
+	svc := NewCategoryService(memory.NewCategoryRepository())
+	create := func(icon string) error {
+		return svc.CreateCategory(context.Background(), &category.Category{
+			ID: uuid.New(), Name: "iconed", Type: category.TypeExpense,
+			Icon: icon, FamilyID: uuid.New(), IsActive: true,
+		})
+	}
+
+	if err := create("utensils"); err != nil {
+		t.Errorf("allow-listed icon rejected: %v", err)
+	}
+	if err := create(""); err != nil {
+		t.Errorf("empty icon should stay optional: %v", err)
+	}
+	if err := create("💰"); !errors.Is(err, validation.ErrInvalidCategoryIcon) {
+		t.Errorf("err = %v, want ErrInvalidCategoryIcon", err)
+	}
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrCategoryInUse is returned when deleting a category that still has
+// transactions or budgets referencing it.
+var ErrCategoryInUse = fmt.Errorf("category in use")
+
+// CategoryUsage counts the records still referencing a category. A
+// transaction counts once whether it references the category directly or
+// through one of its splits.
+type CategoryUsage struct {
+	TransactionCount int
+	BudgetCount      int
+}
+
+// InUse reports whether anything still references the category.
+func (u CategoryUsage) InUse() bool {
+	return u.TransactionCount > 0 || u.BudgetCount > 0
+}
+
+// SetUsageRepositories wires the repositories consulted by usage checks.
+// Either may be nil; that dimension then always counts zero.
+func (s *CategoryService) SetUsageRepositories(transactionRepo transaction.Repository, budgetRepo budget.Repository) {
+	s.transactionRepo = transactionRepo
+	s.budgetRepo = budgetRepo
+}
+
+// CheckCategoryUsage counts the transactions and budgets of the
+// category's family that still reference it.
+func (s *CategoryService) CheckCategoryUsage(ctx context.Context, categoryID uuid.UUID) (CategoryUsage, error) {
+	c, err := s.categoryRepo.GetByID(ctx, categoryID)
+	if err != nil {
+		return CategoryUsage{}, fmt.Errorf("load category: %w", err)
+	}
+
+	var usage CategoryUsage
+	if s.transactionRepo != nil {
+		transactions, err := s.transactionRepo.GetByFamilyID(ctx, c.FamilyID)
+		if err != nil {
+			return CategoryUsage{}, fmt.Errorf("load transactions: %w", err)
+		}
+		for _, t := range transactions {
+			if transactionReferences(t, categoryID) {
+				usage.TransactionCount++
+			}
+		}
+	}
+	if s.budgetRepo != nil {
+		budgets, err := s.budgetRepo.GetByFamilyID(ctx, c.FamilyID)
+		if err != nil {
+			return CategoryUsage{}, fmt.Errorf("load budgets: %w", err)
+		}
+		for _, b := range budgets {
+			if b.CategoryID != nil && *b.CategoryID == categoryID {
+				usage.BudgetCount++
+			}
+		}
+	}
+	return usage, nil
+}
+
+// ErrIncompatibleReassignTarget is returned when the reassignment target
+// of a delete belongs to another family or has a different type.
+var ErrIncompatibleReassignTarget = fmt.Errorf("incompatible reassignment target")
+
+// DeleteCategory removes a category. Without a reassignment target it
+// refuses with ErrCategoryInUse while transactions or budgets still
+// reference the category; with one, those references move to the target
+// first. The target must exist, belong to the same family and share the
+// category's type.
+func (s *CategoryService) DeleteCategory(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error {
+	usage, err := s.CheckCategoryUsage(ctx, id)
+	if err != nil {
+		return err
+	}
+	if usage.InUse() {
+		if reassignTo == nil || reassignTo.IsNil() {
+			return fmt.Errorf("%w: %d transactions, %d budgets", ErrCategoryInUse, usage.TransactionCount, usage.BudgetCount)
+		}
+		if err := s.reassignReferences(ctx, id, *reassignTo); err != nil {
+			return err
+		}
+	}
+	return s.categoryRepo.Delete(ctx, id)
+}
+
+// reassignReferences moves every transaction and budget reference from
+// the source category to the target after validating compatibility.
+func (s *CategoryService) reassignReferences(ctx context.Context, from, to uuid.UUID) error {
+	source, err := s.categoryRepo.GetByID(ctx, from)
+	if err != nil {
+		return fmt.Errorf("load category: %w", err)
+	}
+	target, err := s.categoryRepo.GetByID(ctx, to)
+	if err != nil {
+		return fmt.Errorf("load reassignment target: %w", err)
+	}
+	if target.FamilyID != source.FamilyID {
+		return fmt.Errorf("%w: different family", ErrIncompatibleReassignTarget)
+	}
+	if target.Type != source.Type {
+		return fmt.Errorf("%w: %s category cannot absorb %s", ErrIncompatibleReassignTarget, target.Type, source.Type)
+	}
+
+	if s.transactionRepo != nil {
+		transactions, err := s.transactionRepo.GetByFamilyID(ctx, source.FamilyID)
+		if err != nil {
+			return fmt.Errorf("load transactions: %w", err)
+		}
+		for _, t := range transactions {
+			if !transactionReferences(t, from) {
+				continue
+			}
+			if t.CategoryID == from {
+				t.CategoryID = to
+			}
+			for i := range t.Splits {
+				if t.Splits[i].CategoryID == from {
+					t.Splits[i].CategoryID = to
+				}
+			}
+			if err := s.transactionRepo.Update(ctx, t); err != nil {
+				return fmt.Errorf("reassign transaction %s: %w", t.ID, err)
+			}
+		}
+	}
+	if s.budgetRepo != nil {
+		budgets, err := s.budgetRepo.GetByFamilyID(ctx, source.FamilyID)
+		if err != nil {
+			return fmt.Errorf("load budgets: %w", err)
+		}
+		for _, b := range budgets {
+			if b.CategoryID == nil || *b.CategoryID != from {
+				continue
+			}
+			reassigned := to
+			b.CategoryID = &reassigned
+			if err := s.budgetRepo.Update(ctx, b); err != nil {
+				return fmt.Errorf("reassign budget %s: %w", b.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// transactionReferences reports whether t charges the category directly
+// or through a split.
+func transactionReferences(t *transaction.Transaction, categoryID uuid.UUID) bool {
+	for _, a := range t.Allocations() {
+		if a.CategoryID == categoryID {
+			return true
+		}
+	}
+	return false
+}
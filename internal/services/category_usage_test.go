@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func newUsageFixture() (*CategoryService, *memory.TransactionRepository, *memory.BudgetRepository) {
+	transactions := memory.NewTransactionRepository()
+	budgets := memory.NewBudgetRepository()
+	svc := NewCategoryService(memory.NewCategoryRepository())
+	svc.SetUsageRepositories(transactions, budgets)
+	return svc, transactions, budgets
+}
+
+func TestDeleteCategory_BlockedWhileInUse(t *testing.T) {
+	svc, transactions, budgets := newUsageFixture()
+	familyID := uuid.New()
+	c := &category.Category{ID: uuid.New(), Name: "Food", Type: category.TypeExpense, FamilyID: familyID, IsActive: true}
+	if err := svc.CreateCategory(context.Background(), c); err != nil {
+		t.Fatalf("create category: %v", err)
+	}
+	err := transactions.Create(context.Background(), &transaction.Transaction{
+		ID: uuid.New(), Amount: 25, Type: transaction.TypeExpense,
+		CategoryID: c.ID, UserID: uuid.New(), FamilyID: familyID,
+	})
+	if err != nil {
+		t.Fatalf("seed transaction: %v", err)
+	}
+	err = budgets.Create(context.Background(), &budget.Budget{
+		ID: uuid.New(), Name: "Food cap", Amount: 500,
+		CategoryID: &c.ID, FamilyID: familyID, IsActive: true,
+	})
+	if err != nil {
+		t.Fatalf("seed budget: %v", err)
+	}
+
+	usage, err := svc.CheckCategoryUsage(context.Background(), c.ID)
+	if err != nil {
+		t.Fatalf("CheckCategoryUsage: %v", err)
+	}
+	if usage.TransactionCount != 1 || usage.BudgetCount != 1 {
+		t.Errorf("usage = %+v, want 1 transaction and 1 budget", usage)
+	}
+
+	if err := svc.DeleteCategory(context.Background(), c.ID, nil); !errors.Is(err, ErrCategoryInUse) {
+		t.Fatalf("err = %v, want ErrCategoryInUse", err)
+	}
+	if _, err := svc.GetCategoryByID(context.Background(), c.ID); err != nil {
+		t.Errorf("category should survive a refused delete: %v", err)
+	}
+}
+
+func TestDeleteCategory_SplitReferenceCounts(t *testing.T) {
+	svc, transactions, _ := newUsageFixture()
+	familyID := uuid.New()
+	split := &category.Category{ID: uuid.New(), Name: "Household", Type: category.TypeExpense, FamilyID: familyID, IsActive: true}
+	if err := svc.CreateCategory(context.Background(), split); err != nil {
+		t.Fatalf("create category: %v", err)
+	}
+	err := transactions.Create(context.Background(), &transaction.Transaction{
+		ID: uuid.New(), Amount: 60, Type: transaction.TypeExpense,
+		CategoryID: uuid.New(), UserID: uuid.New(), FamilyID: familyID,
+		Splits: []transaction.Split{
+			{CategoryID: uuid.New(), Amount: 40},
+			{CategoryID: split.ID, Amount: 20},
+		},
+	})
+	if err != nil {
+		t.Fatalf("seed split transaction: %v", err)
+	}
+
+	if err := svc.DeleteCategory(context.Background(), split.ID, nil); !errors.Is(err, ErrCategoryInUse) {
+		t.Errorf("err = %v, want ErrCategoryInUse for a split reference", err)
+	}
+}
+
+func TestDeleteCategory_AllowedWhenEmpty(t *testing.T) {
+	svc, _, _ := newUsageFixture()
+	c := &category.Category{ID: uuid.New(), Name: "Unused", Type: category.TypeExpense, FamilyID: uuid.New(), IsActive: true}
+	if err := svc.CreateCategory(context.Background(), c); err != nil {
+		t.Fatalf("create category: %v", err)
+	}
+
+	if err := svc.DeleteCategory(context.Background(), c.ID, nil); err != nil {
+		t.Fatalf("DeleteCategory: %v", err)
+	}
+	if _, err := svc.GetCategoryByID(context.Background(), c.ID); !errors.Is(err, category.ErrNotFound) {
+		t.Errorf("err = %v, want ErrNotFound after delete", err)
+	}
+}
+
+func TestDeleteCategory_ReassignsReferences(t *testing.T) {
+	svc, transactions, budgets := newUsageFixture()
+	familyID := uuid.New()
+	source := &category.Category{ID: uuid.New(), Name: "Dining", Type: category.TypeExpense, FamilyID: familyID, IsActive: true}
+	target := &category.Category{ID: uuid.New(), Name: "Food", Type: category.TypeExpense, FamilyID: familyID, IsActive: true}
+	for _, c := range []*category.Category{source, target} {
+		if err := svc.CreateCategory(context.Background(), c); err != nil {
+			t.Fatalf("create category: %v", err)
+		}
+	}
+	direct := &transaction.Transaction{
+		ID: uuid.New(), Amount: 30, Type: transaction.TypeExpense,
+		CategoryID: source.ID, UserID: uuid.New(), FamilyID: familyID,
+	}
+	withSplit := &transaction.Transaction{
+		ID: uuid.New(), Amount: 50, Type: transaction.TypeExpense,
+		CategoryID: uuid.New(), UserID: uuid.New(), FamilyID: familyID,
+		Splits: []transaction.Split{
+			{CategoryID: source.ID, Amount: 35},
+			{CategoryID: uuid.New(), Amount: 15},
+		},
+	}
+	for _, tr := range []*transaction.Transaction{direct, withSplit} {
+		if err := transactions.Create(context.Background(), tr); err != nil {
+			t.Fatalf("seed transaction: %v", err)
+		}
+	}
+	b := &budget.Budget{
+		ID: uuid.New(), Name: "Dining cap", Amount: 300,
+		CategoryID: &source.ID, FamilyID: familyID, IsActive: true,
+	}
+	if err := budgets.Create(context.Background(), b); err != nil {
+		t.Fatalf("seed budget: %v", err)
+	}
+
+	if err := svc.DeleteCategory(context.Background(), source.ID, &target.ID); err != nil {
+		t.Fatalf("DeleteCategory with reassignment: %v", err)
+	}
+
+	usage, err := svc.CheckCategoryUsage(context.Background(), target.ID)
+	if err != nil {
+		t.Fatalf("CheckCategoryUsage: %v", err)
+	}
+	if usage.TransactionCount != 2 || usage.BudgetCount != 1 {
+		t.Errorf("target usage = %+v, want both transactions and the budget", usage)
+	}
+	if _, err := svc.GetCategoryByID(context.Background(), source.ID); !errors.Is(err, category.ErrNotFound) {
+		t.Errorf("err = %v, want ErrNotFound for the deleted source", err)
+	}
+	moved, err := transactions.GetByID(context.Background(), withSplit.ID)
+	if err != nil {
+		t.Fatalf("reload split transaction: %v", err)
+	}
+	if moved.Splits[0].CategoryID != target.ID {
+		t.Errorf("split category = %s, want the reassignment target", moved.Splits[0].CategoryID)
+	}
+}
+
+func TestDeleteCategory_RejectsIncompatibleTarget(t *testing.T) {
+	svc, transactions, _ := newUsageFixture()
+	familyID := uuid.New()
+	source := &category.Category{ID: uuid.New(), Name: "Dining", Type: category.TypeExpense, FamilyID: familyID, IsActive: true}
+	income := &category.Category{ID: uuid.New(), Name: "Salary", Type: category.TypeIncome, FamilyID: familyID, IsActive: true}
+	foreign := &category.Category{ID: uuid.New(), Name: "Food", Type: category.TypeExpense, FamilyID: uuid.New(), IsActive: true}
+	for _, c := range []*category.Category{source, income, foreign} {
+		if err := svc.CreateCategory(context.Background(), c); err != nil {
+			t.Fatalf("create category: %v", err)
+		}
+	}
+	err := transactions.Create(context.Background(), &transaction.Transaction{
+		ID: uuid.New(), Amount: 30, Type: transaction.TypeExpense,
+		CategoryID: source.ID, UserID: uuid.New(), FamilyID: familyID,
+	})
+	if err != nil {
+		t.Fatalf("seed transaction: %v", err)
+	}
+
+	if err := svc.DeleteCategory(context.Background(), source.ID, &income.ID); !errors.Is(err, ErrIncompatibleReassignTarget) {
+		t.Errorf("err = %v, want ErrIncompatibleReassignTarget for a type mismatch", err)
+	}
+	if err := svc.DeleteCategory(context.Background(), source.ID, &foreign.ID); !errors.Is(err, ErrIncompatibleReassignTarget) {
+		t.Errorf("err = %v, want ErrIncompatibleReassignTarget for another family", err)
+	}
+	if _, err := svc.GetCategoryByID(context.Background(), source.ID); err != nil {
+		t.Errorf("category should survive a refused reassignment: %v", err)
+	}
+}
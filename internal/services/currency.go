@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrRateUnavailable is returned when no exchange rate is known for a
+// currency pair.
+var ErrRateUnavailable = errors.New("no exchange rate for currency pair")
+
+// RateLookup resolves exchange rates at transaction creation time. The
+// rate multiplies an amount in `from` to yield the equivalent in `to`.
+// Implementations may be backed by a manual table or an external feed.
+type RateLookup interface {
+	Rate(ctx context.Context, from, to string) (float64, error)
+}
+
+// StaticRateLookup is a manually maintained rate table. It answers the
+// inverse pair too, so seeding EUR/USD also covers USD/EUR.
+type StaticRateLookup struct {
+	mu    sync.RWMutex
+	rates map[string]float64 // keyed "FROM/TO"
+}
+
+// NewStaticRateLookup builds a rate table from the given "FROM/TO" keyed
+// rates; rates may be nil.
+func NewStaticRateLookup(rates map[string]float64) *StaticRateLookup {
+	table := make(map[string]float64, len(rates))
+	for pair, rate := range rates {
+		table[pair] = rate
+	}
+	return &StaticRateLookup{rates: table}
+}
+
+// SetRate stores or replaces the rate for a currency pair.
+func (l *StaticRateLookup) SetRate(from, to string, rate float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rates[from+"/"+to] = rate
+}
+
+// Rate returns the stored rate for from→to, falling back to the inverse
+// of to→from when only that direction is seeded.
+func (l *StaticRateLookup) Rate(_ context.Context, from, to string) (float64, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if rate, ok := l.rates[from+"/"+to]; ok {
+		return rate, nil
+	}
+	if rate, ok := l.rates[to+"/"+from]; ok && rate != 0 {
+		return 1 / rate, nil
+	}
+	return 0, fmt.Errorf("%w: %s/%s", ErrRateUnavailable, from, to)
+}
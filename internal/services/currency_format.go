@@ -0,0 +1,82 @@
+package services
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// currencyFormat describes how one currency renders: its symbol and
+// placement, decimal places, and the separators the locale expects.
+type currencyFormat struct {
+	symbol       string
+	decimals     int
+	thousandsSep string
+	decimalSep   string
+	symbolFirst  bool
+}
+
+// currencyFormats covers the currencies the service renders natively.
+// Unknown codes fall back to a neutral "1,234.50 XXX" form.
+var currencyFormats = map[string]currencyFormat{
+	"USD": {symbol: "$", decimals: 2, thousandsSep: ",", decimalSep: ".", symbolFirst: true},
+	"GBP": {symbol: "£", decimals: 2, thousandsSep: ",", decimalSep: ".", symbolFirst: true},
+	"EUR": {symbol: "€", decimals: 2, thousandsSep: " ", decimalSep: ","},
+	"RUB": {symbol: "₽", decimals: 2, thousandsSep: " ", decimalSep: ","},
+	// Yen has no minor unit: amounts round to whole numbers.
+	"JPY": {symbol: "¥", decimals: 0, thousandsSep: ",", decimalSep: ".", symbolFirst: true},
+}
+
+// FormatCurrency renders amount in the given ISO 4217 currency with its
+// symbol, decimal places and thousands grouping. An empty code renders
+// the bare grouped number; an unknown code appends the code instead of a
+// symbol.
+func FormatCurrency(code string, amount float64) string {
+	f, known := currencyFormats[code]
+	if !known {
+		f = currencyFormat{decimals: 2, thousandsSep: ",", decimalSep: "."}
+	}
+
+	negative := amount < 0
+	number := groupedNumber(math.Abs(amount), f)
+
+	var b strings.Builder
+	if negative {
+		b.WriteString("-")
+	}
+	switch {
+	case known && f.symbolFirst:
+		b.WriteString(f.symbol)
+		b.WriteString(number)
+	case known:
+		b.WriteString(number)
+		b.WriteString(" ")
+		b.WriteString(f.symbol)
+	case code != "":
+		b.WriteString(number)
+		b.WriteString(" ")
+		b.WriteString(code)
+	default:
+		b.WriteString(number)
+	}
+	return b.String()
+}
+
+// groupedNumber renders a non-negative amount with f's separators.
+func groupedNumber(amount float64, f currencyFormat) string {
+	fixed := strconv.FormatFloat(amount, 'f', f.decimals, 64)
+	intPart, fracPart, _ := strings.Cut(fixed, ".")
+
+	var b strings.Builder
+	for i, digit := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteString(f.thousandsSep)
+		}
+		b.WriteRune(digit)
+	}
+	if fracPart != "" {
+		b.WriteString(f.decimalSep)
+		b.WriteString(fracPart)
+	}
+	return b.String()
+}
@@ -0,0 +1,36 @@
+package services
+
+import "testing"
+
+func TestFormatCurrency_PerCurrencyRendering(t *testing.T) {
+	const amount = 1234567.891
+
+	tests := []struct {
+		code string
+		want string
+	}{
+		{"USD", "$1,234,567.89"},
+		{"EUR", "1 234 567,89 €"},
+		{"JPY", "¥1,234,568"}, // no minor unit: rounds to whole yen
+		{"RUB", "1 234 567,89 ₽"},
+		{"XXX", "1,234,567.89 XXX"},
+		{"", "1,234,567.89"},
+	}
+	for _, tt := range tests {
+		if got := FormatCurrency(tt.code, amount); got != tt.want {
+			t.Errorf("FormatCurrency(%q) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestFormatCurrency_SmallAndNegativeAmounts(t *testing.T) {
+	if got := FormatCurrency("USD", 7.5); got != "$7.50" {
+		t.Errorf("small amount = %q, want $7.50", got)
+	}
+	if got := FormatCurrency("USD", -42); got != "-$42.00" {
+		t.Errorf("negative amount = %q, want -$42.00", got)
+	}
+	if got := FormatCurrency("EUR", 999); got != "999,00 €" {
+		t.Errorf("ungrouped amount = %q, want 999,00 €", got)
+	}
+}
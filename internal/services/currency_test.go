@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func newCurrencyFixture(t *testing.T) (*TransactionService, *memory.FamilyRepository, *memory.UserRepository) {
+	t.Helper()
+	users := memory.NewUserRepository()
+	families := memory.NewFamilyRepository()
+	svc := NewTransactionService(memory.NewTransactionRepository(), users)
+	svc.SetCurrencyConversion(families, NewStaticRateLookup(map[string]float64{"EUR/USD": 1.10}))
+	return svc, families, users
+}
+
+func seedFamily(t *testing.T, families *memory.FamilyRepository, currency string) *user.Family {
+	t.Helper()
+	f := &user.Family{ID: uuid.New(), Name: "Smiths", Currency: currency}
+	if err := families.Create(context.Background(), f); err != nil {
+		t.Fatalf("seed family: %v", err)
+	}
+	return f
+}
+
+func TestStaticRateLookup_AnswersInversePair(t *testing.T) {
+	lookup := NewStaticRateLookup(map[string]float64{"EUR/USD": 1.25})
+
+	rate, err := lookup.Rate(context.Background(), "USD", "EUR")
+	if err != nil || rate != 0.8 {
+		t.Errorf("inverse rate = %v, %v; want 0.8", rate, err)
+	}
+	if _, err := lookup.Rate(context.Background(), "GBP", "USD"); !errors.Is(err, ErrRateUnavailable) {
+		t.Errorf("unseeded pair err = %v, want ErrRateUnavailable", err)
+	}
+}
+
+func TestCreateTransaction_ForeignCurrencyConvertsToBase(t *testing.T) {
+	svc, families, users := newCurrencyFixture(t)
+	f := seedFamily(t, families, "USD")
+	u := seedFamilyUser(t, users, f.ID)
+
+	tx := expenseFor(f.ID, u.ID)
+	tx.Amount = 0
+	tx.OriginalCurrency = "EUR"
+	tx.OriginalAmount = 100
+	if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+	if tx.Amount != 110 || tx.ExchangeRate != 1.10 {
+		t.Errorf("converted = %v at rate %v, want 110 at 1.10", tx.Amount, tx.ExchangeRate)
+	}
+
+	// Budget spent is tracked on the base-currency amount, so the EUR
+	// expense contributes its converted USD value.
+	budgets := memory.NewBudgetRepository()
+	b := &budget.Budget{
+		ID: uuid.New(), Name: "Travel", Amount: 500, FamilyID: f.ID,
+		Period: budget.PeriodMonthly, IsActive: true,
+		StartDate: time.Now().AddDate(0, 0, -5), EndDate: time.Now().AddDate(0, 0, 25),
+	}
+	if err := budgets.Create(context.Background(), b); err != nil {
+		t.Fatalf("seed budget: %v", err)
+	}
+	budgetSvc := NewBudgetService(budgets, memory.NewAlertRepository(), memory.NewCategoryRepository())
+	if _, err := budgetSvc.ApplySpentDelta(context.Background(), b.ID, tx.Amount); err != nil {
+		t.Fatalf("ApplySpentDelta: %v", err)
+	}
+	updated, err := budgets.GetByID(context.Background(), b.ID)
+	if err != nil || updated.Spent != 110 {
+		t.Errorf("budget spent = %v, %v; want 110", updated.Spent, err)
+	}
+}
+
+func TestCreateTransaction_BaseCurrencyPassesThrough(t *testing.T) {
+	svc, families, users := newCurrencyFixture(t)
+	f := seedFamily(t, families, "USD")
+	u := seedFamilyUser(t, users, f.ID)
+
+	tx := expenseFor(f.ID, u.ID)
+	tx.OriginalCurrency = "USD"
+	tx.OriginalAmount = 25
+	if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+	if tx.Amount != 25 || tx.OriginalCurrency != "" || tx.ExchangeRate != 0 {
+		t.Errorf("base-currency transaction = %+v, want untouched amount with cleared conversion fields", tx)
+	}
+}
+
+func TestCreateTransaction_UnknownRateRejected(t *testing.T) {
+	svc, families, users := newCurrencyFixture(t)
+	f := seedFamily(t, families, "USD")
+	u := seedFamilyUser(t, users, f.ID)
+
+	tx := expenseFor(f.ID, u.ID)
+	tx.OriginalCurrency = "GBP"
+	tx.OriginalAmount = 40
+	if err := svc.CreateTransaction(context.Background(), tx); !errors.Is(err, ErrRateUnavailable) {
+		t.Errorf("err = %v, want ErrRateUnavailable", err)
+	}
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DashboardPeriod is a quick date-range preset of the dashboard filter.
+type DashboardPeriod string
+
+const (
+	PeriodCurrentMonth DashboardPeriod = "current_month"
+	PeriodLast7Days    DashboardPeriod = "last_7_days"
+	PeriodLast30Days   DashboardPeriod = "last_30_days"
+	PeriodLast90Days   DashboardPeriod = "last_90_days"
+	PeriodYearToDate   DashboardPeriod = "year_to_date"
+)
+
+// ErrUnknownDashboardPeriod is returned for a preset outside the known
+// set.
+var ErrUnknownDashboardPeriod = errors.New("unknown dashboard period")
+
+// Valid reports whether p is a known preset.
+func (p DashboardPeriod) Valid() bool {
+	switch p {
+	case PeriodCurrentMonth, PeriodLast7Days, PeriodLast30Days, PeriodLast90Days, PeriodYearToDate:
+		return true
+	}
+	return false
+}
+
+// GetPeriodDates resolves the preset into an inclusive window relative
+// to now. Rolling presets count whole days ending today, so "last 7
+// days" covers today plus the six days before it.
+func (p DashboardPeriod) GetPeriodDates(now time.Time) (time.Time, time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	endOfToday := today.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	switch p {
+	case PeriodCurrentMonth:
+		from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return from, from.AddDate(0, 1, 0).Add(-time.Nanosecond), nil
+	case PeriodLast7Days:
+		return today.AddDate(0, 0, -6), endOfToday, nil
+	case PeriodLast30Days:
+		return today.AddDate(0, 0, -29), endOfToday, nil
+	case PeriodLast90Days:
+		return today.AddDate(0, 0, -89), endOfToday, nil
+	case PeriodYearToDate:
+		return time.Date(now.Year(), 1, 1, 0, 0, 0, 0, now.Location()), endOfToday, nil
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("%w: %q", ErrUnknownDashboardPeriod, p)
+}
+
+// PreviousWindow returns the window of the same duration immediately
+// before [from, to], for custom ranges. Presets must use
+// GetPreviousPeriodDates instead: subtracting a 31-day month's duration
+// would land a day short of the prior calendar month.
+func PreviousWindow(from, to time.Time) (time.Time, time.Time) {
+	length := to.Sub(from)
+	return from.Add(-length).Add(-time.Nanosecond), from.Add(-time.Nanosecond)
+}
+
+// GetPreviousPeriodDates resolves the window immediately before the
+// preset's, with a matching shape: rolling presets get the same number
+// of days ending just before the current window, current_month gets the
+// prior calendar month, and year_to_date gets the same span of the
+// previous year.
+func (p DashboardPeriod) GetPreviousPeriodDates(now time.Time) (time.Time, time.Time, error) {
+	from, _, err := p.GetPeriodDates(now)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	switch p {
+	case PeriodCurrentMonth:
+		prevFrom := from.AddDate(0, -1, 0)
+		return prevFrom, from.Add(-time.Nanosecond), nil
+	case PeriodLast7Days:
+		return from.AddDate(0, 0, -7), from.Add(-time.Nanosecond), nil
+	case PeriodLast30Days:
+		return from.AddDate(0, 0, -30), from.Add(-time.Nanosecond), nil
+	case PeriodLast90Days:
+		return from.AddDate(0, 0, -90), from.Add(-time.Nanosecond), nil
+	case PeriodYearToDate:
+		prevFrom := from.AddDate(-1, 0, 0)
+		prevEnd := time.Date(now.Year()-1, now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).
+			AddDate(0, 0, 1).Add(-time.Nanosecond)
+		return prevFrom, prevEnd, nil
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("%w: %q", ErrUnknownDashboardPeriod, p)
+}
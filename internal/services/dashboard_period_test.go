@@ -0,0 +1,116 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDashboardPeriod_PresetWindows(t *testing.T) {
+	now := time.Date(2026, 8, 26, 15, 4, 5, 0, time.UTC)
+	day := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	endOf := func(y int, m time.Month, d int) time.Time {
+		return day(y, m, d).AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	cases := []struct {
+		preset           DashboardPeriod
+		from, to         time.Time
+		prevFrom, prevTo time.Time
+	}{
+		{
+			preset: PeriodCurrentMonth,
+			from:   day(2026, 8, 1), to: endOf(2026, 8, 31),
+			prevFrom: day(2026, 7, 1), prevTo: endOf(2026, 7, 31),
+		},
+		{
+			preset: PeriodLast7Days,
+			from:   day(2026, 8, 20), to: endOf(2026, 8, 26),
+			prevFrom: day(2026, 8, 13), prevTo: endOf(2026, 8, 19),
+		},
+		{
+			preset: PeriodLast30Days,
+			from:   day(2026, 7, 28), to: endOf(2026, 8, 26),
+			prevFrom: day(2026, 6, 28), prevTo: endOf(2026, 7, 27),
+		},
+		{
+			preset: PeriodLast90Days,
+			from:   day(2026, 5, 29), to: endOf(2026, 8, 26),
+			prevFrom: day(2026, 2, 28), prevTo: endOf(2026, 5, 28),
+		},
+		{
+			preset: PeriodYearToDate,
+			from:   day(2026, 1, 1), to: endOf(2026, 8, 26),
+			prevFrom: day(2025, 1, 1), prevTo: endOf(2025, 8, 26),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(string(tc.preset), func(t *testing.T) {
+			from, to, err := tc.preset.GetPeriodDates(now)
+			if err != nil {
+				t.Fatalf("GetPeriodDates() error = %v", err)
+			}
+			if !from.Equal(tc.from) || !to.Equal(tc.to) {
+				t.Errorf("window = [%v, %v], want [%v, %v]", from, to, tc.from, tc.to)
+			}
+
+			prevFrom, prevTo, err := tc.preset.GetPreviousPeriodDates(now)
+			if err != nil {
+				t.Fatalf("GetPreviousPeriodDates() error = %v", err)
+			}
+			if !prevFrom.Equal(tc.prevFrom) || !prevTo.Equal(tc.prevTo) {
+				t.Errorf("previous = [%v, %v], want [%v, %v]", prevFrom, prevTo, tc.prevFrom, tc.prevTo)
+			}
+			if tc.preset != PeriodYearToDate && !prevTo.Equal(from.Add(-time.Nanosecond)) {
+				t.Errorf("previous window ends at %v, want flush against %v", prevTo, from)
+			}
+		})
+	}
+}
+
+func TestDashboardPeriod_MarchComparesAgainstFullFebruary(t *testing.T) {
+	now := time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)
+	prevFrom, prevTo, err := PeriodCurrentMonth.GetPreviousPeriodDates(now)
+	if err != nil {
+		t.Fatalf("GetPreviousPeriodDates() error = %v", err)
+	}
+	wantFrom := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	wantTo := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+	if !prevFrom.Equal(wantFrom) || !prevTo.Equal(wantTo) {
+		t.Errorf("previous = [%v, %v], want all of February [%v, %v]", prevFrom, prevTo, wantFrom, wantTo)
+	}
+
+	// Leap year: February still ends on its own last day.
+	leap := time.Date(2028, 3, 15, 9, 0, 0, 0, time.UTC)
+	_, prevTo, err = PeriodCurrentMonth.GetPreviousPeriodDates(leap)
+	if err != nil {
+		t.Fatalf("GetPreviousPeriodDates() error = %v", err)
+	}
+	if prevTo.Day() != 29 || prevTo.Month() != time.February {
+		t.Errorf("leap-year previous ends %v, want Feb 29", prevTo)
+	}
+}
+
+func TestPreviousWindow_CustomRangeKeepsDuration(t *testing.T) {
+	from := time.Date(2026, 6, 10, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 6, 20, 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1).Add(-time.Nanosecond)
+	prevFrom, prevTo := PreviousWindow(from, to)
+	if !prevTo.Equal(from.Add(-time.Nanosecond)) {
+		t.Errorf("previous ends at %v, want flush against %v", prevTo, from)
+	}
+	if got, want := prevTo.Sub(prevFrom), to.Sub(from); got != want {
+		t.Errorf("previous duration = %v, want %v", got, want)
+	}
+}
+
+func TestDashboardPeriod_UnknownPresetRejected(t *testing.T) {
+	if DashboardPeriod("fortnight").Valid() {
+		t.Error("unknown preset reported valid")
+	}
+	_, _, err := DashboardPeriod("fortnight").GetPeriodDates(time.Now())
+	if !errors.Is(err, ErrUnknownDashboardPeriod) {
+		t.Errorf("error = %v, want ErrUnknownDashboardPeriod", err)
+	}
+}
@@ -0,0 +1,302 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"family-budget-service/internal/domain/preferences"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// dashboardTopCategoryCount bounds the category list on the dashboard.
+const dashboardTopCategoryCount = 5
+
+// dashboardRecentCount bounds the recent-transactions list.
+const dashboardRecentCount = 5
+
+// Dashboard is the family overview for one period: totals, the biggest
+// expense categories and the latest transactions.
+type Dashboard struct {
+	FamilyID         uuid.UUID
+	From, To         time.Time
+	TotalIncome      float64
+	TotalExpenses    float64
+	NetIncome        float64
+	TransactionCount int
+	TopCategories    []transaction.CategoryTotal
+	Recent           []*transaction.Transaction
+}
+
+// periodTransactionLoader fetches one family period's transactions on
+// first use and hands the same slice to every dashboard builder, so a
+// request that renders several sections queries the repository once.
+// Like metadataLoader it is request-scoped and must not be reused across
+// requests.
+type periodTransactionLoader struct {
+	repo         transaction.Repository
+	familyID     uuid.UUID
+	from, to     time.Time
+	loaded       bool
+	transactions []*transaction.Transaction
+}
+
+func newPeriodTransactionLoader(repo transaction.Repository, familyID uuid.UUID, from, to time.Time) *periodTransactionLoader {
+	return &periodTransactionLoader{repo: repo, familyID: familyID, from: from, to: to}
+}
+
+// Transactions returns the period's transactions, hitting the repository
+// only on the first call.
+func (l *periodTransactionLoader) Transactions(ctx context.Context) ([]*transaction.Transaction, error) {
+	if l.loaded {
+		return l.transactions, nil
+	}
+	transactions, err := l.repo.GetByFilter(ctx, transaction.Filter{
+		FamilyID: l.familyID,
+		DateFrom: &l.from,
+		DateTo:   &l.to,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load dashboard transactions: %w", err)
+	}
+	l.transactions = transactions
+	l.loaded = true
+	return transactions, nil
+}
+
+// DashboardService assembles the family dashboard from one shared
+// period query.
+type DashboardService struct {
+	transactionRepo transaction.Repository
+
+	// preferencesRepo resolves the family timezone for period
+	// boundaries; nil keeps everything in UTC.
+	preferencesRepo preferences.Repository
+}
+
+// NewDashboardService builds a DashboardService.
+func NewDashboardService(transactionRepo transaction.Repository) *DashboardService {
+	return &DashboardService{transactionRepo: transactionRepo}
+}
+
+// SetPreferencesRepository wires the family preferences that carry the
+// dashboard timezone.
+func (s *DashboardService) SetPreferencesRepository(preferencesRepo preferences.Repository) {
+	s.preferencesRepo = preferencesRepo
+}
+
+// FamilyLocation resolves the family's configured timezone. Families
+// without preferences, without a timezone, or with a name the zone
+// database no longer knows get UTC — transactions are stored in UTC, so
+// that is the safe default, not an error.
+func (s *DashboardService) FamilyLocation(ctx context.Context, familyID uuid.UUID) *time.Location {
+	if s.preferencesRepo == nil {
+		return time.UTC
+	}
+	p, err := s.preferencesRepo.GetByFamilyID(ctx, familyID)
+	if err != nil || p.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// GetDashboard computes the dashboard for a family and period. All
+// sections read from one request-scoped loader, so the repository sees a
+// single query per call however many sections render.
+func (s *DashboardService) GetDashboard(ctx context.Context, familyID uuid.UUID, from, to time.Time) (*Dashboard, error) {
+	loader := newPeriodTransactionLoader(s.transactionRepo, familyID, from, to)
+	d := &Dashboard{FamilyID: familyID, From: from, To: to}
+
+	if err := s.buildTotals(ctx, loader, d); err != nil {
+		return nil, err
+	}
+	if err := s.buildTopCategories(ctx, loader, d); err != nil {
+		return nil, err
+	}
+	if err := s.buildRecent(ctx, loader, d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// YearlyOverview is the per-month view of one family year.
+type YearlyOverview struct {
+	FamilyID      uuid.UUID
+	Year          int
+	Months        []transaction.MonthlyTotal
+	TotalIncome   float64
+	TotalExpenses float64
+}
+
+// GetYearlyOverview returns a family's income and expenses bucketed by
+// calendar month, computed by one repository-side aggregate.
+func (s *DashboardService) GetYearlyOverview(ctx context.Context, familyID uuid.UUID, year int) (*YearlyOverview, error) {
+	months, err := s.transactionRepo.GetMonthlyTotals(ctx, familyID, year)
+	if err != nil {
+		return nil, fmt.Errorf("load monthly totals: %w", err)
+	}
+	overview := &YearlyOverview{FamilyID: familyID, Year: year, Months: months}
+	for _, m := range months {
+		overview.TotalIncome = AddAmounts(overview.TotalIncome, m.Income)
+		overview.TotalExpenses = AddAmounts(overview.TotalExpenses, m.Expenses)
+	}
+	return overview, nil
+}
+
+// DashboardChanges compares a dashboard period against the one before
+// it, as the percentage change of each total. A zero previous total
+// yields a zero percentage rather than a division blow-up; the flags say
+// whether a previous baseline existed at all.
+type DashboardChanges struct {
+	PreviousFrom    time.Time
+	PreviousTo      time.Time
+	IncomeChange    float64
+	ExpensesChange  float64
+	HasPrevIncome   bool
+	HasPrevExpenses bool
+}
+
+// GetDashboardWithChanges computes the dashboard plus its change
+// percentages against the given previous window. Callers pick the
+// previous window preset-aware (calendar months compare against the full
+// prior calendar month, not a same-duration slice of it) or via
+// PreviousWindow for custom ranges.
+func (s *DashboardService) GetDashboardWithChanges(
+	ctx context.Context,
+	familyID uuid.UUID,
+	from, to, prevFrom, prevTo time.Time,
+) (*Dashboard, *DashboardChanges, error) {
+	current, err := s.GetDashboard(ctx, familyID, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+	previous := &Dashboard{FamilyID: familyID, From: prevFrom, To: prevTo}
+	loader := newPeriodTransactionLoader(s.transactionRepo, familyID, prevFrom, prevTo)
+	if err := s.buildTotals(ctx, loader, previous); err != nil {
+		return nil, nil, err
+	}
+	return current, calculateChanges(current, previous), nil
+}
+
+// calculateChanges derives the percentage movement between two periods'
+// totals.
+func calculateChanges(current, previous *Dashboard) *DashboardChanges {
+	changes := &DashboardChanges{
+		PreviousFrom:    previous.From,
+		PreviousTo:      previous.To,
+		HasPrevIncome:   previous.TotalIncome > 0,
+		HasPrevExpenses: previous.TotalExpenses > 0,
+	}
+	if changes.HasPrevIncome {
+		changes.IncomeChange = RoundAmount(
+			(current.TotalIncome - previous.TotalIncome) / previous.TotalIncome * 100)
+	}
+	if changes.HasPrevExpenses {
+		changes.ExpensesChange = RoundAmount(
+			(current.TotalExpenses - previous.TotalExpenses) / previous.TotalExpenses * 100)
+	}
+	return changes
+}
+
+// sparklineMonths is the fixed length of the dashboard trend series.
+const sparklineMonths = 12
+
+// SparklinePoint is one month of the dashboard trend series.
+type SparklinePoint struct {
+	Year  int
+	Month time.Month
+	Net   float64
+}
+
+// GetNetSparkline returns the net income of the last sparklineMonths
+// calendar months ending at the month of end, oldest first. The series
+// comes from the repository's monthly aggregates — at most two calls,
+// one per calendar year touched — never from slicing raw transactions.
+func (s *DashboardService) GetNetSparkline(ctx context.Context, familyID uuid.UUID, end time.Time) ([]SparklinePoint, error) {
+	first := time.Date(end.Year(), end.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -(sparklineMonths - 1), 0)
+
+	totals := make(map[int]map[time.Month]transaction.MonthlyTotal, 2)
+	for year := first.Year(); year <= end.Year(); year++ {
+		months, err := s.transactionRepo.GetMonthlyTotals(ctx, familyID, year)
+		if err != nil {
+			return nil, fmt.Errorf("load monthly totals for %d: %w", year, err)
+		}
+		totals[year] = make(map[time.Month]transaction.MonthlyTotal, len(months))
+		for _, m := range months {
+			totals[year][m.Month] = m
+		}
+	}
+
+	points := make([]SparklinePoint, 0, sparklineMonths)
+	for i := 0; i < sparklineMonths; i++ {
+		at := first.AddDate(0, i, 0)
+		m := totals[at.Year()][at.Month()]
+		points = append(points, SparklinePoint{
+			Year:  at.Year(),
+			Month: at.Month(),
+			Net:   RoundAmount(m.Income - m.Expenses),
+		})
+	}
+	return points, nil
+}
+
+// buildTotals fills the period's income, expense and net totals.
+func (s *DashboardService) buildTotals(ctx context.Context, loader *periodTransactionLoader, d *Dashboard) error {
+	transactions, err := loader.Transactions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range transactions {
+		d.TransactionCount++
+		switch t.Type {
+		case transaction.TypeIncome:
+			d.TotalIncome = AddAmounts(d.TotalIncome, t.Amount)
+		case transaction.TypeExpense:
+			d.TotalExpenses = AddAmounts(d.TotalExpenses, t.Amount)
+		}
+	}
+	d.NetIncome = RoundAmount(d.TotalIncome - d.TotalExpenses)
+	return nil
+}
+
+// buildTopCategories fills the largest expense categories of the period.
+func (s *DashboardService) buildTopCategories(ctx context.Context, loader *periodTransactionLoader, d *Dashboard) error {
+	transactions, err := loader.Transactions(ctx)
+	if err != nil {
+		return err
+	}
+	expenses := make([]*transaction.Transaction, 0, len(transactions))
+	for _, t := range transactions {
+		if t.Type == transaction.TypeExpense {
+			expenses = append(expenses, t)
+		}
+	}
+	totals := transaction.ComputeCategoryTotals(expenses)
+	if len(totals) > dashboardTopCategoryCount {
+		totals = totals[:dashboardTopCategoryCount]
+	}
+	d.TopCategories = totals
+	return nil
+}
+
+// buildRecent fills the period's most recent transactions, newest first.
+func (s *DashboardService) buildRecent(ctx context.Context, loader *periodTransactionLoader, d *Dashboard) error {
+	transactions, err := loader.Transactions(ctx)
+	if err != nil {
+		return err
+	}
+	recent := make([]*transaction.Transaction, len(transactions))
+	copy(recent, transactions)
+	sort.Slice(recent, func(i, j int) bool { return recent[i].Date.After(recent[j].Date) })
+	if len(recent) > dashboardRecentCount {
+		recent = recent[:dashboardRecentCount]
+	}
+	d.Recent = recent
+	return nil
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/preferences"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+// countingTransactionRepo wraps the slice-backed fake and counts
+// GetByFilter calls.
+type countingTransactionRepo struct {
+	fakeTransactionRepo
+	getByFilter int
+}
+
+func (c *countingTransactionRepo) GetByFilter(ctx context.Context, filter transaction.Filter) ([]*transaction.Transaction, error) {
+	c.getByFilter++
+	return c.fakeTransactionRepo.GetByFilter(ctx, filter)
+}
+
+func TestGetDashboardWithChanges_MarchVsFullFebruary(t *testing.T) {
+	familyID := uuid.New()
+	repo := &fakeTransactionRepo{}
+	// 1000 on Jan 30 sits inside a naive 31-days-before-March window but
+	// outside calendar February; it must not pollute the baseline.
+	repo.transactions = append(repo.transactions,
+		expenseOn(familyID, uuid.New(), 1000, time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)),
+		expenseOn(familyID, uuid.New(), 100, time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)),
+		expenseOn(familyID, uuid.New(), 100, time.Date(2026, 2, 28, 12, 0, 0, 0, time.UTC)),
+		expenseOn(familyID, uuid.New(), 300, time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)))
+
+	now := time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)
+	from, to, err := PeriodCurrentMonth.GetPeriodDates(now)
+	if err != nil {
+		t.Fatalf("GetPeriodDates() error = %v", err)
+	}
+	prevFrom, prevTo, err := PeriodCurrentMonth.GetPreviousPeriodDates(now)
+	if err != nil {
+		t.Fatalf("GetPreviousPeriodDates() error = %v", err)
+	}
+
+	d, changes, err := NewDashboardService(repo).GetDashboardWithChanges(
+		context.Background(), familyID, from, to, prevFrom, prevTo)
+	if err != nil {
+		t.Fatalf("GetDashboardWithChanges() error = %v", err)
+	}
+	if d.TotalExpenses != 300 {
+		t.Errorf("March expenses = %v, want 300", d.TotalExpenses)
+	}
+	if !changes.HasPrevExpenses {
+		t.Fatal("February baseline missing")
+	}
+	// February totals 200, so March's 300 is +50% — not the skewed
+	// figure a 1200 baseline including Jan 30 would give.
+	if changes.ExpensesChange != 50 {
+		t.Errorf("ExpensesChange = %v, want 50", changes.ExpensesChange)
+	}
+	if changes.HasPrevIncome || changes.IncomeChange != 0 {
+		t.Errorf("income change = %v (has %v), want zero without a baseline",
+			changes.IncomeChange, changes.HasPrevIncome)
+	}
+}
+
+func TestDashboard_LateLocalEveningFallsOnLocalDay(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	familyID := uuid.New()
+	prefs := memory.NewPreferencesRepository()
+	err = prefs.Upsert(context.Background(), &preferences.Preferences{
+		ID: uuid.New(), FamilyID: familyID, Timezone: "America/New_York",
+	})
+	if err != nil {
+		t.Fatalf("seed preferences: %v", err)
+	}
+	repo := &fakeTransactionRepo{}
+	svc := NewDashboardService(repo)
+	svc.SetPreferencesRepository(prefs)
+
+	if got := svc.FamilyLocation(context.Background(), familyID); got.String() != "America/New_York" {
+		t.Fatalf("FamilyLocation = %v, want America/New_York", got)
+	}
+
+	// 23:30 on Jan 31 New York time is already Feb 1 in UTC. Stored in
+	// UTC, it must still land on the local January day.
+	lateEvening := time.Date(2026, 1, 31, 23, 30, 0, 0, loc)
+	repo.transactions = append(repo.transactions,
+		expenseOn(familyID, uuid.New(), 50, lateEvening.UTC()))
+
+	january := time.Date(2026, 1, 15, 12, 0, 0, 0, loc)
+	from, to, err := PeriodCurrentMonth.GetPeriodDates(january)
+	if err != nil {
+		t.Fatalf("GetPeriodDates() error = %v", err)
+	}
+	d, err := svc.GetDashboard(context.Background(), familyID, from, to)
+	if err != nil {
+		t.Fatalf("GetDashboard() error = %v", err)
+	}
+	if d.TotalExpenses != 50 {
+		t.Errorf("January expenses = %v, want 50 (UTC bucketing would push it to February)", d.TotalExpenses)
+	}
+
+	february := time.Date(2026, 2, 15, 12, 0, 0, 0, loc)
+	from, to, err = PeriodCurrentMonth.GetPeriodDates(february)
+	if err != nil {
+		t.Fatalf("GetPeriodDates() error = %v", err)
+	}
+	d, err = svc.GetDashboard(context.Background(), familyID, from, to)
+	if err != nil {
+		t.Fatalf("GetDashboard() error = %v", err)
+	}
+	if d.TotalExpenses != 0 {
+		t.Errorf("February expenses = %v, want 0", d.TotalExpenses)
+	}
+}
+
+func TestGetNetSparkline_TwelveMonthsAcrossYearBoundary(t *testing.T) {
+	familyID := uuid.New()
+	repo := &fakeTransactionRepo{}
+	end := time.Date(2026, 4, 15, 10, 0, 0, 0, time.UTC)
+	first := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	// One income and one expense per month of the window, with amounts
+	// derived from the month index so every point is distinguishable.
+	wantNet := make(map[string]float64)
+	for i := 0; i < 12; i++ {
+		at := first.AddDate(0, i, 3)
+		income := float64(1000 + 10*i)
+		expense := float64(400 + i)
+		repo.transactions = append(repo.transactions,
+			&transaction.Transaction{
+				ID: uuid.New(), Amount: income, Type: transaction.TypeIncome,
+				CategoryID: uuid.New(), UserID: uuid.New(), FamilyID: familyID, Date: at,
+			},
+			expenseOn(familyID, uuid.New(), expense, at),
+		)
+		wantNet[at.Format("2006-01")] = income - expense
+	}
+	// Outside the window on both sides: must not show up.
+	repo.transactions = append(repo.transactions,
+		expenseOn(familyID, uuid.New(), 9999, first.AddDate(0, -1, 0)),
+		expenseOn(familyID, uuid.New(), 9999, end.AddDate(0, 1, 0)))
+
+	points, err := NewDashboardService(repo).GetNetSparkline(context.Background(), familyID, end)
+	if err != nil {
+		t.Fatalf("GetNetSparkline() error = %v", err)
+	}
+	if len(points) != 12 {
+		t.Fatalf("series length = %d, want 12", len(points))
+	}
+	if points[0].Year != 2025 || points[0].Month != time.May {
+		t.Errorf("first point = %d-%v, want 2025-May", points[0].Year, points[0].Month)
+	}
+	if points[11].Year != 2026 || points[11].Month != time.April {
+		t.Errorf("last point = %d-%v, want 2026-April", points[11].Year, points[11].Month)
+	}
+	for _, p := range points {
+		key := time.Date(p.Year, p.Month, 1, 0, 0, 0, 0, time.UTC).Format("2006-01")
+		if p.Net != wantNet[key] {
+			t.Errorf("net for %s = %v, want %v", key, p.Net, wantNet[key])
+		}
+	}
+}
+
+func TestGetDashboard_QueriesPeriodOnce(t *testing.T) {
+	familyID := uuid.New()
+	day := time.Date(2024, 7, 10, 0, 0, 0, 0, time.UTC)
+	repo := &countingTransactionRepo{}
+	for i := 0; i < 8; i++ {
+		repo.transactions = append(repo.transactions, expenseOn(familyID, uuid.New(), float64(10+i), day.AddDate(0, 0, i)))
+	}
+	repo.transactions = append(repo.transactions, &transaction.Transaction{
+		ID: uuid.New(), Amount: 500, Type: transaction.TypeIncome,
+		CategoryID: uuid.New(), UserID: uuid.New(), FamilyID: familyID, Date: day,
+	})
+
+	svc := NewDashboardService(repo)
+	d, err := svc.GetDashboard(context.Background(), familyID, day.AddDate(0, 0, -1), day.AddDate(0, 0, 30))
+	if err != nil {
+		t.Fatalf("GetDashboard: %v", err)
+	}
+
+	if repo.getByFilter != 1 {
+		t.Errorf("GetByFilter called %d times, want 1 for the whole dashboard", repo.getByFilter)
+	}
+	if d.TotalIncome != 500 {
+		t.Errorf("TotalIncome = %v, want 500", d.TotalIncome)
+	}
+	if d.TransactionCount != 9 {
+		t.Errorf("TransactionCount = %d, want 9", d.TransactionCount)
+	}
+	if len(d.TopCategories) != dashboardTopCategoryCount {
+		t.Errorf("len(TopCategories) = %d, want %d", len(d.TopCategories), dashboardTopCategoryCount)
+	}
+	if len(d.Recent) != dashboardRecentCount {
+		t.Errorf("len(Recent) = %d, want %d", len(d.Recent), dashboardRecentCount)
+	}
+	for i := 1; i < len(d.Recent); i++ {
+		if d.Recent[i].Date.After(d.Recent[i-1].Date) {
+			t.Errorf("recent transactions not newest first")
+		}
+	}
+}
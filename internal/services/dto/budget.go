@@ -0,0 +1,17 @@
+package dto
+
+import (
+	"family-budget-service/pkg/uuid"
+)
+
+// BudgetUtilizationDTO describes how a budget's spending compares to the
+// time elapsed in its period. Pace is the headline: a budget 60% spent at
+// 40% through the month is over pace even though the cap is not hit.
+type BudgetUtilizationDTO struct {
+	BudgetID       uuid.UUID
+	Spent          float64
+	Remaining      float64
+	PercentUsed    float64
+	PercentElapsed float64
+	Pace           string
+}
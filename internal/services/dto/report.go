@@ -0,0 +1,212 @@
+package dto
+
+import (
+	"time"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/pkg/uuid"
+)
+
+// ReportFilterDTO restricts which transactions feed a report. All listed
+// category IDs and user IDs are applied; an empty slice means "all".
+type ReportFilterDTO struct {
+	CategoryIDs []uuid.UUID
+	UserIDs     []uuid.UUID
+	// AccountID, when set, limits the report to one account's
+	// transactions.
+	AccountID *uuid.UUID
+}
+
+// ReportRequestDTO describes a report to generate.
+type ReportRequestDTO struct {
+	Name      string
+	Type      report.Type
+	Period    report.Period
+	FamilyID  uuid.UUID
+	UserID    uuid.UUID
+	StartDate time.Time
+	EndDate   time.Time
+	Filters   *ReportFilterDTO
+}
+
+// ReportBreadthWarningDTO is surfaced by the report form when a
+// requested window exceeds the configured breadth soft limit, asking the
+// user to confirm before a heavy generation runs.
+type ReportBreadthWarningDTO struct {
+	SpanDays    int
+	MaxSpanDays int
+	Message     string
+}
+
+// ScheduleReportRequestDTO describes a recurring report to register.
+type ScheduleReportRequestDTO struct {
+	Name      string
+	Type      report.Type
+	Period    report.Period
+	Frequency report.ScheduleFrequency
+	FamilyID  uuid.UUID
+	UserID    uuid.UUID
+}
+
+// Recommendation severities.
+const (
+	SeverityInfo     = "info"
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+)
+
+// Recommendation types produced by GenerateFinancialInsights.
+const (
+	RecommendationCategoryGrowth  = "category_growth"
+	RecommendationLowSavingsRate  = "low_savings_rate"
+	RecommendationBudgetOverspent = "budget_overspent"
+)
+
+// RecommendationDTO is one actionable financial insight.
+type RecommendationDTO struct {
+	Type       string
+	Severity   string
+	Title      string
+	Action     string
+	CategoryID *uuid.UUID
+	BudgetID   *uuid.UUID
+}
+
+// Trend directions.
+const (
+	TrendIncreasing = "increasing"
+	TrendDecreasing = "decreasing"
+	TrendStable     = "stable"
+)
+
+// TrendAnalysisDTO is a bucketed spending time series with its fitted
+// slope, direction and volatility.
+type TrendAnalysisDTO struct {
+	FamilyID   uuid.UUID
+	CategoryID *uuid.UUID // nil means all categories
+	Period     report.Period
+	Points     []TrendPointDTO
+	Slope      float64
+	Direction  string
+	Volatility float64
+}
+
+// TrendPointDTO is one bucket of a trend series.
+type TrendPointDTO struct {
+	PeriodStart time.Time
+	Amount      float64
+}
+
+// BenchmarkPeerGroupSelfHistory marks that benchmark "peers" are the
+// family's own history, not an external dataset.
+const BenchmarkPeerGroupSelfHistory = "self-history"
+
+// BenchmarkComparisonDTO compares the family's current month to its own
+// recent history.
+type BenchmarkComparisonDTO struct {
+	FamilyID           uuid.UUID
+	CurrentMonth       time.Time
+	PeerGroup          string
+	Categories         []CategoryBenchmarkDTO
+	CurrentSavingsRate float64
+	MedianSavingsRate  float64
+	SavingsRateDelta   float64
+}
+
+// CategoryBenchmarkDTO is one category's current-versus-baseline row.
+type CategoryBenchmarkDTO struct {
+	CategoryID      uuid.UUID
+	CurrentSpend    float64
+	TrailingAverage float64
+	Delta           float64
+	DeltaPercent    float64
+}
+
+// Forecast bases name which model produced a forecast point.
+const (
+	ForecastBasisTrailingAverage = "trailing_average"
+	ForecastBasisSeasonal        = "seasonal"
+)
+
+// ForecastDTO is one month of a spending forecast.
+type ForecastDTO struct {
+	Month             time.Time
+	PredictedExpenses float64
+	Basis             string
+}
+
+// ExpenseReportDTO is the computed result of an expense report.
+type ExpenseReportDTO struct {
+	FamilyID      uuid.UUID
+	StartDate     time.Time
+	EndDate       time.Time
+	TotalExpenses float64
+	ByCategory    []CategoryTotalDTO
+	Transactions  []TransactionSummaryDTO
+}
+
+// CategoryTotalDTO is one category's total inside a report.
+type CategoryTotalDTO struct {
+	CategoryID uuid.UUID
+	Name       string
+	Amount     float64
+	Count      int
+}
+
+// TransactionSummaryDTO is a lightweight transaction row embedded in
+// report output.
+type TransactionSummaryDTO struct {
+	ID          uuid.UUID
+	Amount      float64
+	Description string
+	Date        time.Time
+}
+
+// MonthlySpendDTO is one month's spend inside a per-user series.
+type MonthlySpendDTO struct {
+	Month  time.Time
+	Amount float64
+}
+
+// UserSpendingSeriesDTO is one member's monthly spending series.
+type UserSpendingSeriesDTO struct {
+	UserID   uuid.UUID
+	UserName string
+	Monthly  []MonthlySpendDTO
+	Total    float64
+}
+
+// UserSpendingTrendDTO breaks family spending down by member and month.
+// Users are ordered by total spend, largest first.
+type UserSpendingTrendDTO struct {
+	FamilyID uuid.UUID
+	From     time.Time
+	To       time.Time
+	Months   []time.Time
+	Users    []UserSpendingSeriesDTO
+}
+
+// CategoryCoverageDTO is one expense category's share of a period's
+// income. PercentOfIncome is zero when the period had no income; Spent
+// still carries the absolute number.
+type CategoryCoverageDTO struct {
+	CategoryID      uuid.UUID
+	Spent           float64
+	PercentOfIncome float64
+}
+
+// IncomeCoveragePeriodDTO is one income period: its total income and how
+// much of it each expense category consumed, largest share first.
+type IncomeCoveragePeriodDTO struct {
+	Start      time.Time
+	End        time.Time
+	Income     float64
+	Categories []CategoryCoverageDTO
+}
+
+// IncomeCoverageReportDTO relates spending to earnings period by period.
+type IncomeCoverageReportDTO struct {
+	FamilyID uuid.UUID
+	Period   report.Period
+	Periods  []IncomeCoveragePeriodDTO
+}
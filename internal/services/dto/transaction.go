@@ -0,0 +1,91 @@
+// Package dto defines the data-transfer structures exchanged between
+// handlers and services. They carry request input and computed output;
+// domain types never cross the HTTP boundary directly.
+package dto
+
+import (
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// TransactionFilterDTO narrows transaction queries. CategoryID/UserID are
+// the original single-value fields kept for existing callers; the plural
+// slices supersede them and both forms may be combined freely.
+type TransactionFilterDTO struct {
+	FamilyID    uuid.UUID
+	CategoryID  *uuid.UUID
+	UserID      *uuid.UUID
+	CategoryIDs []uuid.UUID
+	UserIDs     []uuid.UUID
+	Type        *transaction.Type
+	DateFrom    *time.Time
+	DateTo      *time.Time
+	AmountFrom  *float64
+	AmountTo    *float64
+	Description string
+	// Tags matches transactions carrying any of the listed tags; values
+	// are normalized before querying.
+	Tags []string
+	// AccountID restricts results to transactions involving the account.
+	AccountID *uuid.UUID
+	Limit     int
+	Offset    int
+}
+
+// EffectiveCategoryIDs merges the legacy single CategoryID into the
+// CategoryIDs slice, deduplicated.
+func (f *TransactionFilterDTO) EffectiveCategoryIDs() []uuid.UUID {
+	return mergeID(f.CategoryIDs, f.CategoryID)
+}
+
+// EffectiveUserIDs merges the legacy single UserID into the UserIDs
+// slice, deduplicated.
+func (f *TransactionFilterDTO) EffectiveUserIDs() []uuid.UUID {
+	return mergeID(f.UserIDs, f.UserID)
+}
+
+func mergeID(ids []uuid.UUID, single *uuid.UUID) []uuid.UUID {
+	if single == nil || single.IsNil() {
+		return ids
+	}
+	for _, id := range ids {
+		if id == *single {
+			return ids
+		}
+	}
+	merged := make([]uuid.UUID, 0, len(ids)+1)
+	merged = append(merged, ids...)
+	return append(merged, *single)
+}
+
+// ToDomainFilter converts the DTO into the repository-level filter.
+func (f *TransactionFilterDTO) ToDomainFilter() transaction.Filter {
+	return transaction.Filter{
+		FamilyID:    f.FamilyID,
+		CategoryIDs: f.EffectiveCategoryIDs(),
+		UserIDs:     f.EffectiveUserIDs(),
+		Type:        f.Type,
+		DateFrom:    f.DateFrom,
+		DateTo:      f.DateTo,
+		AmountFrom:  f.AmountFrom,
+		AmountTo:    f.AmountTo,
+		Description: f.Description,
+		Tags:        normalizeTags(f.Tags),
+		AccountID:   f.AccountID,
+		Limit:       f.Limit,
+		Offset:      f.Offset,
+	}
+}
+
+// normalizeTags canonicalizes filter tags, dropping empties.
+func normalizeTags(tags []string) []string {
+	var out []string
+	for _, tag := range tags {
+		if normalized := transaction.NormalizeTag(tag); normalized != "" {
+			out = append(out, normalized)
+		}
+	}
+	return out
+}
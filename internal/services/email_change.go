@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/notification"
+	"family-budget-service/internal/web/validation"
+	"family-budget-service/pkg/uuid"
+)
+
+// DefaultEmailChangeTokenTTL is how long an email-change verification
+// token stays confirmable.
+const DefaultEmailChangeTokenTTL = 24 * time.Hour
+
+// ErrInvalidEmailChangeToken is returned when confirming with a token
+// that is unknown, expired, or superseded by a newer request.
+var ErrInvalidEmailChangeToken = errors.New("invalid or expired email change token")
+
+// pendingEmailChange is one outstanding, unconfirmed change. The user's
+// old email stays active until the token is confirmed.
+type pendingEmailChange struct {
+	userID    uuid.UUID
+	newEmail  string
+	expiresAt time.Time
+}
+
+// SetEmailSender wires the sender used for verification emails. Without
+// one, requests still issue tokens but nothing is sent (tests, CLI).
+func (s *UserService) SetEmailSender(sender notification.EmailSender) {
+	s.emailSender = sender
+}
+
+// RequestEmailChange starts an email change for a user: the new address
+// is sanitized, validated and checked for uniqueness, then a
+// verification token is issued to it. A newer request supersedes any
+// earlier pending one for the same user.
+func (s *UserService) RequestEmailChange(ctx context.Context, userID uuid.UUID, newEmail string) (string, error) {
+	newEmail = validation.SanitizeEmail(newEmail)
+	if err := validation.ValidateEmail(newEmail); err != nil {
+		return "", err
+	}
+	u, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("load user: %w", err)
+	}
+	if err := s.ensureEmailFree(ctx, newEmail, userID); err != nil {
+		return "", err
+	}
+
+	token, err := emailChangeToken()
+	if err != nil {
+		return "", err
+	}
+	s.emailChangeMu.Lock()
+	if s.pendingEmailChanges == nil {
+		s.pendingEmailChanges = make(map[string]pendingEmailChange)
+	}
+	// Drop any earlier pending change for this user: its token is stale.
+	for t, pending := range s.pendingEmailChanges {
+		if pending.userID == userID {
+			delete(s.pendingEmailChanges, t)
+		}
+	}
+	s.pendingEmailChanges[token] = pendingEmailChange{
+		userID:    userID,
+		newEmail:  newEmail,
+		expiresAt: time.Now().Add(DefaultEmailChangeTokenTTL),
+	}
+	s.emailChangeMu.Unlock()
+
+	if s.emailSender != nil {
+		if err := s.emailSender.Send(ctx, newEmail, "Confirm your new email address",
+			"Use the token "+token+" to confirm the email change for "+u.FullName()+"."); err != nil {
+			return "", fmt.Errorf("send verification email: %w", err)
+		}
+	}
+	return token, nil
+}
+
+// ConfirmEmailChange applies a pending email change after its token is
+// presented. Uniqueness is re-checked at confirmation time since another
+// user may have claimed the address in the meantime.
+func (s *UserService) ConfirmEmailChange(ctx context.Context, token string) (*user.User, error) {
+	s.emailChangeMu.Lock()
+	pending, ok := s.pendingEmailChanges[token]
+	if ok {
+		delete(s.pendingEmailChanges, token)
+	}
+	s.emailChangeMu.Unlock()
+	if !ok || time.Now().After(pending.expiresAt) {
+		return nil, ErrInvalidEmailChangeToken
+	}
+
+	if err := s.ensureEmailFree(ctx, pending.newEmail, pending.userID); err != nil {
+		return nil, err
+	}
+	u, err := s.userRepo.GetByID(ctx, pending.userID)
+	if err != nil {
+		return nil, fmt.Errorf("load user: %w", err)
+	}
+	u.Email = pending.newEmail
+	u.UpdatedAt = time.Now()
+	if err := s.userRepo.Update(ctx, u); err != nil {
+		return nil, fmt.Errorf("update user email: %w", err)
+	}
+	return u, nil
+}
+
+// ensureEmailFree rejects an address already used by another user.
+func (s *UserService) ensureEmailFree(ctx context.Context, email string, userID uuid.UUID) error {
+	existing, err := s.userRepo.GetByEmail(ctx, email)
+	if errors.Is(err, user.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("check email uniqueness: %w", err)
+	}
+	if existing.ID != userID {
+		return fmt.Errorf("%w: %s", user.ErrEmailTaken, email)
+	}
+	return nil
+}
+
+// emailChangeToken returns an unguessable token for the verification
+// email.
+func emailChangeToken() (string, error) {
+	var raw [16]byte
+	if _, err := rand.Read(raw[:]); err != nil {
+		return "", fmt.Errorf("generate token: %w", err)
+	}
+	return hex.EncodeToString(raw[:]), nil
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/web/validation"
+	"family-budget-service/pkg/uuid"
+)
+
+func seedEmailUser(t *testing.T, repo *memory.UserRepository, email string) *user.User {
+	t.Helper()
+	u := &user.User{
+		ID: uuid.New(), Email: email, FirstName: "Alice", LastName: "Doe",
+		Role: user.RoleMember, FamilyID: uuid.New(),
+	}
+	if err := repo.Create(context.Background(), u); err != nil {
+		t.Fatalf("seed user: %v", err)
+	}
+	return u
+}
+
+func TestEmailChange_RequestConfirmApplied(t *testing.T) {
+	repo := memory.NewUserRepository()
+	svc := NewUserService(repo, memory.NewReportRepository(), "")
+	u := seedEmailUser(t, repo, "alice@example.com")
+
+	token, err := svc.RequestEmailChange(context.Background(), u.ID, "  Alice.New@Example.COM ")
+	if err != nil {
+		t.Fatalf("RequestEmailChange: %v", err)
+	}
+
+	// The old email stays active until confirmation.
+	current, err := repo.GetByID(context.Background(), u.ID)
+	if err != nil || current.Email != "alice@example.com" {
+		t.Fatalf("email = %q before confirmation, want the old address", current.Email)
+	}
+
+	updated, err := svc.ConfirmEmailChange(context.Background(), token)
+	if err != nil {
+		t.Fatalf("ConfirmEmailChange: %v", err)
+	}
+	if updated.Email != "alice.new@example.com" {
+		t.Errorf("email = %q, want the sanitized new address", updated.Email)
+	}
+	// A confirmed token cannot be replayed.
+	if _, err := svc.ConfirmEmailChange(context.Background(), token); !errors.Is(err, ErrInvalidEmailChangeToken) {
+		t.Errorf("err = %v, want ErrInvalidEmailChangeToken on replay", err)
+	}
+}
+
+func TestEmailChange_StaleTokenRejected(t *testing.T) {
+	repo := memory.NewUserRepository()
+	svc := NewUserService(repo, memory.NewReportRepository(), "")
+	u := seedEmailUser(t, repo, "alice@example.com")
+
+	stale, err := svc.RequestEmailChange(context.Background(), u.ID, "first@example.com")
+	if err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+	fresh, err := svc.RequestEmailChange(context.Background(), u.ID, "second@example.com")
+	if err != nil {
+		t.Fatalf("second request: %v", err)
+	}
+
+	if _, err := svc.ConfirmEmailChange(context.Background(), stale); !errors.Is(err, ErrInvalidEmailChangeToken) {
+		t.Errorf("err = %v, want ErrInvalidEmailChangeToken for a superseded token", err)
+	}
+	if updated, err := svc.ConfirmEmailChange(context.Background(), fresh); err != nil || updated.Email != "second@example.com" {
+		t.Errorf("fresh token = (%v, %v), want the second address applied", updated, err)
+	}
+}
+
+func TestEmailChange_RejectsTakenAndInvalidAddresses(t *testing.T) {
+	repo := memory.NewUserRepository()
+	svc := NewUserService(repo, memory.NewReportRepository(), "")
+	u := seedEmailUser(t, repo, "alice@example.com")
+	seedEmailUser(t, repo, "bob@example.com")
+
+	if _, err := svc.RequestEmailChange(context.Background(), u.ID, "bob@example.com"); !errors.Is(err, user.ErrEmailTaken) {
+		t.Errorf("err = %v, want ErrEmailTaken", err)
+	}
+	if _, err := svc.RequestEmailChange(context.Background(), u.ID, "not-an-email"); !errors.Is(err, validation.ErrInvalidEmail) {
+		t.Errorf("err = %v, want ErrInvalidEmail", err)
+	}
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrUnsupportedExportFormat is returned for formats the exporter does
+// not produce.
+var ErrUnsupportedExportFormat = errors.New("unsupported export format")
+
+// exportPageSize bounds how many transactions are held in memory while
+// streaming an export.
+const exportPageSize = 500
+
+// ExportService streams raw transaction exports, as opposed to the
+// aggregated report exports.
+type ExportService struct {
+	transactionRepo transaction.Repository
+	categoryRepo    category.Repository
+	userRepo        user.Repository
+	familyRepo      user.FamilyRepository
+}
+
+// NewExportService builds an ExportService. familyRepo may be nil; the
+// formatted amount column then renders without a currency symbol.
+func NewExportService(transactionRepo transaction.Repository, categoryRepo category.Repository, userRepo user.Repository, familyRepo user.FamilyRepository) *ExportService {
+	return &ExportService{
+		transactionRepo: transactionRepo,
+		categoryRepo:    categoryRepo,
+		userRepo:        userRepo,
+		familyRepo:      familyRepo,
+	}
+}
+
+// ExportTransactions streams every family transaction in [from, to] to w
+// in the requested format. Rows are fetched and written page by page so
+// large ranges never buffer wholesale; category and user names are
+// resolved once up front. It returns the number of exported rows.
+func (s *ExportService) ExportTransactions(ctx context.Context, familyID uuid.UUID, format string, from, to time.Time, w io.Writer) (int, error) {
+	if format != "csv" {
+		return 0, fmt.Errorf("%w: %q", ErrUnsupportedExportFormat, format)
+	}
+
+	categoryNames, err := s.categoryNames(ctx, familyID)
+	if err != nil {
+		return 0, err
+	}
+	userNames, err := s.userNames(ctx, familyID)
+	if err != nil {
+		return 0, err
+	}
+
+	currency := s.familyCurrency(ctx, familyID)
+
+	writer := csv.NewWriter(w)
+	header := []string{"date", "amount", "amount_formatted", "type", "description", "category", "user"}
+	if err := writer.Write(header); err != nil {
+		return 0, fmt.Errorf("write header: %w", err)
+	}
+
+	exported := 0
+	for offset := 0; ; offset += exportPageSize {
+		page, err := s.transactionRepo.GetByFilter(ctx, transaction.Filter{
+			FamilyID: familyID,
+			DateFrom: &from,
+			DateTo:   &to,
+			Limit:    exportPageSize,
+			Offset:   offset,
+		})
+		if err != nil {
+			return exported, fmt.Errorf("load transactions: %w", err)
+		}
+		for _, t := range page {
+			row := []string{
+				t.Date.Format("2006-01-02"),
+				formatAmount(t.Amount),
+				FormatCurrency(currency, t.Amount),
+				string(t.Type),
+				t.Description,
+				nameOrUnknown(categoryNames, t.CategoryID),
+				nameOrUnknown(userNames, t.UserID),
+			}
+			if err := writer.Write(row); err != nil {
+				return exported, fmt.Errorf("write row: %w", err)
+			}
+			exported++
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return exported, fmt.Errorf("flush export: %w", err)
+		}
+		if len(page) < exportPageSize {
+			return exported, nil
+		}
+	}
+}
+
+// familyCurrency resolves the family's base currency for display
+// formatting; unknown families or a nil repo render without a symbol.
+func (s *ExportService) familyCurrency(ctx context.Context, familyID uuid.UUID) string {
+	if s.familyRepo == nil {
+		return ""
+	}
+	f, err := s.familyRepo.GetByID(ctx, familyID)
+	if err != nil {
+		return ""
+	}
+	return f.Currency
+}
+
+func (s *ExportService) categoryNames(ctx context.Context, familyID uuid.UUID) (map[uuid.UUID]string, error) {
+	categories, err := s.categoryRepo.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load categories: %w", err)
+	}
+	names := make(map[uuid.UUID]string, len(categories))
+	for _, c := range categories {
+		names[c.ID] = c.Name
+	}
+	return names, nil
+}
+
+func (s *ExportService) userNames(ctx context.Context, familyID uuid.UUID) (map[uuid.UUID]string, error) {
+	members, err := s.userRepo.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load family members: %w", err)
+	}
+	names := make(map[uuid.UUID]string, len(members))
+	for _, m := range members {
+		names[m.ID] = m.FullName()
+	}
+	return names, nil
+}
+
+func nameOrUnknown(names map[uuid.UUID]string, id uuid.UUID) string {
+	if name, ok := names[id]; ok {
+		return name
+	}
+	return "Unknown"
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrNoOFXTransactions is returned for files without a single statement
+// transaction, which usually means the file is not OFX at all.
+var ErrNoOFXTransactions = errors.New("no transactions found in OFX document")
+
+// ofxTransaction is one parsed <STMTTRN> block.
+type ofxTransaction struct {
+	FITID  string
+	Amount float64 // signed: negative for money out
+	Date   time.Time
+	Name   string
+	Memo   string
+}
+
+// OFXImportResult summarizes an OFX import. In dry-run mode nothing is
+// persisted and Transactions previews what an actual run would create.
+type OFXImportResult struct {
+	Imported     int
+	Duplicates   int
+	Skipped      int
+	DryRun       bool
+	Transactions []*transaction.Transaction
+}
+
+// ImportOFX reads an OFX/QFX bank statement and imports its transactions
+// into the family on behalf of userID. Each statement transaction maps
+// its amount sign to income/expense and its memo to the description.
+// Re-importing the same file is a no-op: transactions are deduplicated
+// on the bank's FITID. With dryRun set the method only reports what
+// would be imported.
+func (s *ImportService) ImportOFX(ctx context.Context, familyID, userID uuid.UUID, r io.Reader, dryRun bool) (*OFXImportResult, error) {
+	parsed, err := parseOFX(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed) == 0 {
+		return nil, ErrNoOFXTransactions
+	}
+
+	existing, err := s.transactionService.GetTransactionsByFamily(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load existing transactions: %w", err)
+	}
+	seen := make(map[string]bool)
+	for _, t := range existing {
+		if t.BankTransactionID != "" {
+			seen[t.BankTransactionID] = true
+		}
+	}
+
+	result := &OFXImportResult{DryRun: dryRun}
+	for _, o := range parsed {
+		if o.FITID == "" || o.Amount == 0 {
+			result.Skipped++
+			continue
+		}
+		if seen[o.FITID] {
+			result.Duplicates++
+			continue
+		}
+		seen[o.FITID] = true
+
+		txType := transaction.TypeIncome
+		if o.Amount < 0 {
+			txType = transaction.TypeExpense
+		}
+		description := o.Memo
+		if description == "" {
+			description = o.Name
+		}
+		categoryID, err := s.resolveCategoryForOFX(ctx, familyID, txType, dryRun)
+		if err != nil {
+			return nil, err
+		}
+		tx := &transaction.Transaction{
+			Amount:            math.Abs(o.Amount),
+			Type:              txType,
+			Description:       description,
+			CategoryID:        categoryID,
+			UserID:            userID,
+			FamilyID:          familyID,
+			Date:              o.Date,
+			BankTransactionID: o.FITID,
+		}
+		result.Transactions = append(result.Transactions, tx)
+
+		if !dryRun {
+			if err := s.transactionService.CreateTransaction(ctx, tx); err != nil {
+				return nil, fmt.Errorf("create imported transaction: %w", err)
+			}
+			if tx.Type == transaction.TypeExpense {
+				if err := s.addToBudgets(ctx, tx); err != nil {
+					return nil, err
+				}
+			}
+		}
+		result.Imported++
+	}
+	return result, nil
+}
+
+// resolveCategoryForOFX reuses the csv import's catch-all category. Dry
+// runs must not create anything, so they report the Nil category when the
+// catch-all does not exist yet.
+func (s *ImportService) resolveCategoryForOFX(ctx context.Context, familyID uuid.UUID, txType transaction.Type, dryRun bool) (uuid.UUID, error) {
+	if dryRun {
+		return uuid.Nil, nil
+	}
+	return s.resolveCategory(ctx, familyID, "", txType)
+}
+
+// parseOFX scans an OFX/QFX document for <STMTTRN> blocks. OFX 1.x is
+// SGML with unclosed tags, so the parser is line-based and lenient: it
+// only reads <TAG>value lines inside a statement transaction.
+func parseOFX(r io.Reader) ([]ofxTransaction, error) {
+	scanner := bufio.NewScanner(r)
+	var (
+		out     []ofxTransaction
+		current *ofxTransaction
+	)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		upper := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(upper, "<STMTTRN>"):
+			current = &ofxTransaction{}
+		case strings.HasPrefix(upper, "</STMTTRN>"):
+			if current != nil {
+				out = append(out, *current)
+				current = nil
+			}
+		case current != nil:
+			tag, value, ok := parseOFXField(line)
+			if !ok {
+				continue
+			}
+			switch tag {
+			case "FITID":
+				current.FITID = value
+			case "TRNAMT":
+				amount, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", "."), 64)
+				if err == nil {
+					current.Amount = amount
+				}
+			case "DTPOSTED":
+				if date, ok := parseOFXDate(value); ok {
+					current.Date = date
+				}
+			case "NAME":
+				current.Name = value
+			case "MEMO":
+				current.Memo = value
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read ofx: %w", err)
+	}
+	return out, nil
+}
+
+// parseOFXField splits a "<TAG>value" line into its tag and value.
+func parseOFXField(line string) (tag, value string, ok bool) {
+	if !strings.HasPrefix(line, "<") {
+		return "", "", false
+	}
+	end := strings.IndexByte(line, '>')
+	if end < 2 || line[1] == '/' {
+		return "", "", false
+	}
+	return strings.ToUpper(line[1:end]), strings.TrimSpace(line[end+1:]), true
+}
+
+// parseOFXDate reads the leading YYYYMMDD of an OFX datetime, which may
+// carry time and timezone suffixes like 20260110120000[-5:EST].
+func parseOFXDate(value string) (time.Time, bool) {
+	if len(value) < 8 {
+		return time.Time{}, false
+	}
+	date, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return date, true
+}
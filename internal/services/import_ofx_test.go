@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+const sampleOFX = `OFXHEADER:100
+DATA:OFXSGML
+
+<OFX>
+<BANKMSGSRSV1>
+<STMTTRNRS>
+<STMTRS>
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20260110120000[-5:EST]
+<TRNAMT>-42.50
+<FITID>2026011001
+<NAME>SUPERMARKET
+<MEMO>Weekly groceries
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20260115
+<TRNAMT>3000.00
+<FITID>2026011502
+<NAME>EMPLOYER INC
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS>
+</STMTTRNRS>
+</BANKMSGSRSV1>
+</OFX>
+`
+
+func TestImportOFX_MapsStatementTransactions(t *testing.T) {
+	f := newImportFixture(t)
+	familyID := uuid.New()
+	importer := seedFamilyUser(t, f.users, familyID)
+
+	result, err := f.service.ImportOFX(context.Background(), familyID, importer.ID, strings.NewReader(sampleOFX), false)
+	if err != nil {
+		t.Fatalf("ImportOFX: %v", err)
+	}
+	if result.Imported != 2 || result.Duplicates != 0 {
+		t.Fatalf("result = %d imported, %d duplicates; want 2/0", result.Imported, result.Duplicates)
+	}
+
+	stored, err := f.transactions.GetByFamilyID(context.Background(), familyID)
+	if err != nil || len(stored) != 2 {
+		t.Fatalf("stored = %d, %v; want 2", len(stored), err)
+	}
+	byFITID := make(map[string]*transaction.Transaction)
+	for _, tx := range stored {
+		byFITID[tx.BankTransactionID] = tx
+	}
+	debit := byFITID["2026011001"]
+	if debit == nil || debit.Type != transaction.TypeExpense || debit.Amount != 42.50 {
+		t.Errorf("debit = %+v, want a 42.50 expense", debit)
+	}
+	if debit != nil && debit.Description != "Weekly groceries" {
+		t.Errorf("debit description = %q, want the memo", debit.Description)
+	}
+	credit := byFITID["2026011502"]
+	if credit == nil || credit.Type != transaction.TypeIncome || credit.Amount != 3000 {
+		t.Errorf("credit = %+v, want a 3000 income", credit)
+	}
+	if credit != nil && credit.Description != "EMPLOYER INC" {
+		t.Errorf("credit description = %q, want NAME when memo is absent", credit.Description)
+	}
+}
+
+func TestImportOFX_ReimportCreatesNoDuplicates(t *testing.T) {
+	f := newImportFixture(t)
+	familyID := uuid.New()
+	importer := seedFamilyUser(t, f.users, familyID)
+
+	if _, err := f.service.ImportOFX(context.Background(), familyID, importer.ID, strings.NewReader(sampleOFX), false); err != nil {
+		t.Fatalf("first import: %v", err)
+	}
+	again, err := f.service.ImportOFX(context.Background(), familyID, importer.ID, strings.NewReader(sampleOFX), false)
+	if err != nil {
+		t.Fatalf("second import: %v", err)
+	}
+	if again.Imported != 0 || again.Duplicates != 2 {
+		t.Errorf("reimport = %d imported, %d duplicates; want 0/2", again.Imported, again.Duplicates)
+	}
+
+	stored, err := f.transactions.GetByFamilyID(context.Background(), familyID)
+	if err != nil || len(stored) != 2 {
+		t.Errorf("stored = %d, %v; want still 2 after reimport", len(stored), err)
+	}
+}
+
+func TestImportOFX_DryRunPersistsNothing(t *testing.T) {
+	f := newImportFixture(t)
+	familyID := uuid.New()
+	importer := seedFamilyUser(t, f.users, familyID)
+
+	result, err := f.service.ImportOFX(context.Background(), familyID, importer.ID, strings.NewReader(sampleOFX), true)
+	if err != nil {
+		t.Fatalf("ImportOFX dry run: %v", err)
+	}
+	if !result.DryRun || result.Imported != 2 || len(result.Transactions) != 2 {
+		t.Errorf("dry run = %+v, want a 2-transaction preview", result)
+	}
+
+	stored, err := f.transactions.GetByFamilyID(context.Background(), familyID)
+	if err != nil || len(stored) != 0 {
+		t.Errorf("stored = %d, %v; want nothing persisted on dry run", len(stored), err)
+	}
+}
+
+func TestImportOFX_EmptyDocumentRejected(t *testing.T) {
+	f := newImportFixture(t)
+	_, err := f.service.ImportOFX(context.Background(), uuid.New(), uuid.New(), strings.NewReader("not ofx at all"), false)
+	if err == nil {
+		t.Error("ImportOFX accepted a document without transactions")
+	}
+}
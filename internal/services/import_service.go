@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// importDateLayouts are the date formats accepted in import files, tried
+// in order.
+var importDateLayouts = []string{"2006-01-02", "02.01.2006", "01/02/2006"}
+
+// ImportRowResult records the fate of a single file row so partial
+// imports stay transparent to the user.
+type ImportRowResult struct {
+	Line   int
+	Status string // "imported" or "skipped"
+	Reason string // set for skipped rows
+}
+
+// ImportResult summarizes an import run.
+type ImportResult struct {
+	Imported int
+	Skipped  int
+	Rows     []ImportRowResult
+}
+
+// ImportService ingests bank history files into a family's transactions.
+type ImportService struct {
+	transactionService *TransactionService
+	categoryRepo       category.Repository
+	budgetRepo         budget.Repository
+}
+
+// NewImportService builds an ImportService. Creation goes through the
+// transaction service so imported rows see the same validation as
+// hand-entered ones.
+func NewImportService(transactionService *TransactionService, categoryRepo category.Repository, budgetRepo budget.Repository) *ImportService {
+	return &ImportService{
+		transactionService: transactionService,
+		categoryRepo:       categoryRepo,
+		budgetRepo:         budgetRepo,
+	}
+}
+
+// ImportCSV reads rows of the form date, amount, description, type,
+// optional category name, and creates a transaction per valid row on
+// behalf of userID. Invalid rows are skipped individually with a reason
+// instead of aborting the file. Categories are resolved by name within
+// the family, created when missing, and affected budgets get their spent
+// totals updated.
+func (s *ImportService) ImportCSV(ctx context.Context, familyID, userID uuid.UUID, r io.Reader) (*ImportResult, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1 // row length is validated per row
+
+	result := &ImportResult{}
+	line := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv: %w", err)
+		}
+		line++
+		if line == 1 && isImportHeader(record) {
+			continue
+		}
+
+		tx, reason := s.parseImportRow(ctx, familyID, userID, record)
+		if reason == "" {
+			if err := s.transactionService.CreateTransaction(ctx, tx); err != nil {
+				reason = err.Error()
+			}
+		}
+		if reason != "" {
+			result.Skipped++
+			result.Rows = append(result.Rows, ImportRowResult{Line: line, Status: "skipped", Reason: reason})
+			continue
+		}
+		result.Imported++
+		result.Rows = append(result.Rows, ImportRowResult{Line: line, Status: "imported"})
+		if tx.Type == transaction.TypeExpense {
+			if err := s.addToBudgets(ctx, tx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// parseImportRow validates one csv record and builds the transaction it
+// describes; a non-empty reason means the row must be skipped.
+func (s *ImportService) parseImportRow(ctx context.Context, familyID, userID uuid.UUID, record []string) (*transaction.Transaction, string) {
+	if len(record) < 4 {
+		return nil, "expected at least 4 columns: date, amount, description, type"
+	}
+
+	date, ok := parseImportDate(strings.TrimSpace(record[0]))
+	if !ok {
+		return nil, fmt.Sprintf("unparseable date %q", record[0])
+	}
+	amount, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+	if err != nil || amount <= 0 {
+		return nil, fmt.Sprintf("invalid amount %q", record[1])
+	}
+	txType := transaction.Type(strings.ToLower(strings.TrimSpace(record[3])))
+	if txType != transaction.TypeIncome && txType != transaction.TypeExpense {
+		return nil, fmt.Sprintf("unknown type %q", record[3])
+	}
+
+	categoryName := ""
+	if len(record) > 4 {
+		categoryName = strings.TrimSpace(record[4])
+	}
+	categoryID, err := s.resolveCategory(ctx, familyID, categoryName, txType)
+	if err != nil {
+		return nil, err.Error()
+	}
+
+	return &transaction.Transaction{
+		Amount:      amount,
+		Type:        txType,
+		Description: strings.TrimSpace(record[2]),
+		CategoryID:  categoryID,
+		UserID:      userID,
+		FamilyID:    familyID,
+		Date:        date,
+	}, ""
+}
+
+// resolveCategory finds a family category by name (case-insensitive) or
+// creates it. Empty names land in an "Imported" catch-all so rows without
+// a category still classify somewhere visible.
+func (s *ImportService) resolveCategory(ctx context.Context, familyID uuid.UUID, name string, txType transaction.Type) (uuid.UUID, error) {
+	if name == "" {
+		name = "Imported"
+	}
+	categoryType := category.TypeExpense
+	if txType == transaction.TypeIncome {
+		categoryType = category.TypeIncome
+	}
+
+	existing, err := s.categoryRepo.GetByType(ctx, familyID, categoryType)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("load categories: %w", err)
+	}
+	for _, c := range existing {
+		if strings.EqualFold(c.Name, name) {
+			return c.ID, nil
+		}
+	}
+
+	now := time.Now()
+	created := &category.Category{
+		ID:        uuid.New(),
+		Name:      name,
+		Type:      categoryType,
+		FamilyID:  familyID,
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.categoryRepo.Create(ctx, created); err != nil {
+		return uuid.Nil, fmt.Errorf("create category %q: %w", name, err)
+	}
+	return created.ID, nil
+}
+
+// addToBudgets adds an imported expense to every active budget covering
+// its category and date. Split transactions attribute each portion to
+// its own category's budget.
+func (s *ImportService) addToBudgets(ctx context.Context, tx *transaction.Transaction) error {
+	budgets, err := s.budgetRepo.GetActiveBudgets(ctx, tx.FamilyID)
+	if err != nil {
+		return fmt.Errorf("load active budgets: %w", err)
+	}
+	for _, b := range budgets {
+		if tx.Date.Before(b.StartDate) || tx.Date.After(b.EndDate) {
+			continue
+		}
+		var amount float64
+		for _, allocation := range tx.Allocations() {
+			if b.CategoryID == nil || *b.CategoryID == allocation.CategoryID {
+				amount += allocation.Amount
+			}
+		}
+		if amount == 0 {
+			continue
+		}
+		b.Spent = AddAmounts(b.Spent, amount)
+		b.UpdatedAt = time.Now()
+		if err := s.budgetRepo.Update(ctx, b); err != nil {
+			return fmt.Errorf("update budget spend: %w", err)
+		}
+	}
+	return nil
+}
+
+func parseImportDate(value string) (time.Time, bool) {
+	for _, layout := range importDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// isImportHeader reports whether the first csv record is a column header
+// rather than data.
+func isImportHeader(record []string) bool {
+	return len(record) > 0 && strings.EqualFold(strings.TrimSpace(record[0]), "date")
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+type importFixture struct {
+	service      *ImportService
+	users        *memory.UserRepository
+	transactions *memory.TransactionRepository
+	categories   *memory.CategoryRepository
+	budgets      *memory.BudgetRepository
+}
+
+func newImportFixture(t *testing.T) *importFixture {
+	t.Helper()
+	f := &importFixture{
+		users:        memory.NewUserRepository(),
+		transactions: memory.NewTransactionRepository(),
+		categories:   memory.NewCategoryRepository(),
+		budgets:      memory.NewBudgetRepository(),
+	}
+	txService := NewTransactionService(f.transactions, f.users)
+	f.service = NewImportService(txService, f.categories, f.budgets)
+	return f
+}
+
+func TestImportCSV_ImportsRowsAndReportsSkips(t *testing.T) {
+	f := newImportFixture(t)
+	familyID := uuid.New()
+	importer := seedFamilyUser(t, f.users, familyID)
+
+	file := strings.Join([]string{
+		"date,amount,description,type,category",
+		"2026-01-10,42.50,Supermarket,expense,Groceries",
+		"2026-01-11,not-a-number,Broken row,expense,Groceries",
+		"never,10,Broken date,expense,Groceries",
+		"2026-01-15,3000,Salary,income,Salary",
+	}, "\n")
+
+	result, err := f.service.ImportCSV(context.Background(), familyID, importer.ID, strings.NewReader(file))
+	if err != nil {
+		t.Fatalf("ImportCSV: %v", err)
+	}
+	if result.Imported != 2 || result.Skipped != 2 {
+		t.Fatalf("result = %d imported, %d skipped; want 2/2", result.Imported, result.Skipped)
+	}
+	for _, row := range result.Rows {
+		if row.Status == "skipped" && row.Reason == "" {
+			t.Errorf("skipped row %d has no reason", row.Line)
+		}
+	}
+
+	stored, err := f.transactions.GetByFamilyID(context.Background(), familyID)
+	if err != nil || len(stored) != 2 {
+		t.Fatalf("stored = %d, %v; want 2", len(stored), err)
+	}
+}
+
+func TestImportCSV_ResolvesAndCreatesCategories(t *testing.T) {
+	f := newImportFixture(t)
+	familyID := uuid.New()
+	importer := seedFamilyUser(t, f.users, familyID)
+
+	existing := &category.Category{
+		ID: uuid.New(), Name: "Groceries", Type: category.TypeExpense,
+		FamilyID: familyID, IsActive: true,
+	}
+	if err := f.categories.Create(context.Background(), existing); err != nil {
+		t.Fatalf("seed category: %v", err)
+	}
+
+	file := "2026-01-10,20,Store,expense,groceries\n2026-01-11,15,Cinema,expense,Entertainment\n"
+	if _, err := f.service.ImportCSV(context.Background(), familyID, importer.ID, strings.NewReader(file)); err != nil {
+		t.Fatalf("ImportCSV: %v", err)
+	}
+
+	stored, err := f.transactions.GetByFamilyID(context.Background(), familyID)
+	if err != nil || len(stored) != 2 {
+		t.Fatalf("stored = %d, %v; want 2", len(stored), err)
+	}
+	categories, err := f.categories.GetByFamilyID(context.Background(), familyID)
+	if err != nil {
+		t.Fatalf("load categories: %v", err)
+	}
+	if len(categories) != 2 {
+		t.Fatalf("categories = %d, want the existing one reused and one created", len(categories))
+	}
+	for _, tx := range stored {
+		if tx.Description == "Store" && tx.CategoryID != existing.ID {
+			t.Errorf("Store landed in category %v, want the existing Groceries (case-insensitive)", tx.CategoryID)
+		}
+	}
+}
+
+func TestImportCSV_UpdatesBudgetSpentTotals(t *testing.T) {
+	f := newImportFixture(t)
+	familyID := uuid.New()
+	importer := seedFamilyUser(t, f.users, familyID)
+
+	groceries := &category.Category{
+		ID: uuid.New(), Name: "Groceries", Type: category.TypeExpense,
+		FamilyID: familyID, IsActive: true,
+	}
+	if err := f.categories.Create(context.Background(), groceries); err != nil {
+		t.Fatalf("seed category: %v", err)
+	}
+	categoryID := groceries.ID
+	b := &budget.Budget{
+		ID: uuid.New(), Name: "Groceries", Amount: 500, CategoryID: &categoryID,
+		FamilyID: familyID, Period: budget.PeriodMonthly, IsActive: true,
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+	if err := f.budgets.Create(context.Background(), b); err != nil {
+		t.Fatalf("seed budget: %v", err)
+	}
+
+	file := "2026-01-10,40,Store,expense,Groceries\n2026-02-10,99,Next month,expense,Groceries\n"
+	if _, err := f.service.ImportCSV(context.Background(), familyID, importer.ID, strings.NewReader(file)); err != nil {
+		t.Fatalf("ImportCSV: %v", err)
+	}
+
+	updated, err := f.budgets.GetByID(context.Background(), b.ID)
+	if err != nil {
+		t.Fatalf("reload budget: %v", err)
+	}
+	if updated.Spent != 40 {
+		t.Errorf("Spent = %.2f, want 40 (February row outside the period)", updated.Spent)
+	}
+}
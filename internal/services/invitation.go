@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/web/validation"
+	"family-budget-service/pkg/uuid"
+)
+
+// DefaultInvitationTTL is how long a family invitation stays acceptable.
+const DefaultInvitationTTL = 72 * time.Hour
+
+// ErrInvalidInvitationToken is returned when accepting or revoking an
+// invitation that is unknown, expired, or already used.
+var ErrInvalidInvitationToken = errors.New("invalid or expired invitation")
+
+// ErrNotFamilyAdmin rejects member management by non-admins.
+var ErrNotFamilyAdmin = errors.New("only family admins may invite members")
+
+// ErrInvalidRole rejects invitations for roles outside the known set.
+var ErrInvalidRole = errors.New("invalid member role")
+
+// invitation is one outstanding invite. Like pending email changes,
+// invitations are process-local.
+type invitation struct {
+	familyID  uuid.UUID
+	email     string
+	role      user.Role
+	expiresAt time.Time
+}
+
+// InviteFamilyMember creates an invitation for an email and role in the
+// admin's family and returns the token sent to the invitee. An empty
+// role defaults to RoleMember.
+func (s *UserService) InviteFamilyMember(ctx context.Context, adminID uuid.UUID, email string, role user.Role) (string, error) {
+	admin, err := s.userRepo.GetByID(ctx, adminID)
+	if err != nil {
+		return "", fmt.Errorf("load inviting user: %w", err)
+	}
+	if admin.Role != user.RoleAdmin {
+		return "", ErrNotFamilyAdmin
+	}
+	if role == "" {
+		role = user.RoleMember
+	}
+	switch role {
+	case user.RoleAdmin, user.RoleMember, user.RoleChild:
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidRole, role)
+	}
+	email = validation.SanitizeEmail(email)
+	if err := validation.ValidateEmail(email); err != nil {
+		return "", err
+	}
+	if err := s.ensureEmailFree(ctx, email, uuid.Nil); err != nil {
+		return "", err
+	}
+
+	token, err := emailChangeToken()
+	if err != nil {
+		return "", err
+	}
+	s.emailChangeMu.Lock()
+	if s.invitations == nil {
+		s.invitations = make(map[string]invitation)
+	}
+	s.invitations[token] = invitation{
+		familyID:  admin.FamilyID,
+		email:     email,
+		role:      role,
+		expiresAt: time.Now().Add(DefaultInvitationTTL),
+	}
+	s.emailChangeMu.Unlock()
+
+	if s.emailSender != nil {
+		if err := s.emailSender.Send(ctx, email, "You are invited to a family budget",
+			"Use the token "+token+" to join "+admin.FullName()+"'s family."); err != nil {
+			return "", fmt.Errorf("send invitation email: %w", err)
+		}
+	}
+	return token, nil
+}
+
+// RevokeInvitation withdraws an outstanding invitation.
+func (s *UserService) RevokeInvitation(_ context.Context, token string) error {
+	s.emailChangeMu.Lock()
+	defer s.emailChangeMu.Unlock()
+	if _, ok := s.invitations[token]; !ok {
+		return ErrInvalidInvitationToken
+	}
+	delete(s.invitations, token)
+	return nil
+}
+
+// AcceptInvitation redeems an invitation: the invitee chooses their own
+// name and password and becomes a member of the inviting family.
+func (s *UserService) AcceptInvitation(ctx context.Context, token, firstName, lastName, password string) (*user.User, error) {
+	s.emailChangeMu.Lock()
+	inv, ok := s.invitations[token]
+	if ok {
+		delete(s.invitations, token)
+	}
+	s.emailChangeMu.Unlock()
+	if !ok || time.Now().After(inv.expiresAt) {
+		return nil, ErrInvalidInvitationToken
+	}
+
+	if err := s.ensureEmailFree(ctx, inv.email, uuid.Nil); err != nil {
+		return nil, err
+	}
+	hash, err := hashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	u := &user.User{
+		ID:        uuid.New(),
+		Email:     inv.email,
+		Password:  hash,
+		FirstName: firstName,
+		LastName:  lastName,
+		Role:      inv.role,
+		FamilyID:  inv.familyID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.userRepo.Create(ctx, u); err != nil {
+		return nil, fmt.Errorf("create member: %w", err)
+	}
+	return u, nil
+}
+
+// hashPassword derives a salted hash for storage. The "salt$digest" form
+// keeps verification self-contained per user.
+func hashPassword(password string) (string, error) {
+	var salt [16]byte
+	if _, err := rand.Read(salt[:]); err != nil {
+		return "", fmt.Errorf("generate salt: %w", err)
+	}
+	digest := sha256.Sum256(append(salt[:], []byte(password)...))
+	return hex.EncodeToString(salt[:]) + "$" + hex.EncodeToString(digest[:]), nil
+}
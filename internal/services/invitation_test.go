@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func seedFamilyAdmin(t *testing.T, repo *memory.UserRepository) *user.User {
+	t.Helper()
+	admin := &user.User{
+		ID: uuid.New(), Email: "admin@example.com", FirstName: "Ann", LastName: "Admin",
+		Role: user.RoleAdmin, FamilyID: uuid.New(),
+	}
+	if err := repo.Create(context.Background(), admin); err != nil {
+		t.Fatalf("seed admin: %v", err)
+	}
+	return admin
+}
+
+func TestInvitation_InviteAcceptCreatesMember(t *testing.T) {
+	repo := memory.NewUserRepository()
+	svc := NewUserService(repo, memory.NewReportRepository(), "")
+	admin := seedFamilyAdmin(t, repo)
+
+	token, err := svc.InviteFamilyMember(context.Background(), admin.ID, "  Kid@Example.COM ", user.RoleChild)
+	if err != nil {
+		t.Fatalf("InviteFamilyMember: %v", err)
+	}
+
+	member, err := svc.AcceptInvitation(context.Background(), token, "Kim", "Doe", "hunter2secret")
+	if err != nil {
+		t.Fatalf("AcceptInvitation: %v", err)
+	}
+	if member.Email != "kid@example.com" || member.Role != user.RoleChild || member.FamilyID != admin.FamilyID {
+		t.Errorf("member = %+v, want sanitized email, child role and the admin's family", member)
+	}
+	if member.Password == "" || strings.Contains(member.Password, "hunter2secret") {
+		t.Errorf("password %q stored without hashing", member.Password)
+	}
+	stored, err := repo.GetByEmail(context.Background(), "kid@example.com")
+	if err != nil || stored.ID != member.ID {
+		t.Fatalf("member not persisted: (%v, %v)", stored, err)
+	}
+	// A redeemed token cannot be replayed.
+	if _, err := svc.AcceptInvitation(context.Background(), token, "Kim", "Doe", "other"); !errors.Is(err, ErrInvalidInvitationToken) {
+		t.Errorf("err = %v, want ErrInvalidInvitationToken on replay", err)
+	}
+}
+
+func TestInvitation_ExpiredTokenRejected(t *testing.T) {
+	repo := memory.NewUserRepository()
+	svc := NewUserService(repo, memory.NewReportRepository(), "")
+	admin := seedFamilyAdmin(t, repo)
+
+	token, err := svc.InviteFamilyMember(context.Background(), admin.ID, "late@example.com", "")
+	if err != nil {
+		t.Fatalf("InviteFamilyMember: %v", err)
+	}
+	svc.emailChangeMu.Lock()
+	inv := svc.invitations[token]
+	inv.expiresAt = time.Now().Add(-time.Minute)
+	svc.invitations[token] = inv
+	svc.emailChangeMu.Unlock()
+
+	if _, err := svc.AcceptInvitation(context.Background(), token, "Les", "Late", "secretpw"); !errors.Is(err, ErrInvalidInvitationToken) {
+		t.Errorf("err = %v, want ErrInvalidInvitationToken for an expired invite", err)
+	}
+}
+
+func TestInvitation_OnlyAdminsInviteAndRevokeWorks(t *testing.T) {
+	repo := memory.NewUserRepository()
+	svc := NewUserService(repo, memory.NewReportRepository(), "")
+	admin := seedFamilyAdmin(t, repo)
+	member := &user.User{
+		ID: uuid.New(), Email: "member@example.com", Role: user.RoleMember, FamilyID: admin.FamilyID,
+	}
+	if err := repo.Create(context.Background(), member); err != nil {
+		t.Fatalf("seed member: %v", err)
+	}
+
+	if _, err := svc.InviteFamilyMember(context.Background(), member.ID, "new@example.com", ""); !errors.Is(err, ErrNotFamilyAdmin) {
+		t.Errorf("err = %v, want ErrNotFamilyAdmin", err)
+	}
+	if _, err := svc.InviteFamilyMember(context.Background(), admin.ID, "new@example.com", "owner"); !errors.Is(err, ErrInvalidRole) {
+		t.Errorf("err = %v, want ErrInvalidRole", err)
+	}
+
+	token, err := svc.InviteFamilyMember(context.Background(), admin.ID, "new@example.com", "")
+	if err != nil {
+		t.Fatalf("InviteFamilyMember: %v", err)
+	}
+	if err := svc.RevokeInvitation(context.Background(), token); err != nil {
+		t.Fatalf("RevokeInvitation: %v", err)
+	}
+	if _, err := svc.AcceptInvitation(context.Background(), token, "New", "Member", "secretpw"); !errors.Is(err, ErrInvalidInvitationToken) {
+		t.Errorf("err = %v, want ErrInvalidInvitationToken after revoke", err)
+	}
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/pkg/uuid"
+)
+
+// metadataLoader memoizes category and user lookups for the duration of
+// one report or dashboard build. Builders that resolve names per ID
+// share a loader so each distinct ID hits its repository at most once
+// per request, without a long-lived cache's invalidation concerns.
+// Loaders are cheap to construct and must not outlive the request.
+type metadataLoader struct {
+	categoryRepo category.Repository
+	userRepo     user.Repository
+	categories   map[uuid.UUID]*category.Category
+	users        map[uuid.UUID]*user.User
+}
+
+// newMetadataLoader builds a loader over the given repositories; either
+// may be nil, in which case lookups of that kind resolve to nothing.
+func newMetadataLoader(categoryRepo category.Repository, userRepo user.Repository) *metadataLoader {
+	return &metadataLoader{
+		categoryRepo: categoryRepo,
+		userRepo:     userRepo,
+		categories:   make(map[uuid.UUID]*category.Category),
+		users:        make(map[uuid.UUID]*user.User),
+	}
+}
+
+// Category resolves a category by ID, hitting the repository only on the
+// first lookup of each ID. Missing categories come back nil without an
+// error so report rows degrade to empty names instead of failing.
+func (l *metadataLoader) Category(ctx context.Context, id uuid.UUID) (*category.Category, error) {
+	if l.categoryRepo == nil || id.IsNil() {
+		return nil, nil
+	}
+	if c, ok := l.categories[id]; ok {
+		return c, nil
+	}
+	c, err := l.categoryRepo.GetByID(ctx, id)
+	if errors.Is(err, category.ErrNotFound) {
+		c = nil
+	} else if err != nil {
+		return nil, fmt.Errorf("load category %s: %w", id, err)
+	}
+	l.categories[id] = c
+	return c, nil
+}
+
+// CategoryName is Category reduced to the name, empty when unresolved.
+func (l *metadataLoader) CategoryName(ctx context.Context, id uuid.UUID) (string, error) {
+	c, err := l.Category(ctx, id)
+	if err != nil || c == nil {
+		return "", err
+	}
+	return c.Name, nil
+}
+
+// User resolves a user by ID with the same memoization and missing-ID
+// semantics as Category.
+func (l *metadataLoader) User(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	if l.userRepo == nil || id.IsNil() {
+		return nil, nil
+	}
+	if u, ok := l.users[id]; ok {
+		return u, nil
+	}
+	u, err := l.userRepo.GetByID(ctx, id)
+	if errors.Is(err, user.ErrNotFound) {
+		u = nil
+	} else if err != nil {
+		return nil, fmt.Errorf("load user %s: %w", id, err)
+	}
+	l.users[id] = u
+	return u, nil
+}
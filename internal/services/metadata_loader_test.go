@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+// countingCategoryRepo wraps a category repository and counts GetByID
+// calls per ID.
+type countingCategoryRepo struct {
+	category.Repository
+	getByID map[uuid.UUID]int
+}
+
+func newCountingCategoryRepo() *countingCategoryRepo {
+	return &countingCategoryRepo{
+		Repository: memory.NewCategoryRepository(),
+		getByID:    make(map[uuid.UUID]int),
+	}
+}
+
+func (c *countingCategoryRepo) GetByID(ctx context.Context, id uuid.UUID) (*category.Category, error) {
+	c.getByID[id]++
+	return c.Repository.GetByID(ctx, id)
+}
+
+func TestMetadataLoader_MemoizesCategoryLookups(t *testing.T) {
+	repo := newCountingCategoryRepo()
+	familyID := uuid.New()
+	c := &category.Category{ID: uuid.New(), Name: "Groceries", Type: category.TypeExpense, FamilyID: familyID, IsActive: true}
+	if err := repo.Create(context.Background(), c); err != nil {
+		t.Fatalf("create category: %v", err)
+	}
+
+	loader := newMetadataLoader(repo, nil)
+	for i := 0; i < 5; i++ {
+		name, err := loader.CategoryName(context.Background(), c.ID)
+		if err != nil {
+			t.Fatalf("CategoryName: %v", err)
+		}
+		if name != "Groceries" {
+			t.Fatalf("name = %q, want Groceries", name)
+		}
+	}
+	if repo.getByID[c.ID] != 1 {
+		t.Errorf("category fetched %d times, want 1", repo.getByID[c.ID])
+	}
+
+	// Missing categories resolve to nothing, also only once.
+	missing := uuid.New()
+	for i := 0; i < 3; i++ {
+		if name, err := loader.CategoryName(context.Background(), missing); err != nil || name != "" {
+			t.Fatalf("missing category: name = %q, err = %v", name, err)
+		}
+	}
+	if repo.getByID[missing] != 1 {
+		t.Errorf("missing category fetched %d times, want 1", repo.getByID[missing])
+	}
+}
+
+func TestGenerateExpenseReport_FetchesEachCategoryOnce(t *testing.T) {
+	familyID := uuid.New()
+	categoryRepo := newCountingCategoryRepo()
+	categoryIDs := make([]uuid.UUID, 3)
+	for i, name := range []string{"Food", "Transport", "Fun"} {
+		c := &category.Category{ID: uuid.New(), Name: name, Type: category.TypeExpense, FamilyID: familyID, IsActive: true}
+		if err := categoryRepo.Create(context.Background(), c); err != nil {
+			t.Fatalf("create category: %v", err)
+		}
+		categoryIDs[i] = c.ID
+	}
+
+	day := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	txRepo := &fakeTransactionRepo{}
+	for i := 0; i < 12; i++ {
+		txRepo.transactions = append(txRepo.transactions, expenseOn(familyID, categoryIDs[i%3], 10, day))
+	}
+
+	svc := NewReportService(newFakeReportRepo(), txRepo, nil, nil, nil)
+	svc.SetCategoryRepository(categoryRepo)
+	result, err := svc.GenerateExpenseReport(context.Background(), dto.ReportRequestDTO{
+		FamilyID:  familyID,
+		StartDate: day.AddDate(0, 0, -1),
+		EndDate:   day.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		t.Fatalf("GenerateExpenseReport: %v", err)
+	}
+
+	for _, entry := range result.ByCategory {
+		if entry.Name == "" {
+			t.Errorf("category %s has no resolved name", entry.CategoryID)
+		}
+	}
+	for id, count := range categoryRepo.getByID {
+		if count > 1 {
+			t.Errorf("category %s fetched %d times, want at most 1", id, count)
+		}
+	}
+	if len(categoryRepo.getByID) != len(categoryIDs) {
+		t.Errorf("fetched %d distinct categories, want %d", len(categoryRepo.getByID), len(categoryIDs))
+	}
+}
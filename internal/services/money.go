@@ -0,0 +1,21 @@
+package services
+
+import "math"
+
+// centsPerUnit is the amount precision used across the service layer:
+// two decimals, the minor unit of every currency the app handles.
+const centsPerUnit = 100
+
+// RoundAmount rounds a monetary amount to the nearest cent. Every place
+// that derives an amount (currency conversion, percentages) rounds
+// through here so precision rules live in one spot.
+func RoundAmount(v float64) float64 {
+	return math.Round(v*centsPerUnit) / centsPerUnit
+}
+
+// AddAmounts returns a+b rounded to the nearest cent. Running totals are
+// accumulated through it so binary float error cannot build up across
+// many additions (0.1+0.2 style artifacts).
+func AddAmounts(a, b float64) float64 {
+	return RoundAmount(a + b)
+}
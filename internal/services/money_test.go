@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestAddAmounts_ManySmallAmountsSumExactly(t *testing.T) {
+	var total float64
+	for i := 0; i < 10000; i++ {
+		total = AddAmounts(total, 0.01)
+	}
+	if total != 100 {
+		t.Fatalf("total = %v, want exactly 100", total)
+	}
+}
+
+func TestAddAmounts_AvoidsBinaryArtifacts(t *testing.T) {
+	// 0.1 + 0.2 is the canonical float64 artifact (0.30000000000000004).
+	if got := AddAmounts(0.1, 0.2); got != 0.3 {
+		t.Fatalf("AddAmounts(0.1, 0.2) = %v, want exactly 0.3", got)
+	}
+	if got := AddAmounts(AddAmounts(0.1, 0.2), 0.3); got != 0.6 {
+		t.Fatalf("running total = %v, want exactly 0.6", got)
+	}
+}
+
+func TestRoundAmount(t *testing.T) {
+	cases := []struct {
+		in, want float64
+	}{
+		{1.006, 1.01},
+		{1.004, 1.0},
+		{-1.006, -1.01},
+		{99.999, 100},
+		{0, 0},
+	}
+	for _, c := range cases {
+		if got := RoundAmount(c.in); got != c.want {
+			t.Errorf("RoundAmount(%v) = %v, want %v", c.in, got, c.want)
+		}
+	}
+}
+
+func TestGenerateExpenseReport_ExactTotalWithCentAmounts(t *testing.T) {
+	svc, users, transactions := newTransactionServiceFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+
+	for i := 0; i < 100; i++ {
+		tx := expenseFor(familyID, u.ID)
+		tx.Amount = 0.1
+		if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+	}
+
+	reports := NewReportService(newFakeReportRepo(), transactions, nil, nil, nil)
+	result, err := reports.GenerateExpenseReport(context.Background(), dto.ReportRequestDTO{
+		FamilyID:  familyID,
+		StartDate: time.Now().Add(-time.Hour),
+		EndDate:   time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("GenerateExpenseReport: %v", err)
+	}
+	if result.TotalExpenses != 10 {
+		t.Fatalf("TotalExpenses = %v, want exactly 10", result.TotalExpenses)
+	}
+}
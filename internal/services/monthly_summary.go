@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/preferences"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/notification"
+	"family-budget-service/pkg/uuid"
+)
+
+// monthlySummaryTopCategories is how many expense categories the summary
+// email lists.
+const monthlySummaryTopCategories = 3
+
+// MonthlySummary holds one family's numbers for one calendar month.
+type MonthlySummary struct {
+	FamilyID      uuid.UUID
+	Month         time.Time // first instant of the month
+	TotalIncome   float64
+	TotalExpenses float64
+	// TopCategories are the biggest expense categories of the month,
+	// largest first.
+	TopCategories []CategoryExpense
+	// Overruns lists the budgets that ended the month past their cap.
+	Overruns []BudgetOverrun
+}
+
+// CategoryExpense is one category's expense total inside a summary.
+type CategoryExpense struct {
+	CategoryID uuid.UUID
+	Amount     float64
+}
+
+// BudgetOverrun is one over-spent budget inside a summary.
+type BudgetOverrun struct {
+	BudgetID uuid.UUID
+	Name     string
+	Amount   float64
+	Spent    float64
+}
+
+// MonthlySummaryService sends each family a monthly email recapping the
+// previous month to the members who opted in.
+type MonthlySummaryService struct {
+	familyRepo      user.FamilyRepository
+	userRepo        user.Repository
+	preferencesRepo preferences.Repository
+	transactionRepo transaction.Repository
+	budgetRepo      budget.Repository
+	sender          notification.EmailSender
+
+	// lastSent guards against re-sending within the same month when the
+	// scheduler ticks more than once. Process-local, like the pending
+	// email-change state.
+	mu       sync.Mutex
+	lastSent map[uuid.UUID]time.Time
+}
+
+// NewMonthlySummaryService builds a MonthlySummaryService. Pass a
+// notification.LogSender as sender in development so no real mail goes
+// out.
+func NewMonthlySummaryService(
+	familyRepo user.FamilyRepository,
+	userRepo user.Repository,
+	preferencesRepo preferences.Repository,
+	transactionRepo transaction.Repository,
+	budgetRepo budget.Repository,
+	sender notification.EmailSender,
+) *MonthlySummaryService {
+	return &MonthlySummaryService{
+		familyRepo:      familyRepo,
+		userRepo:        userRepo,
+		preferencesRepo: preferencesRepo,
+		transactionRepo: transactionRepo,
+		budgetRepo:      budgetRepo,
+		sender:          sender,
+		lastSent:        make(map[uuid.UUID]time.Time),
+	}
+}
+
+// BuildMonthlySummary computes one family's summary for the month
+// containing month.
+func (s *MonthlySummaryService) BuildMonthlySummary(ctx context.Context, familyID uuid.UUID, month time.Time) (*MonthlySummary, error) {
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+
+	// Income is a single repository-side aggregate; only the expenses
+	// are loaded, since the category breakdown needs their allocations.
+	income, err := s.transactionRepo.GetTotalByFamilyAndDateRange(ctx, familyID, start, end, transaction.TypeIncome)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate income for summary: %w", err)
+	}
+	expenseType := transaction.TypeExpense
+	expenses, err := s.transactionRepo.GetByFilter(ctx, transaction.Filter{
+		FamilyID: familyID,
+		Type:     &expenseType,
+		DateFrom: &start,
+		DateTo:   &end,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load expenses for summary: %w", err)
+	}
+
+	summary := &MonthlySummary{FamilyID: familyID, Month: start}
+	summary.TotalIncome = RoundAmount(income)
+	byCategory := make(map[uuid.UUID]float64)
+	for _, t := range expenses {
+		summary.TotalExpenses = AddAmounts(summary.TotalExpenses, t.Amount)
+		for _, allocation := range t.Allocations() {
+			byCategory[allocation.CategoryID] = AddAmounts(byCategory[allocation.CategoryID], allocation.Amount)
+		}
+	}
+	for categoryID, amount := range byCategory {
+		summary.TopCategories = append(summary.TopCategories, CategoryExpense{CategoryID: categoryID, Amount: amount})
+	}
+	sort.Slice(summary.TopCategories, func(i, j int) bool {
+		return summary.TopCategories[i].Amount > summary.TopCategories[j].Amount
+	})
+	if len(summary.TopCategories) > monthlySummaryTopCategories {
+		summary.TopCategories = summary.TopCategories[:monthlySummaryTopCategories]
+	}
+
+	budgets, err := s.budgetRepo.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load budgets for summary: %w", err)
+	}
+	for _, b := range budgets {
+		if b.StartDate.After(end) || b.EndDate.Before(start) {
+			continue
+		}
+		if b.Spent > b.Amount {
+			summary.Overruns = append(summary.Overruns, BudgetOverrun{
+				BudgetID: b.ID,
+				Name:     b.Name,
+				Amount:   b.Amount,
+				Spent:    b.Spent,
+			})
+		}
+	}
+	return summary, nil
+}
+
+// SendMonthlySummaries emails every family's previous-month summary to
+// its opted-in members. Each family is covered at most once per month;
+// repeated scheduler ticks within the month are no-ops. It returns how
+// many emails went out.
+func (s *MonthlySummaryService) SendMonthlySummaries(ctx context.Context, now time.Time) (int, error) {
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, -1, 0)
+	families, err := s.familyRepo.List(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list families: %w", err)
+	}
+	sent := 0
+	for _, f := range families {
+		if s.alreadySent(f.ID, month) {
+			continue
+		}
+		n, err := s.sendFamilySummary(ctx, f, month)
+		if err != nil {
+			return sent, err
+		}
+		sent += n
+		s.markSent(f.ID, month)
+	}
+	return sent, nil
+}
+
+// sendFamilySummary builds and emails one family's summary. Families
+// with no opted-in members skip the build entirely.
+func (s *MonthlySummaryService) sendFamilySummary(ctx context.Context, f *user.Family, month time.Time) (int, error) {
+	recipients, err := s.optedInMembers(ctx, f.ID)
+	if err != nil || len(recipients) == 0 {
+		return 0, err
+	}
+	summary, err := s.BuildMonthlySummary(ctx, f.ID, month)
+	if err != nil {
+		return 0, err
+	}
+	subject := fmt.Sprintf("Family finances for %s", month.Format("January 2006"))
+	body := formatMonthlySummary(f, summary)
+	sent := 0
+	for _, member := range recipients {
+		if err := s.sender.Send(ctx, member.Email, subject, body); err != nil {
+			return sent, fmt.Errorf("send summary to %s: %w", member.Email, err)
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// optedInMembers returns the family members who asked for the summary.
+// A family without preferences has nobody opted in.
+func (s *MonthlySummaryService) optedInMembers(ctx context.Context, familyID uuid.UUID) ([]*user.User, error) {
+	prefs, err := s.preferencesRepo.GetByFamilyID(ctx, familyID)
+	if errors.Is(err, preferences.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load preferences: %w", err)
+	}
+	if len(prefs.MonthlySummaryOptIns) == 0 {
+		return nil, nil
+	}
+	members, err := s.userRepo.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load family members: %w", err)
+	}
+	var out []*user.User
+	for _, member := range members {
+		if prefs.MonthlySummaryOptIns[member.ID] {
+			out = append(out, member)
+		}
+	}
+	return out, nil
+}
+
+// formatMonthlySummary renders the plain-text email body.
+func formatMonthlySummary(f *user.Family, summary *MonthlySummary) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Summary for %s, %s\n\n", f.Name, summary.Month.Format("January 2006"))
+	fmt.Fprintf(&b, "Total income: %.2f %s\n", summary.TotalIncome, f.Currency)
+	fmt.Fprintf(&b, "Total expenses: %.2f %s\n", summary.TotalExpenses, f.Currency)
+	if len(summary.TopCategories) > 0 {
+		b.WriteString("\nTop expense categories:\n")
+		for _, c := range summary.TopCategories {
+			fmt.Fprintf(&b, "  %s: %.2f\n", c.CategoryID, c.Amount)
+		}
+	}
+	if len(summary.Overruns) > 0 {
+		b.WriteString("\nBudgets over their cap:\n")
+		for _, o := range summary.Overruns {
+			fmt.Fprintf(&b, "  %s: %.2f spent of %.2f\n", o.Name, o.Spent, o.Amount)
+		}
+	}
+	return b.String()
+}
+
+func (s *MonthlySummaryService) alreadySent(familyID uuid.UUID, month time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastSent[familyID].Equal(month)
+}
+
+func (s *MonthlySummaryService) markSent(familyID uuid.UUID, month time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastSent[familyID] = month
+}
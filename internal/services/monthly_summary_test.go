@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+// recordingSender captures sent emails instead of delivering them.
+type recordingSender struct {
+	mu   sync.Mutex
+	sent []recordedEmail
+}
+
+type recordedEmail struct {
+	to, subject, body string
+}
+
+func (s *recordingSender) Send(_ context.Context, to, subject, body string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, recordedEmail{to: to, subject: subject, body: body})
+	return nil
+}
+
+type summaryFixture struct {
+	summaries    *MonthlySummaryService
+	transactions *TransactionService
+	preferences  *PreferencesService
+	users        *memory.UserRepository
+	budgets      *memory.BudgetRepository
+	sender       *recordingSender
+	family       *user.Family
+}
+
+func newSummaryFixture(t *testing.T) *summaryFixture {
+	t.Helper()
+	users := memory.NewUserRepository()
+	families := memory.NewFamilyRepository()
+	transactions := memory.NewTransactionRepository()
+	budgets := memory.NewBudgetRepository()
+	prefs := memory.NewPreferencesRepository()
+	sender := &recordingSender{}
+
+	f := &user.Family{ID: uuid.New(), Name: "Smith", Currency: "USD"}
+	if err := families.Create(context.Background(), f); err != nil {
+		t.Fatalf("create family: %v", err)
+	}
+	return &summaryFixture{
+		summaries:    NewMonthlySummaryService(families, users, prefs, transactions, budgets, sender),
+		transactions: NewTransactionService(transactions, users),
+		preferences:  NewPreferencesService(prefs, transactions),
+		users:        users,
+		budgets:      budgets,
+		sender:       sender,
+		family:       f,
+	}
+}
+
+func TestBuildMonthlySummary_SeededMonth(t *testing.T) {
+	fx := newSummaryFixture(t)
+	u := seedFamilyUser(t, fx.users, fx.family.ID)
+	lastMonth := time.Now().AddDate(0, -1, 0)
+	food, rent := uuid.New(), uuid.New()
+
+	salary := expenseFor(fx.family.ID, u.ID)
+	salary.Type = transaction.TypeIncome
+	salary.Amount = 1000
+	salary.Date = lastMonth
+	groceries := expenseFor(fx.family.ID, u.ID)
+	groceries.Amount = 200
+	groceries.CategoryID = food
+	groceries.Date = lastMonth
+	housing := expenseFor(fx.family.ID, u.ID)
+	housing.Amount = 500
+	housing.CategoryID = rent
+	housing.Date = lastMonth
+	thisMonth := expenseFor(fx.family.ID, u.ID)
+	thisMonth.Amount = 999
+	for _, tx := range []*transaction.Transaction{salary, groceries, housing, thisMonth} {
+		if err := fx.transactions.CreateTransaction(context.Background(), tx); err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+	}
+	overrun := &budget.Budget{
+		ID:        uuid.New(),
+		FamilyID:  fx.family.ID,
+		Name:      "Groceries",
+		Amount:    150,
+		Spent:     200,
+		StartDate: lastMonth.AddDate(0, 0, -5),
+		EndDate:   lastMonth.AddDate(0, 0, 25),
+	}
+	if err := fx.budgets.Create(context.Background(), overrun); err != nil {
+		t.Fatalf("create budget: %v", err)
+	}
+
+	summary, err := fx.summaries.BuildMonthlySummary(context.Background(), fx.family.ID, lastMonth)
+	if err != nil {
+		t.Fatalf("BuildMonthlySummary: %v", err)
+	}
+	if summary.TotalIncome != 1000 || summary.TotalExpenses != 700 {
+		t.Errorf("totals = %v income / %v expenses, want 1000 / 700", summary.TotalIncome, summary.TotalExpenses)
+	}
+	if len(summary.TopCategories) != 2 || summary.TopCategories[0].CategoryID != rent || summary.TopCategories[0].Amount != 500 {
+		t.Errorf("top categories = %+v, want rent 500 first", summary.TopCategories)
+	}
+	if len(summary.Overruns) != 1 || summary.Overruns[0].Name != "Groceries" {
+		t.Errorf("overruns = %+v, want the Groceries budget", summary.Overruns)
+	}
+}
+
+func TestSendMonthlySummaries_SkipsOptedOutMembers(t *testing.T) {
+	fx := newSummaryFixture(t)
+	optedIn := seedFamilyUser(t, fx.users, fx.family.ID)
+	optedOut := seedFamilyUser(t, fx.users, fx.family.ID)
+	if _, err := fx.preferences.SetMonthlySummaryOptIn(context.Background(), fx.family.ID, optedIn.ID, true); err != nil {
+		t.Fatalf("SetMonthlySummaryOptIn: %v", err)
+	}
+	salary := expenseFor(fx.family.ID, optedIn.ID)
+	salary.Type = transaction.TypeIncome
+	salary.Amount = 300
+	salary.Date = time.Now().AddDate(0, -1, 0)
+	if err := fx.transactions.CreateTransaction(context.Background(), salary); err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+
+	sent, err := fx.summaries.SendMonthlySummaries(context.Background(), time.Now())
+	if err != nil {
+		t.Fatalf("SendMonthlySummaries: %v", err)
+	}
+	if sent != 1 || len(fx.sender.sent) != 1 {
+		t.Fatalf("sent %d emails (%d recorded), want exactly 1", sent, len(fx.sender.sent))
+	}
+	email := fx.sender.sent[0]
+	if email.to != optedIn.Email || email.to == optedOut.Email {
+		t.Errorf("recipient = %q, want the opted-in member %q", email.to, optedIn.Email)
+	}
+	if !strings.Contains(email.body, "Total income: 300.00 USD") {
+		t.Errorf("body missing income line:\n%s", email.body)
+	}
+
+	// A second tick in the same month must not re-send.
+	sent, err = fx.summaries.SendMonthlySummaries(context.Background(), time.Now())
+	if err != nil || sent != 0 {
+		t.Errorf("second tick sent %d, %v; want 0 sends", sent, err)
+	}
+}
@@ -0,0 +1,239 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/preferences"
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrNegativeGoal rejects preference updates with negative targets.
+var ErrNegativeGoal = errors.New("income goal and expense cap must not be negative")
+
+// PreferencesService manages family preferences and the dashboard stats
+// derived from them.
+type PreferencesService struct {
+	preferencesRepo preferences.Repository
+	transactionRepo transaction.Repository
+}
+
+// NewPreferencesService builds a PreferencesService.
+func NewPreferencesService(preferencesRepo preferences.Repository, transactionRepo transaction.Repository) *PreferencesService {
+	return &PreferencesService{preferencesRepo: preferencesRepo, transactionRepo: transactionRepo}
+}
+
+// GetPreferences returns the family's preferences, or a zeroed record
+// when none have been saved yet.
+func (s *PreferencesService) GetPreferences(ctx context.Context, familyID uuid.UUID) (*preferences.Preferences, error) {
+	p, err := s.preferencesRepo.GetByFamilyID(ctx, familyID)
+	if errors.Is(err, preferences.ErrNotFound) {
+		return &preferences.Preferences{FamilyID: familyID}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load preferences: %w", err)
+	}
+	return p, nil
+}
+
+// SetGoals stores the family's monthly income goal and expense cap.
+// Zero clears a goal; negative values are rejected.
+func (s *PreferencesService) SetGoals(ctx context.Context, familyID uuid.UUID, incomeGoal, expenseCap float64) (*preferences.Preferences, error) {
+	if incomeGoal < 0 || expenseCap < 0 {
+		return nil, ErrNegativeGoal
+	}
+	p, err := s.GetPreferences(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+	p.MonthlyIncomeGoal = incomeGoal
+	p.MonthlyExpenseCap = expenseCap
+	p.UpdatedAt = time.Now()
+	if err := s.preferencesRepo.Upsert(ctx, p); err != nil {
+		return nil, fmt.Errorf("save preferences: %w", err)
+	}
+	return p, nil
+}
+
+// ErrNegativeSpendingLimit rejects negative per-member spending caps.
+var ErrNegativeSpendingLimit = errors.New("spending limit must not be negative")
+
+// SetMemberSpendingLimit stores the monthly expense cap for one family
+// member. Zero clears the member's cap; negative values are rejected.
+func (s *PreferencesService) SetMemberSpendingLimit(ctx context.Context, familyID, memberID uuid.UUID, limit float64) (*preferences.Preferences, error) {
+	if limit < 0 {
+		return nil, ErrNegativeSpendingLimit
+	}
+	p, err := s.GetPreferences(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		delete(p.MemberSpendingLimits, memberID)
+	} else {
+		if p.MemberSpendingLimits == nil {
+			p.MemberSpendingLimits = make(map[uuid.UUID]float64)
+		}
+		p.MemberSpendingLimits[memberID] = limit
+	}
+	p.UpdatedAt = time.Now()
+	if err := s.preferencesRepo.Upsert(ctx, p); err != nil {
+		return nil, fmt.Errorf("save preferences: %w", err)
+	}
+	return p, nil
+}
+
+// SetMonthlySummaryOptIn records whether one member wants the monthly
+// summary email. Opting out removes the entry.
+func (s *PreferencesService) SetMonthlySummaryOptIn(ctx context.Context, familyID, memberID uuid.UUID, optIn bool) (*preferences.Preferences, error) {
+	p, err := s.GetPreferences(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+	if !optIn {
+		delete(p.MonthlySummaryOptIns, memberID)
+	} else {
+		if p.MonthlySummaryOptIns == nil {
+			p.MonthlySummaryOptIns = make(map[uuid.UUID]bool)
+		}
+		p.MonthlySummaryOptIns[memberID] = true
+	}
+	p.UpdatedAt = time.Now()
+	if err := s.preferencesRepo.Upsert(ctx, p); err != nil {
+		return nil, fmt.Errorf("save preferences: %w", err)
+	}
+	return p, nil
+}
+
+// FamilySettings carries a full settings update from the settings page.
+type FamilySettings struct {
+	Currency               string
+	MonthlyIncomeGoal      float64
+	MonthlyExpenseCap      float64
+	WeekStartDay           int
+	DashboardPeriod        string
+	Timezone               string
+	ExportFilenameTemplate string
+	AuditRetentionDays     int
+	ExcludeReimbursed      bool
+}
+
+// ErrInvalidWeekStart rejects week-start days outside Sunday..Saturday.
+var ErrInvalidWeekStart = errors.New("week start day must be between 0 (Sunday) and 6 (Saturday)")
+
+// ErrInvalidRetention rejects negative audit retention windows.
+var ErrInvalidRetention = errors.New("audit retention days must not be negative")
+
+// ErrInvalidTimezone rejects timezone names the IANA database doesn't
+// know.
+var ErrInvalidTimezone = errors.New("unknown timezone")
+
+// UpdateSettings stores a family's settings wholesale. Goal validation
+// matches SetGoals; currency validation is the caller's responsibility so
+// web and import paths can share their own rules.
+func (s *PreferencesService) UpdateSettings(ctx context.Context, familyID uuid.UUID, settings FamilySettings) (*preferences.Preferences, error) {
+	if settings.MonthlyIncomeGoal < 0 || settings.MonthlyExpenseCap < 0 {
+		return nil, ErrNegativeGoal
+	}
+	if settings.WeekStartDay < 0 || settings.WeekStartDay > 6 {
+		return nil, ErrInvalidWeekStart
+	}
+	if settings.AuditRetentionDays < 0 {
+		return nil, ErrInvalidRetention
+	}
+	if settings.Timezone != "" {
+		if _, err := time.LoadLocation(settings.Timezone); err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidTimezone, settings.Timezone)
+		}
+	}
+	p, err := s.GetPreferences(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+	p.Currency = settings.Currency
+	p.MonthlyIncomeGoal = settings.MonthlyIncomeGoal
+	p.MonthlyExpenseCap = settings.MonthlyExpenseCap
+	p.WeekStartDay = settings.WeekStartDay
+	p.DashboardPeriod = settings.DashboardPeriod
+	p.Timezone = settings.Timezone
+	p.ExportFilenameTemplate = settings.ExportFilenameTemplate
+	p.AuditRetentionDays = settings.AuditRetentionDays
+	p.ExcludeReimbursed = settings.ExcludeReimbursed
+	p.UpdatedAt = time.Now()
+	if err := s.preferencesRepo.Upsert(ctx, p); err != nil {
+		return nil, fmt.Errorf("save preferences: %w", err)
+	}
+	return p, nil
+}
+
+// PeriodOptions resolves the family's preferences into the options that
+// anchor report and dashboard period windows, so weekly periods start on
+// the family's configured weekday.
+func (s *PreferencesService) PeriodOptions(ctx context.Context, familyID uuid.UUID) (report.PeriodOptions, error) {
+	p, err := s.GetPreferences(ctx, familyID)
+	if err != nil {
+		return report.PeriodOptions{}, err
+	}
+	return report.PeriodOptions{WeekStartDay: p.WeekStartDay}, nil
+}
+
+// EnhancedStats backs the dashboard's goal cards. The Has* flags tell the
+// template whether to render a goal bar at all — an unset goal is hidden,
+// not shown as 0% progress.
+type EnhancedStats struct {
+	MonthIncome   float64
+	MonthExpenses float64
+
+	IncomeGoal         float64
+	HasIncomeGoal      bool
+	IncomeGoalProgress float64 // percent of goal reached
+
+	ExpenseBudget         float64
+	HasExpenseBudget      bool
+	ExpenseBudgetProgress float64 // percent of cap consumed
+}
+
+// GetEnhancedStats computes the family's month-to-date income and expenses
+// and their progress against the configured goals.
+func (s *PreferencesService) GetEnhancedStats(ctx context.Context, familyID uuid.UUID, now time.Time) (*EnhancedStats, error) {
+	p, err := s.GetPreferences(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	txs, err := s.transactionRepo.GetByFilter(ctx, transaction.Filter{
+		FamilyID: familyID,
+		DateFrom: &monthStart,
+		DateTo:   &now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load month transactions: %w", err)
+	}
+
+	stats := &EnhancedStats{
+		IncomeGoal:    p.MonthlyIncomeGoal,
+		ExpenseBudget: p.MonthlyExpenseCap,
+	}
+	for _, t := range txs {
+		switch t.Type {
+		case transaction.TypeIncome:
+			stats.MonthIncome += t.Amount
+		case transaction.TypeExpense:
+			stats.MonthExpenses += t.Amount
+		}
+	}
+	if p.MonthlyIncomeGoal > 0 {
+		stats.HasIncomeGoal = true
+		stats.IncomeGoalProgress = stats.MonthIncome / p.MonthlyIncomeGoal * 100
+	}
+	if p.MonthlyExpenseCap > 0 {
+		stats.HasExpenseBudget = true
+		stats.ExpenseBudgetProgress = stats.MonthExpenses / p.MonthlyExpenseCap * 100
+	}
+	return stats, nil
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func newPreferencesFixture(t *testing.T) (*PreferencesService, *memory.TransactionRepository) {
+	t.Helper()
+	transactions := memory.NewTransactionRepository()
+	return NewPreferencesService(memory.NewPreferencesRepository(), transactions), transactions
+}
+
+func seedMonthTransaction(t *testing.T, repo *memory.TransactionRepository, familyID uuid.UUID, txType transaction.Type, amount float64, date time.Time) {
+	t.Helper()
+	err := repo.Create(context.Background(), &transaction.Transaction{
+		ID: uuid.New(), Amount: amount, Type: txType,
+		CategoryID: uuid.New(), UserID: uuid.New(), FamilyID: familyID, Date: date,
+	})
+	if err != nil {
+		t.Fatalf("seed transaction: %v", err)
+	}
+}
+
+func TestEnhancedStats_ProgressAgainstConfiguredGoals(t *testing.T) {
+	svc, transactions := newPreferencesFixture(t)
+	familyID := uuid.New()
+	now := time.Date(2026, 4, 20, 12, 0, 0, 0, time.UTC)
+
+	seedMonthTransaction(t, transactions, familyID, transaction.TypeIncome, 3000, now.AddDate(0, 0, -5))
+	seedMonthTransaction(t, transactions, familyID, transaction.TypeExpense, 900, now.AddDate(0, 0, -3))
+	// Outside the current month; must not count.
+	seedMonthTransaction(t, transactions, familyID, transaction.TypeExpense, 500, now.AddDate(0, -1, 0))
+
+	if _, err := svc.SetGoals(context.Background(), familyID, 4000, 1200); err != nil {
+		t.Fatalf("SetGoals: %v", err)
+	}
+
+	stats, err := svc.GetEnhancedStats(context.Background(), familyID, now)
+	if err != nil {
+		t.Fatalf("GetEnhancedStats: %v", err)
+	}
+	if !stats.HasIncomeGoal || !stats.HasExpenseBudget {
+		t.Fatalf("stats = %+v, want both goals flagged as set", stats)
+	}
+	if math.Abs(stats.IncomeGoalProgress-75) > 0.01 {
+		t.Errorf("IncomeGoalProgress = %.2f, want 75", stats.IncomeGoalProgress)
+	}
+	if math.Abs(stats.ExpenseBudgetProgress-75) > 0.01 {
+		t.Errorf("ExpenseBudgetProgress = %.2f, want 75", stats.ExpenseBudgetProgress)
+	}
+	if stats.MonthExpenses != 900 {
+		t.Errorf("MonthExpenses = %.2f, want 900 (previous month excluded)", stats.MonthExpenses)
+	}
+}
+
+func TestEnhancedStats_UnsetGoalsStayHidden(t *testing.T) {
+	svc, transactions := newPreferencesFixture(t)
+	familyID := uuid.New()
+	now := time.Date(2026, 4, 20, 12, 0, 0, 0, time.UTC)
+	seedMonthTransaction(t, transactions, familyID, transaction.TypeExpense, 250, now.AddDate(0, 0, -1))
+
+	stats, err := svc.GetEnhancedStats(context.Background(), familyID, now)
+	if err != nil {
+		t.Fatalf("GetEnhancedStats: %v", err)
+	}
+	if stats.HasIncomeGoal || stats.HasExpenseBudget {
+		t.Errorf("stats = %+v, want goals hidden when no preference is stored", stats)
+	}
+	if stats.IncomeGoalProgress != 0 || stats.ExpenseBudgetProgress != 0 {
+		t.Errorf("progress = %.2f/%.2f, want zeroes without goals",
+			stats.IncomeGoalProgress, stats.ExpenseBudgetProgress)
+	}
+}
+
+func TestSetGoals_RejectsNegativeValues(t *testing.T) {
+	svc, _ := newPreferencesFixture(t)
+	if _, err := svc.SetGoals(context.Background(), uuid.New(), -1, 100); !errors.Is(err, ErrNegativeGoal) {
+		t.Errorf("err = %v, want ErrNegativeGoal", err)
+	}
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrInvalidRecurringFrequency rejects templates with an unknown cadence.
+var ErrInvalidRecurringFrequency = errors.New("invalid recurring frequency")
+
+// RecurringService manages recurring-transaction templates and turns due
+// occurrences into real transactions.
+type RecurringService struct {
+	recurringRepo      transaction.RecurringRepository
+	transactionService *TransactionService
+}
+
+// NewRecurringService builds a RecurringService. Materialized occurrences
+// go through the transaction service so they see the same validation as
+// hand-entered transactions.
+func NewRecurringService(recurringRepo transaction.RecurringRepository, transactionService *TransactionService) *RecurringService {
+	return &RecurringService{recurringRepo: recurringRepo, transactionService: transactionService}
+}
+
+// CreateTemplate validates and stores a new recurring template. The first
+// occurrence lands on the start date.
+func (s *RecurringService) CreateTemplate(ctx context.Context, t *transaction.RecurringTemplate) error {
+	if !t.Frequency.Valid() {
+		return fmt.Errorf("%w: %q", ErrInvalidRecurringFrequency, t.Frequency)
+	}
+	if t.ID.IsNil() {
+		t.ID = uuid.New()
+	}
+	t.NextOccurrence = t.StartDate
+	t.IsActive = true
+	now := time.Now()
+	t.CreatedAt = now
+	t.UpdatedAt = now
+	if err := s.recurringRepo.Create(ctx, t); err != nil {
+		return fmt.Errorf("create recurring template: %w", err)
+	}
+	return nil
+}
+
+// GetTemplatesByFamily lists a family's recurring templates.
+func (s *RecurringService) GetTemplatesByFamily(ctx context.Context, familyID uuid.UUID) ([]*transaction.RecurringTemplate, error) {
+	return s.recurringRepo.GetByFamilyID(ctx, familyID)
+}
+
+// UpdateTemplate changes a template going forward. Transactions already
+// materialized from it are left untouched.
+func (s *RecurringService) UpdateTemplate(ctx context.Context, t *transaction.RecurringTemplate) error {
+	if !t.Frequency.Valid() {
+		return fmt.Errorf("%w: %q", ErrInvalidRecurringFrequency, t.Frequency)
+	}
+	t.UpdatedAt = time.Now()
+	return s.recurringRepo.Update(ctx, t)
+}
+
+// DeleteTemplate removes a template. Its past occurrences stay in the
+// ledger.
+func (s *RecurringService) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	return s.recurringRepo.Delete(ctx, id)
+}
+
+// SkipNextOccurrence advances a template past its next occurrence without
+// creating a transaction — for the month rent is prepaid or a
+// subscription pauses.
+func (s *RecurringService) SkipNextOccurrence(ctx context.Context, id uuid.UUID) error {
+	t, err := s.recurringRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	t.NextOccurrence = t.Frequency.Next(t.NextOccurrence)
+	t.UpdatedAt = time.Now()
+	return s.recurringRepo.Update(ctx, t)
+}
+
+// MaterializeDueRecurring creates transactions for every occurrence due
+// by now, catching up templates that missed several ticks. Templates past
+// their end date are deactivated. It returns the created transactions.
+func (s *RecurringService) MaterializeDueRecurring(ctx context.Context, now time.Time) ([]*transaction.Transaction, error) {
+	due, err := s.recurringRepo.GetDue(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("load due recurring templates: %w", err)
+	}
+
+	var created []*transaction.Transaction
+	for _, t := range due {
+		for !t.NextOccurrence.After(now) {
+			if t.EndDate != nil && t.NextOccurrence.After(*t.EndDate) {
+				t.IsActive = false
+				break
+			}
+			tx := &transaction.Transaction{
+				Amount:      t.Amount,
+				Type:        t.Type,
+				Description: t.Description,
+				CategoryID:  t.CategoryID,
+				UserID:      t.UserID,
+				FamilyID:    t.FamilyID,
+				Date:        t.NextOccurrence,
+			}
+			if err := s.transactionService.CreateTransaction(ctx, tx); err != nil {
+				return created, fmt.Errorf("materialize occurrence: %w", err)
+			}
+			created = append(created, tx)
+			t.NextOccurrence = t.Frequency.Next(t.NextOccurrence)
+		}
+		t.UpdatedAt = time.Now()
+		if err := s.recurringRepo.Update(ctx, t); err != nil {
+			return created, fmt.Errorf("advance recurring template: %w", err)
+		}
+	}
+	return created, nil
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func newRecurringFixture(t *testing.T) (*RecurringService, *memory.TransactionRepository, *memory.UserRepository) {
+	t.Helper()
+	users := memory.NewUserRepository()
+	transactions := memory.NewTransactionRepository()
+	svc := NewRecurringService(memory.NewRecurringRepository(), NewTransactionService(transactions, users))
+	return svc, transactions, users
+}
+
+func rentTemplate(familyID, userID uuid.UUID, start time.Time) *transaction.RecurringTemplate {
+	return &transaction.RecurringTemplate{
+		Amount:      1200,
+		Type:        transaction.TypeExpense,
+		Description: "Rent",
+		CategoryID:  uuid.New(),
+		UserID:      userID,
+		FamilyID:    familyID,
+		Frequency:   transaction.RecurringMonthly,
+		StartDate:   start,
+	}
+}
+
+func TestMaterializeDueRecurring_MonthlyAcrossYearBoundary(t *testing.T) {
+	svc, transactions, users := newRecurringFixture(t)
+	familyID := uuid.New()
+	payer := seedFamilyUser(t, users, familyID)
+
+	start := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	tmpl := rentTemplate(familyID, payer.ID, start)
+	if err := svc.CreateTemplate(context.Background(), tmpl); err != nil {
+		t.Fatalf("CreateTemplate: %v", err)
+	}
+
+	now := time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC)
+	created, err := svc.MaterializeDueRecurring(context.Background(), now)
+	if err != nil {
+		t.Fatalf("MaterializeDueRecurring: %v", err)
+	}
+	// Nov 1, Dec 1, Jan 1, Feb 1.
+	if len(created) != 4 {
+		t.Fatalf("created %d transactions, want 4 across the year boundary", len(created))
+	}
+	wantDates := []time.Time{
+		start,
+		time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for i, tx := range created {
+		if !tx.Date.Equal(wantDates[i]) {
+			t.Errorf("occurrence %d on %v, want %v", i, tx.Date, wantDates[i])
+		}
+		if tx.Amount != 1200 || tx.Description != "Rent" {
+			t.Errorf("occurrence %d = %+v, want the template's amount and description", i, tx)
+		}
+	}
+
+	// A second run right after creates nothing new.
+	again, err := svc.MaterializeDueRecurring(context.Background(), now)
+	if err != nil {
+		t.Fatalf("second MaterializeDueRecurring: %v", err)
+	}
+	if len(again) != 0 {
+		t.Errorf("second run created %d transactions, want 0", len(again))
+	}
+
+	stored, err := transactions.GetByFamilyID(context.Background(), familyID)
+	if err != nil || len(stored) != 4 {
+		t.Errorf("stored = %d, %v; want 4", len(stored), err)
+	}
+}
+
+func TestSkipNextOccurrence_AdvancesWithoutCreating(t *testing.T) {
+	svc, transactions, users := newRecurringFixture(t)
+	familyID := uuid.New()
+	payer := seedFamilyUser(t, users, familyID)
+
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	tmpl := rentTemplate(familyID, payer.ID, start)
+	if err := svc.CreateTemplate(context.Background(), tmpl); err != nil {
+		t.Fatalf("CreateTemplate: %v", err)
+	}
+	if err := svc.SkipNextOccurrence(context.Background(), tmpl.ID); err != nil {
+		t.Fatalf("SkipNextOccurrence: %v", err)
+	}
+
+	created, err := svc.MaterializeDueRecurring(context.Background(), time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("MaterializeDueRecurring: %v", err)
+	}
+	if len(created) != 0 {
+		t.Errorf("created %d transactions after skip, want 0 until April", len(created))
+	}
+	stored, err := transactions.GetByFamilyID(context.Background(), familyID)
+	if err != nil || len(stored) != 0 {
+		t.Errorf("stored = %d, %v; want none", len(stored), err)
+	}
+}
+
+func TestDeleteTemplate_KeepsMaterializedTransactions(t *testing.T) {
+	svc, transactions, users := newRecurringFixture(t)
+	familyID := uuid.New()
+	payer := seedFamilyUser(t, users, familyID)
+
+	tmpl := rentTemplate(familyID, payer.ID, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err := svc.CreateTemplate(context.Background(), tmpl); err != nil {
+		t.Fatalf("CreateTemplate: %v", err)
+	}
+	if _, err := svc.MaterializeDueRecurring(context.Background(), time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("MaterializeDueRecurring: %v", err)
+	}
+	if err := svc.DeleteTemplate(context.Background(), tmpl.ID); err != nil {
+		t.Fatalf("DeleteTemplate: %v", err)
+	}
+
+	stored, err := transactions.GetByFamilyID(context.Background(), familyID)
+	if err != nil || len(stored) != 1 {
+		t.Errorf("stored = %d, %v; want the materialized transaction kept", len(stored), err)
+	}
+}
+
+func TestCreateTemplate_RejectsUnknownFrequency(t *testing.T) {
+	svc, _, users := newRecurringFixture(t)
+	familyID := uuid.New()
+	payer := seedFamilyUser(t, users, familyID)
+
+	tmpl := rentTemplate(familyID, payer.ID, time.Now())
+	tmpl.Frequency = "fortnightly"
+	if err := svc.CreateTemplate(context.Background(), tmpl); err == nil {
+		t.Error("CreateTemplate accepted an unknown frequency")
+	}
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+// benchmarkTrailingMonths is the history window benchmarks compare the
+// current month against.
+const benchmarkTrailingMonths = 6
+
+// CalculateBenchmarks compares the family's current month against its
+// own recent history: per-category spend versus the trailing six-month
+// average, and the current savings rate versus the historical median.
+//
+// There is no external peer dataset, so the "peer" in the comparison is
+// deliberately the family itself (PeerGroup reflects that); the value is
+// spotting drift from the family's own baseline.
+func (s *ReportService) CalculateBenchmarks(ctx context.Context, familyID uuid.UUID) (*dto.BenchmarkComparisonDTO, error) {
+	now := time.Now()
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	historyStart := currentMonth.AddDate(0, -benchmarkTrailingMonths, 0)
+
+	transactions, err := s.getTransactionsForPeriod(ctx, familyID, historyStart, now, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("calculate benchmarks: %w", err)
+	}
+
+	// Per-month income/expense plus per-category expense sums, split
+	// into trailing history and the current month.
+	byMonth := make(map[time.Time]*monthTotals)
+	trailingByCategory := make(map[uuid.UUID]float64)
+	currentByCategory := make(map[uuid.UUID]float64)
+	var currentIncome, currentExpenses float64
+
+	for _, t := range transactions {
+		month := time.Date(t.Date.Year(), t.Date.Month(), 1, 0, 0, 0, 0, time.UTC)
+		inCurrent := month.Equal(currentMonth)
+		if !inCurrent {
+			totals, ok := byMonth[month]
+			if !ok {
+				totals = &monthTotals{}
+				byMonth[month] = totals
+			}
+			switch t.Type {
+			case transaction.TypeIncome:
+				totals.income += t.Amount
+			case transaction.TypeExpense:
+				totals.expenses += t.Amount
+				trailingByCategory[t.CategoryID] += t.Amount
+			}
+			continue
+		}
+		switch t.Type {
+		case transaction.TypeIncome:
+			currentIncome += t.Amount
+		case transaction.TypeExpense:
+			currentExpenses += t.Amount
+			currentByCategory[t.CategoryID] += t.Amount
+		}
+	}
+
+	result := &dto.BenchmarkComparisonDTO{
+		FamilyID:     familyID,
+		CurrentMonth: currentMonth,
+		PeerGroup:    dto.BenchmarkPeerGroupSelfHistory,
+	}
+
+	// Every category seen in either window gets a row so new and
+	// abandoned categories both show a delta.
+	seen := make(map[uuid.UUID]bool)
+	for id := range trailingByCategory {
+		seen[id] = true
+	}
+	for id := range currentByCategory {
+		seen[id] = true
+	}
+	for id := range seen {
+		average := trailingByCategory[id] / benchmarkTrailingMonths
+		current := currentByCategory[id]
+		row := dto.CategoryBenchmarkDTO{
+			CategoryID:      id,
+			CurrentSpend:    current,
+			TrailingAverage: average,
+			Delta:           current - average,
+		}
+		if average > 0 {
+			row.DeltaPercent = (current - average) / average * 100
+		}
+		result.Categories = append(result.Categories, row)
+	}
+	sort.Slice(result.Categories, func(i, j int) bool {
+		return result.Categories[i].Delta > result.Categories[j].Delta
+	})
+
+	if currentIncome > 0 {
+		result.CurrentSavingsRate = (currentIncome - currentExpenses) / currentIncome
+	}
+	result.MedianSavingsRate = medianSavingsRate(byMonth)
+	result.SavingsRateDelta = result.CurrentSavingsRate - result.MedianSavingsRate
+	return result, nil
+}
+
+// monthTotals accumulates one month's income and expenses.
+type monthTotals struct{ income, expenses float64 }
+
+// medianSavingsRate computes the median of per-month savings rates over
+// the trailing window, skipping months with no income.
+func medianSavingsRate(byMonth map[time.Time]*monthTotals) float64 {
+	var rates []float64
+	for _, totals := range byMonth {
+		if totals.income <= 0 {
+			continue
+		}
+		rates = append(rates, (totals.income-totals.expenses)/totals.income)
+	}
+	if len(rates) == 0 {
+		return 0
+	}
+	sort.Float64s(rates)
+	mid := len(rates) / 2
+	if len(rates)%2 == 1 {
+		return rates[mid]
+	}
+	return (rates[mid-1] + rates[mid]) / 2
+}
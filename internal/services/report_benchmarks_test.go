@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestCalculateBenchmarks_SixMonthsOfData(t *testing.T) {
+	transactions := memory.NewTransactionRepository()
+	svc := NewReportService(memory.NewReportRepository(), transactions, nil, nil, nil)
+
+	familyID := uuid.New()
+	groceries := uuid.New()
+	now := time.Now().UTC()
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	// Six trailing months: 300/month groceries, 1000 income, 400 total
+	// expenses (300 groceries + 100 misc).
+	misc := uuid.New()
+	for i := 1; i <= 6; i++ {
+		month := currentMonth.AddDate(0, -i, 0)
+		seedInsightTransaction(t, transactions, familyID, groceries, transaction.TypeExpense, 300, month.AddDate(0, 0, 3))
+		seedInsightTransaction(t, transactions, familyID, misc, transaction.TypeExpense, 100, month.AddDate(0, 0, 4))
+		seedInsightTransaction(t, transactions, familyID, uuid.New(), transaction.TypeIncome, 1000, month.AddDate(0, 0, 1))
+	}
+	// Current month: groceries jumped to 450.
+	seedInsightTransaction(t, transactions, familyID, groceries, transaction.TypeExpense, 450, currentMonth)
+	seedInsightTransaction(t, transactions, familyID, uuid.New(), transaction.TypeIncome, 1000, currentMonth)
+
+	got, err := svc.CalculateBenchmarks(context.Background(), familyID)
+	if err != nil {
+		t.Fatalf("CalculateBenchmarks: %v", err)
+	}
+
+	if got.PeerGroup != dto.BenchmarkPeerGroupSelfHistory {
+		t.Errorf("PeerGroup = %q, want explicit self-history marker", got.PeerGroup)
+	}
+
+	var groceriesRow *dto.CategoryBenchmarkDTO
+	for i := range got.Categories {
+		if got.Categories[i].CategoryID == groceries {
+			groceriesRow = &got.Categories[i]
+		}
+	}
+	if groceriesRow == nil {
+		t.Fatal("groceries category missing from benchmark rows")
+	}
+	if groceriesRow.TrailingAverage != 300 {
+		t.Errorf("groceries trailing average = %v, want 300", groceriesRow.TrailingAverage)
+	}
+	if groceriesRow.Delta != 150 {
+		t.Errorf("groceries delta = %v, want +150", groceriesRow.Delta)
+	}
+	if math.Abs(groceriesRow.DeltaPercent-50) > 0.001 {
+		t.Errorf("groceries delta percent = %v, want 50", groceriesRow.DeltaPercent)
+	}
+
+	// Historical savings rate was a constant 60%; current month saved
+	// 55% (1000 income, 450 spent).
+	if math.Abs(got.MedianSavingsRate-0.6) > 0.001 {
+		t.Errorf("median savings rate = %v, want 0.6", got.MedianSavingsRate)
+	}
+	if math.Abs(got.CurrentSavingsRate-0.55) > 0.001 {
+		t.Errorf("current savings rate = %v, want 0.55", got.CurrentSavingsRate)
+	}
+	if math.Abs(got.SavingsRateDelta-(-0.05)) > 0.001 {
+		t.Errorf("savings rate delta = %v, want -0.05", got.SavingsRateDelta)
+	}
+}
+
+func TestCalculateBenchmarks_EmptyHistory(t *testing.T) {
+	svc := NewReportService(memory.NewReportRepository(), memory.NewTransactionRepository(), nil, nil, nil)
+	got, err := svc.CalculateBenchmarks(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("CalculateBenchmarks: %v", err)
+	}
+	if len(got.Categories) != 0 || got.MedianSavingsRate != 0 {
+		t.Errorf("empty history should produce empty benchmarks, got %+v", got)
+	}
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// comparisonMoverCount is how many categories the biggest-movers summary
+// of a period comparison keeps.
+const comparisonMoverCount = 3
+
+// CategoryComparison is one category's totals across the two compared
+// periods. A category absent from a period contributes zero for it.
+type CategoryComparison struct {
+	CategoryID   uuid.UUID
+	Name         string
+	FirstAmount  float64
+	SecondAmount float64
+	// Delta is SecondAmount - FirstAmount: positive means spending in
+	// the category grew.
+	Delta float64
+}
+
+// ReportComparison is the side-by-side result of running the same
+// aggregation over two windows.
+type ReportComparison struct {
+	FamilyID    uuid.UUID
+	FirstStart  time.Time
+	FirstEnd    time.Time
+	SecondStart time.Time
+	SecondEnd   time.Time
+
+	FirstIncome    float64
+	SecondIncome   float64
+	IncomeDelta    float64
+	FirstExpenses  float64
+	SecondExpenses float64
+	ExpensesDelta  float64
+
+	// Categories holds every category seen in either period, ordered by
+	// absolute delta, largest movement first.
+	Categories []CategoryComparison
+	// BiggestMovers is the head of Categories, capped at
+	// comparisonMoverCount.
+	BiggestMovers []CategoryComparison
+}
+
+// ComparePeriods generates the report aggregates for two windows and
+// returns them combined with per-category deltas, for "this month vs
+// last month" views.
+func (s *ReportService) ComparePeriods(
+	ctx context.Context,
+	familyID uuid.UUID,
+	firstStart, firstEnd, secondStart, secondEnd time.Time,
+) (*ReportComparison, error) {
+	first, err := s.buildReportData(ctx, familyID, firstStart, firstEnd)
+	if err != nil {
+		return nil, fmt.Errorf("compare first period: %w", err)
+	}
+	second, err := s.buildReportData(ctx, familyID, secondStart, secondEnd)
+	if err != nil {
+		return nil, fmt.Errorf("compare second period: %w", err)
+	}
+
+	result := &ReportComparison{
+		FamilyID:       familyID,
+		FirstStart:     firstStart,
+		FirstEnd:       firstEnd,
+		SecondStart:    secondStart,
+		SecondEnd:      secondEnd,
+		FirstIncome:    first.TotalIncome,
+		SecondIncome:   second.TotalIncome,
+		IncomeDelta:    RoundAmount(second.TotalIncome - first.TotalIncome),
+		FirstExpenses:  first.TotalExpenses,
+		SecondExpenses: second.TotalExpenses,
+		ExpensesDelta:  RoundAmount(second.TotalExpenses - first.TotalExpenses),
+	}
+
+	// Union the category sets: a category present in only one period
+	// still shows up, with zero on the other side.
+	byCategory := make(map[uuid.UUID]*CategoryComparison)
+	for _, item := range first.CategoryBreakdown {
+		byCategory[item.CategoryID] = &CategoryComparison{
+			CategoryID:  item.CategoryID,
+			Name:        item.CategoryName,
+			FirstAmount: item.Amount,
+		}
+	}
+	for _, item := range second.CategoryBreakdown {
+		entry, ok := byCategory[item.CategoryID]
+		if !ok {
+			entry = &CategoryComparison{CategoryID: item.CategoryID, Name: item.CategoryName}
+			byCategory[item.CategoryID] = entry
+		}
+		entry.SecondAmount = item.Amount
+		if entry.Name == "" {
+			entry.Name = item.CategoryName
+		}
+	}
+	for _, entry := range byCategory {
+		entry.Delta = RoundAmount(entry.SecondAmount - entry.FirstAmount)
+		result.Categories = append(result.Categories, *entry)
+	}
+	sort.Slice(result.Categories, func(i, j int) bool {
+		a, b := result.Categories[i], result.Categories[j]
+		if math.Abs(a.Delta) != math.Abs(b.Delta) {
+			return math.Abs(a.Delta) > math.Abs(b.Delta)
+		}
+		return a.CategoryID.String() < b.CategoryID.String()
+	})
+
+	movers := len(result.Categories)
+	if movers > comparisonMoverCount {
+		movers = comparisonMoverCount
+	}
+	result.BiggestMovers = result.Categories[:movers]
+	return result, nil
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestComparePeriods_CategoryOnlyInSecondPeriod(t *testing.T) {
+	transactions := memory.NewTransactionRepository()
+	categories := memory.NewCategoryRepository()
+	svc := NewReportService(memory.NewReportRepository(), transactions, nil, nil, nil)
+	svc.SetCategoryRepository(categories)
+	familyID := uuid.New()
+
+	groceries := &category.Category{
+		ID: uuid.New(), Name: "Groceries", Type: category.TypeExpense,
+		FamilyID: familyID, IsActive: true,
+	}
+	travel := &category.Category{
+		ID: uuid.New(), Name: "Travel", Type: category.TypeExpense,
+		FamilyID: familyID, IsActive: true,
+	}
+	for _, c := range []*category.Category{groceries, travel} {
+		if err := categories.Create(context.Background(), c); err != nil {
+			t.Fatalf("seed category: %v", err)
+		}
+	}
+
+	seed := func(categoryID uuid.UUID, amount float64, date time.Time) {
+		t.Helper()
+		err := transactions.Create(context.Background(), &transaction.Transaction{
+			ID: uuid.New(), Amount: amount, Type: transaction.TypeExpense,
+			CategoryID: categoryID, UserID: uuid.New(), FamilyID: familyID, Date: date,
+		})
+		if err != nil {
+			t.Fatalf("seed transaction: %v", err)
+		}
+	}
+	march := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	april := time.Date(2026, 4, 10, 12, 0, 0, 0, time.UTC)
+	seed(groceries.ID, 200, march)
+	seed(groceries.ID, 250, april)
+	// Travel exists only in the second month.
+	seed(travel.ID, 400, april)
+
+	comparison, err := svc.ComparePeriods(context.Background(), familyID,
+		time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 31, 23, 59, 59, 0, time.UTC),
+		time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 30, 23, 59, 59, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("ComparePeriods() error = %v", err)
+	}
+
+	if comparison.FirstExpenses != 200 || comparison.SecondExpenses != 650 {
+		t.Errorf("expenses = %v vs %v, want 200 vs 650",
+			comparison.FirstExpenses, comparison.SecondExpenses)
+	}
+	if comparison.ExpensesDelta != 450 {
+		t.Errorf("ExpensesDelta = %v, want 450", comparison.ExpensesDelta)
+	}
+	if len(comparison.Categories) != 2 {
+		t.Fatalf("categories = %d, want 2", len(comparison.Categories))
+	}
+
+	// Travel moved the most (0 -> 400) and so leads the ordering.
+	lead := comparison.Categories[0]
+	if lead.CategoryID != travel.ID || lead.Name != "Travel" {
+		t.Fatalf("biggest mover = %s (%s), want Travel", lead.Name, lead.CategoryID)
+	}
+	if lead.FirstAmount != 0 || lead.SecondAmount != 400 || lead.Delta != 400 {
+		t.Errorf("Travel comparison = %+v, want 0 -> 400, delta 400", lead)
+	}
+	second := comparison.Categories[1]
+	if second.CategoryID != groceries.ID || second.FirstAmount != 200 || second.SecondAmount != 250 || second.Delta != 50 {
+		t.Errorf("Groceries comparison = %+v, want 200 -> 250, delta 50", second)
+	}
+
+	if len(comparison.BiggestMovers) != 2 || comparison.BiggestMovers[0].CategoryID != travel.ID {
+		t.Errorf("BiggestMovers = %+v, want Travel first", comparison.BiggestMovers)
+	}
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+// GenerateIncomeCoverageReport relates spending to earnings: for each
+// income period it reports what fraction of that period's income every
+// expense category consumed. Periods with expenses but no income keep
+// their absolute spend with a zero percentage instead of dividing by
+// zero. Periods are returned oldest first.
+func (s *ReportService) GenerateIncomeCoverageReport(ctx context.Context, familyID uuid.UUID, period report.Period) (*dto.IncomeCoverageReportDTO, error) {
+	transactions, err := s.transactionRepo.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load family transactions: %w", err)
+	}
+
+	income := make(map[time.Time]float64)
+	spent := make(map[time.Time]map[uuid.UUID]float64)
+	for _, t := range transactions {
+		start := coveragePeriodStart(t.Date, period)
+		switch t.Type {
+		case transaction.TypeIncome:
+			income[start] += t.Amount
+		case transaction.TypeExpense:
+			if spent[start] == nil {
+				spent[start] = make(map[uuid.UUID]float64)
+			}
+			for _, a := range t.Allocations() {
+				spent[start][a.CategoryID] += a.Amount
+			}
+		}
+	}
+
+	starts := make(map[time.Time]bool)
+	for s := range income {
+		starts[s] = true
+	}
+	for s := range spent {
+		starts[s] = true
+	}
+
+	result := &dto.IncomeCoverageReportDTO{FamilyID: familyID, Period: period}
+	for start := range starts {
+		p := dto.IncomeCoveragePeriodDTO{
+			Start:  start,
+			End:    coveragePeriodEnd(start, period),
+			Income: income[start],
+		}
+		for categoryID, amount := range spent[start] {
+			c := dto.CategoryCoverageDTO{CategoryID: categoryID, Spent: amount}
+			if p.Income > 0 {
+				c.PercentOfIncome = amount / p.Income * 100
+			}
+			p.Categories = append(p.Categories, c)
+		}
+		sort.Slice(p.Categories, func(i, j int) bool { return p.Categories[i].Spent > p.Categories[j].Spent })
+		result.Periods = append(result.Periods, p)
+	}
+	sort.Slice(result.Periods, func(i, j int) bool { return result.Periods[i].Start.Before(result.Periods[j].Start) })
+	return result, nil
+}
+
+// coveragePeriodStart buckets a date into its income period, in UTC.
+// Weeks start on Monday; the default granularity is monthly.
+func coveragePeriodStart(date time.Time, period report.Period) time.Time {
+	date = date.UTC()
+	switch period {
+	case report.PeriodDaily:
+		return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	case report.PeriodWeekly:
+		day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -int((day.Weekday()+6)%7))
+	case report.PeriodQuarterly:
+		return time.Date(date.Year(), date.Month()-(date.Month()-1)%3, 1, 0, 0, 0, 0, time.UTC)
+	case report.PeriodYearly:
+		return time.Date(date.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// coveragePeriodEnd returns the inclusive last day of the period
+// starting at start.
+func coveragePeriodEnd(start time.Time, period report.Period) time.Time {
+	switch period {
+	case report.PeriodDaily:
+		return start
+	case report.PeriodWeekly:
+		return start.AddDate(0, 0, 6)
+	case report.PeriodQuarterly:
+		return start.AddDate(0, 3, -1)
+	case report.PeriodYearly:
+		return start.AddDate(1, 0, -1)
+	default:
+		return start.AddDate(0, 1, -1)
+	}
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestGenerateIncomeCoverageReport_CategoryShares(t *testing.T) {
+	transactions := memory.NewTransactionRepository()
+	svc := NewReportService(memory.NewReportRepository(), transactions, nil, nil, nil)
+	familyID := uuid.New()
+	groceries := uuid.New()
+	rent := uuid.New()
+
+	march := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	seed := func(amount float64, txType transaction.Type, categoryID uuid.UUID, date time.Time) {
+		t.Helper()
+		err := transactions.Create(context.Background(), &transaction.Transaction{
+			ID: uuid.New(), Amount: amount, Type: txType,
+			CategoryID: categoryID, UserID: uuid.New(), FamilyID: familyID, Date: date,
+		})
+		if err != nil {
+			t.Fatalf("seed transaction: %v", err)
+		}
+	}
+	seed(4000, transaction.TypeIncome, uuid.New(), march)
+	seed(1000, transaction.TypeExpense, rent, march.AddDate(0, 0, 2))
+	seed(600, transaction.TypeExpense, groceries, march.AddDate(0, 0, 5))
+
+	got, err := svc.GenerateIncomeCoverageReport(context.Background(), familyID, report.PeriodMonthly)
+	if err != nil {
+		t.Fatalf("GenerateIncomeCoverageReport: %v", err)
+	}
+	if len(got.Periods) != 1 {
+		t.Fatalf("periods = %d, want 1", len(got.Periods))
+	}
+	p := got.Periods[0]
+	if p.Income != 4000 {
+		t.Errorf("income = %v, want 4000", p.Income)
+	}
+	if len(p.Categories) != 2 {
+		t.Fatalf("categories = %d, want 2", len(p.Categories))
+	}
+	// Largest share first: rent 25%, groceries 15%.
+	if p.Categories[0].CategoryID != rent || p.Categories[0].PercentOfIncome != 25 {
+		t.Errorf("first category = %+v, want rent at 25%%", p.Categories[0])
+	}
+	if p.Categories[1].CategoryID != groceries || p.Categories[1].PercentOfIncome != 15 {
+		t.Errorf("second category = %+v, want groceries at 15%%", p.Categories[1])
+	}
+}
+
+func TestGenerateIncomeCoverageReport_ZeroIncomePeriod(t *testing.T) {
+	transactions := memory.NewTransactionRepository()
+	svc := NewReportService(memory.NewReportRepository(), transactions, nil, nil, nil)
+	familyID := uuid.New()
+	groceries := uuid.New()
+
+	// Income in March, only spending in April.
+	err := transactions.Create(context.Background(), &transaction.Transaction{
+		ID: uuid.New(), Amount: 3000, Type: transaction.TypeIncome,
+		CategoryID: uuid.New(), UserID: uuid.New(), FamilyID: familyID,
+		Date: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("seed income: %v", err)
+	}
+	err = transactions.Create(context.Background(), &transaction.Transaction{
+		ID: uuid.New(), Amount: 500, Type: transaction.TypeExpense,
+		CategoryID: groceries, UserID: uuid.New(), FamilyID: familyID,
+		Date: time.Date(2026, 4, 8, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("seed expense: %v", err)
+	}
+
+	got, err := svc.GenerateIncomeCoverageReport(context.Background(), familyID, report.PeriodMonthly)
+	if err != nil {
+		t.Fatalf("GenerateIncomeCoverageReport: %v", err)
+	}
+	if len(got.Periods) != 2 {
+		t.Fatalf("periods = %d, want March and April", len(got.Periods))
+	}
+	april := got.Periods[1]
+	if april.Income != 0 {
+		t.Fatalf("april income = %v, want 0", april.Income)
+	}
+	if len(april.Categories) != 1 || april.Categories[0].Spent != 500 || april.Categories[0].PercentOfIncome != 0 {
+		t.Errorf("april categories = %+v, want 500 spent at 0%% of income", april.Categories)
+	}
+}
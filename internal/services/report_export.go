@@ -0,0 +1,123 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/pkg/uuid"
+)
+
+// DefaultExportFilenameTemplate names exports when a family has not
+// configured its own template.
+const DefaultExportFilenameTemplate = "{name}_{date}"
+
+// ExportFilename renders a filename template for a report. Supported
+// tokens are {name}, {type}, {period} and {date} (the generation date,
+// YYYY-MM-DD). The result is sanitized for filesystem safety and always
+// carries a .csv extension; an empty template falls back to the default.
+func ExportFilename(template string, r *report.Report) string {
+	if strings.TrimSpace(template) == "" {
+		template = DefaultExportFilenameTemplate
+	}
+	replacer := strings.NewReplacer(
+		"{name}", r.Name,
+		"{type}", string(r.Type),
+		"{period}", string(r.Period),
+		"{date}", r.GeneratedAt.Format("2006-01-02"),
+	)
+	name := sanitizeFilename(replacer.Replace(template))
+	if name == "" {
+		name = "report"
+	}
+	return name + ".csv"
+}
+
+// sanitizeFilename keeps letters, digits, dash, underscore and dot;
+// anything else (path separators, spaces, shell metacharacters) becomes
+// an underscore. Runs of underscores collapse so stripped characters
+// don't leave gaps.
+func sanitizeFilename(name string) string {
+	var b strings.Builder
+	for _, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '.':
+			b.WriteRune(c)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	collapsed := b.String()
+	for strings.Contains(collapsed, "__") {
+		collapsed = strings.ReplaceAll(collapsed, "__", "_")
+	}
+	return strings.Trim(collapsed, "_.")
+}
+
+// ExportReportAsCSV renders a stored report as CSV using the family's
+// filename template, formatting money cells in the family's currency.
+// It returns the filename and the file contents. Callers that can write
+// straight to an output should prefer ExportReportToWriter, which does
+// not hold the whole file in memory.
+func (s *ReportService) ExportReportAsCSV(ctx context.Context, reportID uuid.UUID, filenameTemplate, currency string) (string, []byte, error) {
+	var buf bytes.Buffer
+	filename, err := s.ExportReportToWriter(ctx, reportID, filenameTemplate, currency, &buf)
+	if err != nil {
+		return "", nil, err
+	}
+	return filename, buf.Bytes(), nil
+}
+
+// ExportReportToWriter streams a stored report as CSV into w row by row,
+// so memory use stays flat no matter how large the breakdown is. It
+// returns the export filename.
+func (s *ReportService) ExportReportToWriter(ctx context.Context, reportID uuid.UUID, filenameTemplate, currency string, w io.Writer) (string, error) {
+	defer s.observeExport("csv", time.Now())
+	r, err := s.reportRepo.GetByID(ctx, reportID)
+	if err != nil {
+		return "", fmt.Errorf("load report: %w", err)
+	}
+
+	cw := csv.NewWriter(w)
+	header := [][]string{
+		{"report", r.Name},
+		{"type", string(r.Type)},
+		{"period", r.StartDate.Format("2006-01-02") + " - " + r.EndDate.Format("2006-01-02")},
+		{"total_income", FormatCurrency(currency, r.Data.TotalIncome)},
+		{"total_expenses", FormatCurrency(currency, r.Data.TotalExpenses)},
+		{},
+		{"category", "amount", "percentage"},
+	}
+	for _, row := range header {
+		if err := cw.Write(row); err != nil {
+			return "", fmt.Errorf("write csv: %w", err)
+		}
+	}
+	for _, item := range r.Data.CategoryBreakdown {
+		if err := cw.Write([]string{
+			item.CategoryName,
+			FormatCurrency(currency, item.Amount),
+			formatAmount(item.Percentage),
+		}); err != nil {
+			return "", fmt.Errorf("write csv: %w", err)
+		}
+		// Flush per row so the bytes reach w incrementally instead of
+		// accumulating in the csv writer's buffer.
+		cw.Flush()
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return "", fmt.Errorf("write csv: %w", err)
+	}
+	return ExportFilename(filenameTemplate, r), nil
+}
+
+func formatAmount(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
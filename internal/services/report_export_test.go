@@ -0,0 +1,114 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func exportReport() *report.Report {
+	return &report.Report{
+		ID:          uuid.New(),
+		Name:        "March Expenses",
+		Type:        report.TypeExpenses,
+		Period:      report.PeriodMonthly,
+		GeneratedAt: time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestExportFilename_RendersTemplateTokens(t *testing.T) {
+	got := ExportFilename("{type}-{period}-{name}-{date}", exportReport())
+	want := "expenses-monthly-March_Expenses-2026-04-01.csv"
+	if got != want {
+		t.Errorf("ExportFilename = %q, want %q", got, want)
+	}
+}
+
+func TestExportFilename_DefaultTemplate(t *testing.T) {
+	got := ExportFilename("", exportReport())
+	if got != "March_Expenses_2026-04-01.csv" {
+		t.Errorf("ExportFilename = %q, want the default name_date form", got)
+	}
+}
+
+func TestExportFilename_StripsUnsafeCharacters(t *testing.T) {
+	r := exportReport()
+	r.Name = "../../etc/passwd; rm -rf /"
+
+	got := ExportFilename("{name}", r)
+	if strings.ContainsAny(got, "/\\;& ") {
+		t.Errorf("ExportFilename = %q, contains unsafe characters", got)
+	}
+	if strings.HasPrefix(got, ".") {
+		t.Errorf("ExportFilename = %q, must not start with a dot", got)
+	}
+	if !strings.HasSuffix(got, ".csv") {
+		t.Errorf("ExportFilename = %q, want .csv extension", got)
+	}
+}
+
+func TestExportFilename_EmptyAfterSanitizingFallsBack(t *testing.T) {
+	r := exportReport()
+	r.Name = "///"
+	if got := ExportFilename("{name}", r); got != "report.csv" {
+		t.Errorf("ExportFilename = %q, want the report fallback", got)
+	}
+}
+
+// chunkRecorder counts individual Write calls to observe streaming.
+type chunkRecorder struct {
+	writes int
+	buf    bytes.Buffer
+}
+
+func (r *chunkRecorder) Write(p []byte) (int, error) {
+	r.writes++
+	return r.buf.Write(p)
+}
+
+func TestExportReportToWriter_StreamsIncrementally(t *testing.T) {
+	reports := memory.NewReportRepository()
+	svc := NewReportService(reports, memory.NewTransactionRepository(), nil, nil, memory.NewUserRepository())
+
+	saved := exportReport()
+	saved.FamilyID = uuid.New()
+	for i := 0; i < 50; i++ {
+		saved.Data.CategoryBreakdown = append(saved.Data.CategoryBreakdown, report.CategoryBreakdownItem{
+			CategoryName: "Category " + strconv.Itoa(i),
+			Amount:       float64(i) * 10,
+			Percentage:   2,
+		})
+	}
+	if err := reports.Create(context.Background(), saved); err != nil {
+		t.Fatalf("seed report: %v", err)
+	}
+
+	rec := &chunkRecorder{}
+	filename, err := svc.ExportReportToWriter(context.Background(), saved.ID, "", "USD", rec)
+	if err != nil {
+		t.Fatalf("ExportReportToWriter: %v", err)
+	}
+	if filename != ExportFilename("", saved) {
+		t.Errorf("filename = %q, want %q", filename, ExportFilename("", saved))
+	}
+	// One flush per breakdown row means at least one write per category;
+	// a single monolithic write would show up as one.
+	if rec.writes < 50 {
+		t.Errorf("writer saw %d writes, want at least one per breakdown row", rec.writes)
+	}
+
+	_, buffered, err := svc.ExportReportAsCSV(context.Background(), saved.ID, "", "USD")
+	if err != nil {
+		t.Fatalf("ExportReportAsCSV: %v", err)
+	}
+	if rec.buf.String() != string(buffered) {
+		t.Error("streamed output differs from the buffered export")
+	}
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+// forecastHistoryMonths is how far back the forecast model looks. Two
+// years gives every forecast month a same-calendar-month observation
+// from last year when the family has been active that long.
+const forecastHistoryMonths = 24
+
+// seasonalWeight is how much a same-calendar-month observation from last
+// year contributes versus the trailing average.
+const seasonalWeight = 0.5
+
+// ErrInvalidForecastHorizon is returned for a non-positive month count.
+var ErrInvalidForecastHorizon = errors.New("forecast horizon must be at least one month")
+
+// GenerateSpendingForecast predicts the family's total expenses for each
+// of the next months. The model blends the trailing monthly average with
+// the same calendar month of the previous year when that history exists,
+// and degrades to the flat average otherwise. Forecasts are never
+// negative.
+func (s *ReportService) GenerateSpendingForecast(ctx context.Context, familyID uuid.UUID, months int) ([]dto.ForecastDTO, error) {
+	if months <= 0 {
+		return nil, ErrInvalidForecastHorizon
+	}
+
+	now := time.Now()
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	historyStart := currentMonth.AddDate(0, -forecastHistoryMonths, 0)
+
+	expenseType := transaction.TypeExpense
+	transactions, err := s.getTransactionsForPeriod(ctx, familyID, historyStart, currentMonth, &expenseType, nil)
+	if err != nil {
+		return nil, fmt.Errorf("generate spending forecast: %w", err)
+	}
+
+	// Bucket historical expenses by calendar month.
+	monthly := make(map[time.Time]float64)
+	for _, t := range transactions {
+		bucket := time.Date(t.Date.Year(), t.Date.Month(), 1, 0, 0, 0, 0, time.UTC)
+		monthly[bucket] += t.Amount
+	}
+
+	trailing := trailingMonthlyAverage(monthly, currentMonth)
+
+	forecast := make([]dto.ForecastDTO, 0, months)
+	for i := 0; i < months; i++ {
+		month := currentMonth.AddDate(0, i, 0)
+		predicted := trailing
+		basis := dto.ForecastBasisTrailingAverage
+		if seasonal, ok := monthly[month.AddDate(-1, 0, 0)]; ok {
+			predicted = seasonalWeight*seasonal + (1-seasonalWeight)*trailing
+			basis = dto.ForecastBasisSeasonal
+		}
+		if predicted < 0 {
+			predicted = 0
+		}
+		forecast = append(forecast, dto.ForecastDTO{
+			Month:             month,
+			PredictedExpenses: predicted,
+			Basis:             basis,
+		})
+	}
+	return forecast, nil
+}
+
+// trailingMonthlyAverage averages the last up-to-12 observed months
+// before currentMonth. Months with no transactions are skipped rather
+// than counted as zero so a sparse history isn't dragged down.
+func trailingMonthlyAverage(monthly map[time.Time]float64, currentMonth time.Time) float64 {
+	var sum float64
+	var observed int
+	for i := 1; i <= 12; i++ {
+		if amount, ok := monthly[currentMonth.AddDate(0, -i, 0)]; ok {
+			sum += amount
+			observed++
+		}
+	}
+	if observed == 0 {
+		return 0
+	}
+	return sum / float64(observed)
+}
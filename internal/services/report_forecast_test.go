@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func seedMonthlyExpenses(t *testing.T, repo *memory.TransactionRepository, familyID uuid.UUID, months int, amount func(i int) float64) {
+	t.Helper()
+	now := time.Now()
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for i := 1; i <= months; i++ {
+		err := repo.Create(context.Background(), &transaction.Transaction{
+			ID:         uuid.New(),
+			FamilyID:   familyID,
+			CategoryID: uuid.New(),
+			UserID:     uuid.New(),
+			Type:       transaction.TypeExpense,
+			Amount:     amount(i),
+			Date:       currentMonth.AddDate(0, -i, 0).AddDate(0, 0, 10),
+		})
+		if err != nil {
+			t.Fatalf("seed month -%d: %v", i, err)
+		}
+	}
+}
+
+func TestGenerateSpendingForecast_LengthMatchesHorizon(t *testing.T) {
+	repo := memory.NewTransactionRepository()
+	familyID := uuid.New()
+	seedMonthlyExpenses(t, repo, familyID, 18, func(int) float64 { return 500 })
+	svc := NewReportService(memory.NewReportRepository(), repo, nil, nil, nil)
+
+	forecast, err := svc.GenerateSpendingForecast(context.Background(), familyID, 6)
+	if err != nil {
+		t.Fatalf("GenerateSpendingForecast: %v", err)
+	}
+	if len(forecast) != 6 {
+		t.Fatalf("forecast length = %d, want 6", len(forecast))
+	}
+	for _, point := range forecast {
+		if point.PredictedExpenses < 0 {
+			t.Errorf("month %v predicted %v, forecasts must never be negative", point.Month, point.PredictedExpenses)
+		}
+		if point.PredictedExpenses != 500 {
+			t.Errorf("flat 500 history should forecast 500, got %v for %v", point.PredictedExpenses, point.Month)
+		}
+	}
+}
+
+func TestGenerateSpendingForecast_SeasonalMonthsWeighted(t *testing.T) {
+	repo := memory.NewTransactionRepository()
+	familyID := uuid.New()
+	// 18 months of history: 100/month except the same calendar month
+	// last year (offset 12), which spiked to 1100.
+	seedMonthlyExpenses(t, repo, familyID, 18, func(i int) float64 {
+		if i == 12 {
+			return 1100
+		}
+		return 100
+	})
+	svc := NewReportService(memory.NewReportRepository(), repo, nil, nil, nil)
+
+	forecast, err := svc.GenerateSpendingForecast(context.Background(), familyID, 1)
+	if err != nil {
+		t.Fatalf("GenerateSpendingForecast: %v", err)
+	}
+	point := forecast[0]
+	if point.Basis != "seasonal" {
+		t.Errorf("basis = %s, want seasonal (same month last year exists)", point.Basis)
+	}
+	// Trailing average over 12 observed months = (11*100 + 1100)/12.
+	trailing := (11*100.0 + 1100.0) / 12.0
+	want := 0.5*1100 + 0.5*trailing
+	if diff := point.PredictedExpenses - want; diff > 0.001 || diff < -0.001 {
+		t.Errorf("seasonal forecast = %v, want %v", point.PredictedExpenses, want)
+	}
+}
+
+func TestGenerateSpendingForecast_DegradesWithoutFullYear(t *testing.T) {
+	repo := memory.NewTransactionRepository()
+	familyID := uuid.New()
+	seedMonthlyExpenses(t, repo, familyID, 4, func(int) float64 { return 200 })
+	svc := NewReportService(memory.NewReportRepository(), repo, nil, nil, nil)
+
+	forecast, err := svc.GenerateSpendingForecast(context.Background(), familyID, 3)
+	if err != nil {
+		t.Fatalf("GenerateSpendingForecast: %v", err)
+	}
+	for _, point := range forecast {
+		if point.Basis != "trailing_average" {
+			t.Errorf("basis = %s, want trailing_average without a year of data", point.Basis)
+		}
+		if point.PredictedExpenses != 200 {
+			t.Errorf("forecast = %v, want flat 200 average", point.PredictedExpenses)
+		}
+	}
+}
+
+func TestGenerateSpendingForecast_RejectsNonPositiveHorizon(t *testing.T) {
+	svc := NewReportService(memory.NewReportRepository(), memory.NewTransactionRepository(), nil, nil, nil)
+	if _, err := svc.GenerateSpendingForecast(context.Background(), uuid.New(), 0); !errors.Is(err, ErrInvalidForecastHorizon) {
+		t.Errorf("err = %v, want ErrInvalidForecastHorizon", err)
+	}
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+// InsightThresholds tune when GenerateFinancialInsights fires a
+// recommendation. They are injectable so tests can assert a specific
+// recommendation for a crafted dataset.
+type InsightThresholds struct {
+	// CategoryGrowthPercent is the month-over-month spending growth (in
+	// percent) above which a category is flagged.
+	CategoryGrowthPercent float64
+	// SavingsRateFloor is the minimum acceptable savings rate for the
+	// current month, as a fraction of income (0.1 = 10%).
+	SavingsRateFloor float64
+}
+
+// DefaultInsightThresholds returns the production defaults.
+func DefaultInsightThresholds() InsightThresholds {
+	return InsightThresholds{
+		CategoryGrowthPercent: 30,
+		SavingsRateFloor:      0.1,
+	}
+}
+
+// SetInsightThresholds overrides the insight tuning.
+func (s *ReportService) SetInsightThresholds(t InsightThresholds) {
+	s.insightThresholds = t
+}
+
+// GenerateFinancialInsights analyzes the family's recent activity and
+// returns actionable recommendations: categories growing faster than the
+// configured month-over-month threshold, a savings rate below the floor,
+// and active budgets that are currently overspent.
+func (s *ReportService) GenerateFinancialInsights(ctx context.Context, familyID uuid.UUID) ([]dto.RecommendationDTO, error) {
+	now := time.Now()
+	currentStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	previousStart := currentStart.AddDate(0, -1, 0)
+
+	transactions, err := s.getTransactionsForPeriod(ctx, familyID, previousStart, now, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("generate financial insights: %w", err)
+	}
+
+	var currentIncome, currentExpenses float64
+	currentByCategory := make(map[uuid.UUID]float64)
+	previousByCategory := make(map[uuid.UUID]float64)
+	for _, t := range transactions {
+		inCurrent := !t.Date.Before(currentStart)
+		switch t.Type {
+		case transaction.TypeIncome:
+			if inCurrent {
+				currentIncome += t.Amount
+			}
+		case transaction.TypeExpense:
+			if inCurrent {
+				currentExpenses += t.Amount
+				currentByCategory[t.CategoryID] += t.Amount
+			} else {
+				previousByCategory[t.CategoryID] += t.Amount
+			}
+		}
+	}
+
+	var recommendations []dto.RecommendationDTO
+	recommendations = append(recommendations, s.categoryGrowthInsights(currentByCategory, previousByCategory)...)
+
+	if currentIncome > 0 {
+		rate := (currentIncome - currentExpenses) / currentIncome
+		if rate < s.insightThresholds.SavingsRateFloor {
+			recommendations = append(recommendations, dto.RecommendationDTO{
+				Type:     dto.RecommendationLowSavingsRate,
+				Severity: dto.SeverityWarning,
+				Title:    "Savings rate below target",
+				Action: fmt.Sprintf(
+					"This month's savings rate is %.0f%%, below your %.0f%% target. Review discretionary spending.",
+					rate*100, s.insightThresholds.SavingsRateFloor*100,
+				),
+			})
+		}
+	}
+
+	overspent, err := s.overspentBudgetInsights(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+	recommendations = append(recommendations, overspent...)
+	return recommendations, nil
+}
+
+func (s *ReportService) categoryGrowthInsights(current, previous map[uuid.UUID]float64) []dto.RecommendationDTO {
+	var out []dto.RecommendationDTO
+	for categoryID, amount := range current {
+		prior, ok := previous[categoryID]
+		if !ok || prior <= 0 {
+			continue // no baseline to compare against
+		}
+		growth := (amount - prior) / prior * 100
+		if growth <= s.insightThresholds.CategoryGrowthPercent {
+			continue
+		}
+		categoryID := categoryID
+		out = append(out, dto.RecommendationDTO{
+			Type:       dto.RecommendationCategoryGrowth,
+			Severity:   dto.SeverityWarning,
+			CategoryID: &categoryID,
+			Title:      "Category spending is accelerating",
+			Action: fmt.Sprintf(
+				"Spending in this category grew %.0f%% month-over-month (%.2f → %.2f). Check for new recurring costs.",
+				growth, prior, amount,
+			),
+		})
+	}
+	return out
+}
+
+func (s *ReportService) overspentBudgetInsights(ctx context.Context, familyID uuid.UUID) ([]dto.RecommendationDTO, error) {
+	if s.budgetRepo == nil {
+		return nil, nil
+	}
+	budgets, err := s.budgetRepo.GetActiveBudgets(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load budgets for insights: %w", err)
+	}
+	var out []dto.RecommendationDTO
+	for _, b := range budgets {
+		if !b.IsOverBudget() {
+			continue
+		}
+		b := b
+		out = append(out, dto.RecommendationDTO{
+			Type:     dto.RecommendationBudgetOverspent,
+			Severity: dto.SeverityCritical,
+			BudgetID: &b.ID,
+			Title:    fmt.Sprintf("Budget %q is overspent", b.Name),
+			Action: fmt.Sprintf(
+				"Spent %.2f of a %.2f limit (%.0f%%). Raise the budget or cut back for the rest of the period.",
+				b.Spent, b.Amount, b.GetSpentPercentage(),
+			),
+		})
+	}
+	return out, nil
+}
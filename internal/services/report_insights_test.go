@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+func seedInsightTransaction(t *testing.T, repo *memory.TransactionRepository, familyID, categoryID uuid.UUID, txType transaction.Type, amount float64, date time.Time) {
+	t.Helper()
+	err := repo.Create(context.Background(), &transaction.Transaction{
+		ID: uuid.New(), FamilyID: familyID, CategoryID: categoryID,
+		UserID: uuid.New(), Type: txType, Amount: amount, Date: date,
+	})
+	if err != nil {
+		t.Fatalf("seed transaction: %v", err)
+	}
+}
+
+func insightsByType(recs []dto.RecommendationDTO) map[string]dto.RecommendationDTO {
+	out := make(map[string]dto.RecommendationDTO)
+	for _, r := range recs {
+		out[r.Type] = r
+	}
+	return out
+}
+
+func TestGenerateFinancialInsights(t *testing.T) {
+	transactions := memory.NewTransactionRepository()
+	budgets := memory.NewBudgetRepository()
+	svc := NewReportService(memory.NewReportRepository(), transactions, budgets, nil, nil)
+	svc.SetInsightThresholds(InsightThresholds{CategoryGrowthPercent: 50, SavingsRateFloor: 0.2})
+
+	familyID := uuid.New()
+	dining := uuid.New()
+	now := time.Now().UTC()
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	previousMonth := currentMonth.AddDate(0, -1, 0)
+
+	// Dining doubled month-over-month: 100 -> 200.
+	seedInsightTransaction(t, transactions, familyID, dining, transaction.TypeExpense, 100, previousMonth.AddDate(0, 0, 5))
+	seedInsightTransaction(t, transactions, familyID, dining, transaction.TypeExpense, 200, currentMonth)
+	// Income 1000 this month with 900+ spent => savings rate below 20%.
+	seedInsightTransaction(t, transactions, familyID, uuid.New(), transaction.TypeIncome, 1000, currentMonth)
+	seedInsightTransaction(t, transactions, familyID, uuid.New(), transaction.TypeExpense, 750, currentMonth)
+
+	// An active budget already blown past its cap.
+	overspent := &budget.Budget{
+		ID: uuid.New(), Name: "Groceries", Amount: 300, Spent: 400,
+		FamilyID: familyID, IsActive: true,
+		StartDate: currentMonth, EndDate: currentMonth.AddDate(0, 1, 0),
+	}
+	if err := budgets.Create(context.Background(), overspent); err != nil {
+		t.Fatalf("seed budget: %v", err)
+	}
+
+	recs, err := svc.GenerateFinancialInsights(context.Background(), familyID)
+	if err != nil {
+		t.Fatalf("GenerateFinancialInsights: %v", err)
+	}
+	byType := insightsByType(recs)
+
+	growth, ok := byType[dto.RecommendationCategoryGrowth]
+	if !ok {
+		t.Fatal("expected a category-growth recommendation for dining")
+	}
+	if growth.CategoryID == nil || *growth.CategoryID != dining {
+		t.Errorf("growth recommendation category = %v, want dining", growth.CategoryID)
+	}
+	if growth.Severity != dto.SeverityWarning {
+		t.Errorf("growth severity = %s, want warning", growth.Severity)
+	}
+
+	savings, ok := byType[dto.RecommendationLowSavingsRate]
+	if !ok {
+		t.Fatal("expected a low-savings-rate recommendation")
+	}
+	if savings.Action == "" {
+		t.Error("savings recommendation needs a human-readable action")
+	}
+
+	over, ok := byType[dto.RecommendationBudgetOverspent]
+	if !ok {
+		t.Fatal("expected an overspent-budget recommendation")
+	}
+	if over.Severity != dto.SeverityCritical || over.BudgetID == nil || *over.BudgetID != overspent.ID {
+		t.Errorf("overspent recommendation = %+v, want critical for %s", over, overspent.ID)
+	}
+}
+
+func TestGenerateFinancialInsights_QuietMonthHasNone(t *testing.T) {
+	transactions := memory.NewTransactionRepository()
+	svc := NewReportService(memory.NewReportRepository(), transactions, memory.NewBudgetRepository(), nil, nil)
+
+	familyID := uuid.New()
+	now := time.Now().UTC()
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	seedInsightTransaction(t, transactions, familyID, uuid.New(), transaction.TypeIncome, 1000, currentMonth)
+	seedInsightTransaction(t, transactions, familyID, uuid.New(), transaction.TypeExpense, 200, currentMonth)
+
+	recs, err := svc.GenerateFinancialInsights(context.Background(), familyID)
+	if err != nil {
+		t.Fatalf("GenerateFinancialInsights: %v", err)
+	}
+	if len(recs) != 0 {
+		t.Errorf("healthy month produced %d recommendations: %+v", len(recs), recs)
+	}
+}
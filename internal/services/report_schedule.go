@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrUnknownScheduleFrequency is returned when a schedule request
+// carries a frequency outside daily/weekly/monthly.
+var ErrUnknownScheduleFrequency = fmt.Errorf("unknown schedule frequency")
+
+// ScheduleReport persists a recurring report definition. The first run
+// is one frequency interval from now so the initial window has data.
+func (s *ReportService) ScheduleReport(ctx context.Context, req dto.ScheduleReportRequestDTO) (*report.Schedule, error) {
+	if !req.Frequency.Valid() {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownScheduleFrequency, req.Frequency)
+	}
+	now := time.Now()
+	schedule := &report.Schedule{
+		ID:         uuid.New(),
+		Name:       req.Name,
+		ReportType: req.Type,
+		Period:     req.Period,
+		Frequency:  req.Frequency,
+		FamilyID:   req.FamilyID,
+		UserID:     req.UserID,
+		NextRun:    req.Frequency.Next(now),
+		IsActive:   true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := s.scheduleRepo.Create(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("create schedule: %w", err)
+	}
+	return schedule, nil
+}
+
+// GetScheduledReports lists a family's report schedules.
+func (s *ReportService) GetScheduledReports(ctx context.Context, familyID uuid.UUID) ([]*report.Schedule, error) {
+	return s.scheduleRepo.GetByFamilyID(ctx, familyID)
+}
+
+// UpdateScheduledReport persists changes to a schedule, re-validating
+// the frequency.
+func (s *ReportService) UpdateScheduledReport(ctx context.Context, schedule *report.Schedule) error {
+	if !schedule.Frequency.Valid() {
+		return fmt.Errorf("%w: %q", ErrUnknownScheduleFrequency, schedule.Frequency)
+	}
+	schedule.UpdatedAt = time.Now()
+	return s.scheduleRepo.Update(ctx, schedule)
+}
+
+// DeleteScheduledReport removes a schedule. Reports it already generated
+// are kept.
+func (s *ReportService) DeleteScheduledReport(ctx context.Context, id uuid.UUID) error {
+	return s.scheduleRepo.Delete(ctx, id)
+}
+
+// ExecuteScheduledReport generates and saves the report configured by a
+// schedule for the window ending at its NextRun, then advances NextRun
+// by one frequency interval.
+func (s *ReportService) ExecuteScheduledReport(ctx context.Context, scheduleID uuid.UUID) (*report.Report, error) {
+	schedule, err := s.scheduleRepo.GetByID(ctx, scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("load schedule: %w", err)
+	}
+	defer s.observeGeneration(schedule.ReportType, time.Now())
+
+	end := schedule.NextRun
+	start := schedule.Frequency.WindowStart(end)
+	data, err := s.buildReportData(ctx, schedule.FamilyID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("execute schedule %s: %w", scheduleID, err)
+	}
+
+	generated := &report.Report{
+		ID:          uuid.New(),
+		Name:        schedule.Name,
+		Type:        schedule.ReportType,
+		Period:      schedule.Period,
+		FamilyID:    schedule.FamilyID,
+		UserID:      schedule.UserID,
+		StartDate:   start,
+		EndDate:     end,
+		Data:        data,
+		GeneratedAt: time.Now(),
+	}
+	if err := s.reportRepo.Create(ctx, generated); err != nil {
+		return nil, fmt.Errorf("save generated report: %w", err)
+	}
+
+	schedule.NextRun = schedule.Frequency.Next(schedule.NextRun)
+	schedule.UpdatedAt = time.Now()
+	if err := s.scheduleRepo.Update(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("advance schedule %s: %w", scheduleID, err)
+	}
+	return generated, nil
+}
+
+// RegenerateReport re-runs a saved report's window against current data
+// and updates the report in place with a fresh GeneratedAt. The report
+// keeps its identity, so links stay valid while conditional-GET
+// validators roll over to the new content.
+func (s *ReportService) RegenerateReport(ctx context.Context, reportID uuid.UUID) (*report.Report, error) {
+	saved, err := s.reportRepo.GetByID(ctx, reportID)
+	if err != nil {
+		return nil, fmt.Errorf("load report: %w", err)
+	}
+	defer s.observeGeneration(saved.Type, time.Now())
+
+	data, err := s.buildReportData(ctx, saved.FamilyID, saved.StartDate, saved.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("regenerate report %s: %w", reportID, err)
+	}
+	saved.Data = data
+	saved.GeneratedAt = time.Now()
+	if err := s.reportRepo.Update(ctx, saved); err != nil {
+		return nil, fmt.Errorf("save regenerated report %s: %w", reportID, err)
+	}
+	return saved, nil
+}
+
+// GenerateReportData computes a report payload for an arbitrary window
+// without persisting anything, for API consumers that want the numbers
+// synchronously.
+func (s *ReportService) GenerateReportData(ctx context.Context, familyID uuid.UUID, start, end time.Time) (report.Data, error) {
+	return s.buildReportData(ctx, familyID, start, end)
+}
+
+// buildReportData computes the stored payload for a saved report window.
+// Totals come from repository-side aggregates, so generation never loads
+// the window's transactions just to sum them.
+func (s *ReportService) buildReportData(ctx context.Context, familyID uuid.UUID, start, end time.Time) (report.Data, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "report.build_data")
+	defer span.End()
+	span.SetAttribute("family.id", hashedID(familyID))
+
+	_, incomeSpan := s.tracer.StartSpan(ctx, "repository.get_total_by_family_and_date_range")
+	income, err := s.transactionRepo.GetTotalByFamilyAndDateRange(ctx, familyID, start, end, transaction.TypeIncome)
+	incomeSpan.End()
+	if err != nil {
+		return report.Data{}, fmt.Errorf("aggregate income: %w", err)
+	}
+	_, expensesSpan := s.tracer.StartSpan(ctx, "repository.get_total_by_family_and_date_range")
+	expenses, err := s.transactionRepo.GetTotalByFamilyAndDateRange(ctx, familyID, start, end, transaction.TypeExpense)
+	expensesSpan.End()
+	if err != nil {
+		return report.Data{}, fmt.Errorf("aggregate expenses: %w", err)
+	}
+
+	var data report.Data
+	data.TotalIncome = RoundAmount(income)
+	data.TotalExpenses = RoundAmount(expenses)
+	data.NetIncome = RoundAmount(data.TotalIncome - data.TotalExpenses)
+
+	breakdown, err := s.categoryBreakdown(ctx, familyID, start, end, data.TotalExpenses)
+	if err != nil {
+		return report.Data{}, err
+	}
+	data.CategoryBreakdown = breakdown
+	return data, nil
+}
+
+// categoryBreakdown aggregates per-category expense totals repository-
+// side and resolves category names in one batch, instead of loading all
+// transactions and looking categories up one by one.
+func (s *ReportService) categoryBreakdown(ctx context.Context, familyID uuid.UUID, start, end time.Time, totalExpenses float64) ([]report.CategoryBreakdownItem, error) {
+	expenseType := transaction.TypeExpense
+	totals, err := s.transactionRepo.GetCategoryTotals(ctx, transaction.Filter{
+		FamilyID: familyID,
+		Type:     &expenseType,
+		DateFrom: &start,
+		DateTo:   &end,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("aggregate category totals: %w", err)
+	}
+	if len(totals) == 0 {
+		return nil, nil
+	}
+
+	names, err := s.categoryNames(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+	breakdown := make([]report.CategoryBreakdownItem, 0, len(totals))
+	for _, total := range totals {
+		item := report.CategoryBreakdownItem{
+			CategoryID:   total.CategoryID,
+			CategoryName: names[total.CategoryID],
+			Amount:       total.Amount,
+			Count:        total.Count,
+		}
+		if totalExpenses > 0 {
+			item.Percentage = RoundAmount(total.Amount / totalExpenses * 100)
+		}
+		breakdown = append(breakdown, item)
+	}
+	return breakdown, nil
+}
+
+// categoryNames loads a family's categories once and indexes them by ID.
+// Without a category repository every name stays empty.
+func (s *ReportService) categoryNames(ctx context.Context, familyID uuid.UUID) (map[uuid.UUID]string, error) {
+	if s.categoryRepo == nil {
+		return nil, nil
+	}
+	categories, err := s.categoryRepo.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load categories for breakdown: %w", err)
+	}
+	names := make(map[uuid.UUID]string, len(categories))
+	for _, c := range categories {
+		names[c.ID] = c.Name
+	}
+	return names, nil
+}
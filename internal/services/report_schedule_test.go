@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestScheduleReport_RejectsUnknownFrequency(t *testing.T) {
+	svc := NewReportService(memory.NewReportRepository(), memory.NewTransactionRepository(), nil, memory.NewScheduleRepository(), nil)
+
+	_, err := svc.ScheduleReport(context.Background(), dto.ScheduleReportRequestDTO{
+		Name:      "Nightly",
+		Type:      report.TypeExpenses,
+		Period:    report.PeriodDaily,
+		Frequency: "hourly",
+		FamilyID:  uuid.New(),
+		UserID:    uuid.New(),
+	})
+	if !errors.Is(err, ErrUnknownScheduleFrequency) {
+		t.Fatalf("err = %v, want ErrUnknownScheduleFrequency", err)
+	}
+}
+
+func TestScheduledReport_CreateListExecuteAdvance(t *testing.T) {
+	ctx := context.Background()
+	reports := memory.NewReportRepository()
+	transactions := memory.NewTransactionRepository()
+	schedules := memory.NewScheduleRepository()
+	svc := NewReportService(reports, transactions, nil, schedules, nil)
+
+	familyID, userID := uuid.New(), uuid.New()
+	created, err := svc.ScheduleReport(ctx, dto.ScheduleReportRequestDTO{
+		Name:      "Weekly expenses",
+		Type:      report.TypeExpenses,
+		Period:    report.PeriodWeekly,
+		Frequency: report.FrequencyWeekly,
+		FamilyID:  familyID,
+		UserID:    userID,
+	})
+	if err != nil {
+		t.Fatalf("ScheduleReport: %v", err)
+	}
+
+	listed, err := svc.GetScheduledReports(ctx, familyID)
+	if err != nil || len(listed) != 1 || listed[0].ID != created.ID {
+		t.Fatalf("GetScheduledReports = %+v, %v; want the created schedule", listed, err)
+	}
+
+	firstRun := created.NextRun
+	generated, err := svc.ExecuteScheduledReport(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("ExecuteScheduledReport: %v", err)
+	}
+	if generated.FamilyID != familyID || generated.UserID != userID {
+		t.Errorf("generated report ownership = %s/%s, want %s/%s",
+			generated.FamilyID, generated.UserID, familyID, userID)
+	}
+	if !generated.EndDate.Equal(firstRun) {
+		t.Errorf("generated window end = %v, want schedule NextRun %v", generated.EndDate, firstRun)
+	}
+
+	saved, err := reports.GetByFamilyID(ctx, familyID)
+	if err != nil || len(saved) != 1 {
+		t.Errorf("generated report not persisted: %d, %v", len(saved), err)
+	}
+
+	after, err := schedules.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("reload schedule: %v", err)
+	}
+	wantNext := firstRun.AddDate(0, 0, 7)
+	if !after.NextRun.Equal(wantNext) {
+		t.Errorf("NextRun = %v, want advanced to %v", after.NextRun, wantNext)
+	}
+
+	if err := svc.DeleteScheduledReport(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteScheduledReport: %v", err)
+	}
+	if _, err := schedules.GetByID(ctx, created.ID); !errors.Is(err, report.ErrScheduleNotFound) {
+		t.Errorf("schedule should be gone, err = %v", err)
+	}
+}
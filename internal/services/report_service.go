@@ -0,0 +1,361 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/preferences"
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/metrics"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/internal/tracing"
+	"family-budget-service/pkg/uuid"
+)
+
+// reportTransactionQueryLimit bounds a single transaction query issued
+// during report generation.
+const reportTransactionQueryLimit = 1000
+
+// DefaultMaxReportSpanDays is the report window span above which
+// generation is considered accidentally broad and a warning is raised.
+const DefaultMaxReportSpanDays = 730
+
+// ReportService generates and persists reports.
+type ReportService struct {
+	reportRepo      report.Repository
+	transactionRepo transaction.Repository
+	budgetRepo      budget.Repository
+	scheduleRepo    report.ScheduleRepository
+	userRepo        user.Repository
+
+	// preferencesRepo backs per-family report settings like excluding
+	// reimbursed expenses; nil until wired via SetPreferencesRepository.
+	preferencesRepo preferences.Repository
+
+	// categoryRepo resolves category names for report breakdowns; nil
+	// leaves breakdown names empty.
+	categoryRepo category.Repository
+
+	// generationDuration and exportDuration time report builds and
+	// exports; nil until wired via SetMetrics.
+	generationDuration *metrics.Histogram
+	exportDuration     *metrics.Histogram
+
+	// tracer emits spans around report generation; nil (the default)
+	// traces nothing.
+	tracer *tracing.Tracer
+
+	maxReportSpanDays int
+	insightThresholds InsightThresholds
+}
+
+// SetMetrics registers the report timing histograms on the given
+// registry and starts recording into them.
+func (s *ReportService) SetMetrics(registry *metrics.Registry) {
+	s.generationDuration = registry.Histogram(
+		"report_generation_duration_seconds", "Time to generate a report, by report type.", "type", nil)
+	s.exportDuration = registry.Histogram(
+		"report_export_duration_seconds", "Time to export a report, by format.", "format", nil)
+}
+
+// SetTracer wires span emission for report generation.
+func (s *ReportService) SetTracer(tracer *tracing.Tracer) {
+	s.tracer = tracer
+}
+
+// hashedID obfuscates an identifier for span attributes: traces leave
+// the trust boundary, raw family IDs should not.
+func hashedID(id uuid.UUID) string {
+	sum := sha256.Sum256([]byte(id.String()))
+	return hex.EncodeToString(sum[:8])
+}
+
+// observeGeneration records one report build's duration by type when
+// metrics are wired.
+func (s *ReportService) observeGeneration(reportType report.Type, start time.Time) {
+	if s.generationDuration != nil {
+		s.generationDuration.Observe(string(reportType), time.Since(start).Seconds())
+	}
+}
+
+// observeExport records one export's duration by format when metrics
+// are wired.
+func (s *ReportService) observeExport(format string, start time.Time) {
+	if s.exportDuration != nil {
+		s.exportDuration.Observe(format, time.Since(start).Seconds())
+	}
+}
+
+// SetPreferencesRepository wires the family preferences that tune report
+// generation. Without it every preference keeps its default.
+func (s *ReportService) SetPreferencesRepository(preferencesRepo preferences.Repository) {
+	s.preferencesRepo = preferencesRepo
+}
+
+// SetCategoryRepository wires category metadata for report breakdowns.
+func (s *ReportService) SetCategoryRepository(categoryRepo category.Repository) {
+	s.categoryRepo = categoryRepo
+}
+
+// NewReportService builds a ReportService. budgetRepo, scheduleRepo and
+// userRepo may be nil for callers that never touch budget insights,
+// scheduled reports or per-user reports.
+func NewReportService(
+	reportRepo report.Repository,
+	transactionRepo transaction.Repository,
+	budgetRepo budget.Repository,
+	scheduleRepo report.ScheduleRepository,
+	userRepo user.Repository,
+) *ReportService {
+	return &ReportService{
+		reportRepo:        reportRepo,
+		transactionRepo:   transactionRepo,
+		budgetRepo:        budgetRepo,
+		scheduleRepo:      scheduleRepo,
+		userRepo:          userRepo,
+		maxReportSpanDays: DefaultMaxReportSpanDays,
+		insightThresholds: DefaultInsightThresholds(),
+	}
+}
+
+// SetMaxReportSpanDays overrides the breadth soft limit. Non-positive
+// values restore the default.
+func (s *ReportService) SetMaxReportSpanDays(days int) {
+	if days <= 0 {
+		days = DefaultMaxReportSpanDays
+	}
+	s.maxReportSpanDays = days
+}
+
+// CheckReportBreadth returns a warning when the requested window spans
+// more days than the configured soft limit, so the report form can ask
+// for confirmation before running a heavy query. It returns nil when the
+// request is within bounds.
+func (s *ReportService) CheckReportBreadth(req dto.ReportRequestDTO) *dto.ReportBreadthWarningDTO {
+	spanDays := int(req.EndDate.Sub(req.StartDate).Hours() / 24)
+	if spanDays <= s.maxReportSpanDays {
+		return nil
+	}
+	return &dto.ReportBreadthWarningDTO{
+		SpanDays:    spanDays,
+		MaxSpanDays: s.maxReportSpanDays,
+		Message: fmt.Sprintf(
+			"report covers %d days, above the %d-day limit; generation may be slow",
+			spanDays, s.maxReportSpanDays,
+		),
+	}
+}
+
+// GetReportByID returns a saved report.
+func (s *ReportService) GetReportByID(ctx context.Context, id uuid.UUID) (*report.Report, error) {
+	return s.reportRepo.GetByID(ctx, id)
+}
+
+// GetReportsByFamily returns every saved report of a family.
+func (s *ReportService) GetReportsByFamily(ctx context.Context, familyID uuid.UUID) ([]*report.Report, error) {
+	return s.reportRepo.GetByFamilyID(ctx, familyID)
+}
+
+// DefaultReportPageSize is the reports-list page size used when a list
+// request doesn't specify one.
+const DefaultReportPageSize = 20
+
+// ReportListOptions narrows and pages the saved-reports list. The zero
+// value lists the first page of everything, newest first.
+type ReportListOptions struct {
+	// Type keeps only reports of one type; empty keeps all.
+	Type report.Type
+	// GeneratedFrom and GeneratedTo bound the generation time; nil bounds
+	// are open.
+	GeneratedFrom *time.Time
+	GeneratedTo   *time.Time
+	// Limit and Offset page the result. A non-positive limit falls back
+	// to DefaultReportPageSize.
+	Limit  int
+	Offset int
+}
+
+// ReportPage is one page of a family's saved reports plus the total
+// match count across all pages, for pager controls.
+type ReportPage struct {
+	Reports []*report.Report
+	Total   int
+}
+
+// ListReports returns a filtered, paged view of a family's saved
+// reports, newest first.
+func (s *ReportService) ListReports(ctx context.Context, familyID uuid.UUID, opts ReportListOptions) (*ReportPage, error) {
+	all, err := s.reportRepo.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("list reports: %w", err)
+	}
+
+	matched := make([]*report.Report, 0, len(all))
+	for _, r := range all {
+		if opts.Type != "" && r.Type != opts.Type {
+			continue
+		}
+		if opts.GeneratedFrom != nil && r.GeneratedAt.Before(*opts.GeneratedFrom) {
+			continue
+		}
+		if opts.GeneratedTo != nil && r.GeneratedAt.After(*opts.GeneratedTo) {
+			continue
+		}
+		matched = append(matched, r)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].GeneratedAt.After(matched[j].GeneratedAt)
+	})
+
+	page := &ReportPage{Total: len(matched)}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultReportPageSize
+	}
+	offset := opts.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(matched) {
+		return page, nil
+	}
+	end := offset + limit
+	if end > len(matched) {
+		end = len(matched)
+	}
+	page.Reports = matched[offset:end]
+	return page, nil
+}
+
+// GetReportsByUserID returns every saved report authored by a user.
+func (s *ReportService) GetReportsByUserID(ctx context.Context, userID uuid.UUID) ([]*report.Report, error) {
+	return s.reportRepo.GetByUserID(ctx, userID)
+}
+
+// GenerateExpenseReport computes an expense report for the requested
+// window, honoring the request's category and user filters.
+func (s *ReportService) GenerateExpenseReport(ctx context.Context, req dto.ReportRequestDTO) (*dto.ExpenseReportDTO, error) {
+	defer s.observeGeneration(report.TypeExpenses, time.Now())
+	ctx, span := s.tracer.StartSpan(ctx, "report.generate")
+	defer span.End()
+	span.SetAttribute("report.type", string(report.TypeExpenses))
+	span.SetAttribute("family.id", hashedID(req.FamilyID))
+
+	expenseType := transaction.TypeExpense
+	transactions, err := s.getTransactionsForPeriod(ctx, req.FamilyID, req.StartDate, req.EndDate, &expenseType, req.Filters)
+	if err != nil {
+		return nil, fmt.Errorf("generate expense report: %w", err)
+	}
+	span.SetAttribute("transaction.count", strconv.Itoa(len(transactions)))
+
+	result := &dto.ExpenseReportDTO{
+		FamilyID:  req.FamilyID,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	}
+	excludeReimbursed, err := s.excludeReimbursed(ctx, req.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	// One memoizing loader per generation: each distinct category is
+	// fetched at most once no matter how many rows reference it.
+	loader := newMetadataLoader(s.categoryRepo, s.userRepo)
+	byCategory := make(map[uuid.UUID]*dto.CategoryTotalDTO)
+	for _, t := range transactions {
+		if excludeReimbursed && t.ReimbursedBy != nil {
+			continue
+		}
+		result.TotalExpenses = AddAmounts(result.TotalExpenses, t.Amount)
+		// Split transactions contribute each portion to its own
+		// category instead of the whole amount to one.
+		for _, allocation := range t.Allocations() {
+			entry, ok := byCategory[allocation.CategoryID]
+			if !ok {
+				name, err := loader.CategoryName(ctx, allocation.CategoryID)
+				if err != nil {
+					return nil, err
+				}
+				entry = &dto.CategoryTotalDTO{CategoryID: allocation.CategoryID, Name: name}
+				byCategory[allocation.CategoryID] = entry
+			}
+			entry.Amount = AddAmounts(entry.Amount, allocation.Amount)
+			entry.Count++
+		}
+		result.Transactions = append(result.Transactions, dto.TransactionSummaryDTO{
+			ID:          t.ID,
+			Amount:      t.Amount,
+			Description: t.Description,
+			Date:        t.Date,
+		})
+	}
+	for _, entry := range byCategory {
+		result.ByCategory = append(result.ByCategory, *entry)
+	}
+	return result, nil
+}
+
+// excludeReimbursed reads the family's exclusion toggle for reimbursed
+// expenses. Missing preferences mean the default: count everything.
+func (s *ReportService) excludeReimbursed(ctx context.Context, familyID uuid.UUID) (bool, error) {
+	if s.preferencesRepo == nil {
+		return false, nil
+	}
+	p, err := s.preferencesRepo.GetByFamilyID(ctx, familyID)
+	if errors.Is(err, preferences.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("load preferences: %w", err)
+	}
+	return p.ExcludeReimbursed, nil
+}
+
+// getTransactionsForPeriod loads the transactions feeding a report. All
+// category IDs and user IDs from the report filters are applied, not
+// just the first of each. The query is paginated internally in pages of
+// reportTransactionQueryLimit so heavy families are never truncated.
+func (s *ReportService) getTransactionsForPeriod(
+	ctx context.Context,
+	familyID uuid.UUID,
+	startDate, endDate time.Time,
+	txType *transaction.Type,
+	filters *dto.ReportFilterDTO,
+) ([]*transaction.Transaction, error) {
+	filter := dto.TransactionFilterDTO{
+		FamilyID: familyID,
+		Type:     txType,
+		DateFrom: &startDate,
+		DateTo:   &endDate,
+		Limit:    reportTransactionQueryLimit,
+	}
+	if filters != nil {
+		filter.CategoryIDs = filters.CategoryIDs
+		filter.UserIDs = filters.UserIDs
+		filter.AccountID = filters.AccountID
+	}
+
+	var all []*transaction.Transaction
+	for offset := 0; ; offset += reportTransactionQueryLimit {
+		filter.Offset = offset
+		_, querySpan := s.tracer.StartSpan(ctx, "repository.get_by_filter")
+		page, err := s.transactionRepo.GetByFilter(ctx, filter.ToDomainFilter())
+		querySpan.End()
+		if err != nil {
+			return nil, fmt.Errorf("load transactions for period (offset %d): %w", offset, err)
+		}
+		all = append(all, page...)
+		if len(page) < reportTransactionQueryLimit {
+			return all, nil
+		}
+	}
+}
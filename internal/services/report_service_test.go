@@ -0,0 +1,394 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/metrics"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/internal/tracing"
+	"family-budget-service/pkg/uuid"
+)
+
+// fakeTransactionRepo is a slice-backed transaction.Repository using the
+// shared Filter.Matches semantics.
+type fakeTransactionRepo struct {
+	transactions []*transaction.Transaction
+}
+
+func (f *fakeTransactionRepo) Create(_ context.Context, t *transaction.Transaction) error {
+	f.transactions = append(f.transactions, t)
+	return nil
+}
+
+func (f *fakeTransactionRepo) GetByID(_ context.Context, id uuid.UUID) (*transaction.Transaction, error) {
+	for _, t := range f.transactions {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return nil, transaction.ErrNotFound
+}
+
+func (f *fakeTransactionRepo) GetByFamilyID(_ context.Context, familyID uuid.UUID) ([]*transaction.Transaction, error) {
+	var out []*transaction.Transaction
+	for _, t := range f.transactions {
+		if t.FamilyID == familyID {
+			out = append(out, t)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeTransactionRepo) GetByFilter(_ context.Context, filter transaction.Filter) ([]*transaction.Transaction, error) {
+	var out []*transaction.Transaction
+	for _, t := range f.transactions {
+		if filter.Matches(t) {
+			out = append(out, t)
+		}
+	}
+	if filter.Offset > 0 {
+		if filter.Offset >= len(out) {
+			return nil, nil
+		}
+		out = out[filter.Offset:]
+	}
+	if filter.Limit > 0 && len(out) > filter.Limit {
+		out = out[:filter.Limit]
+	}
+	return out, nil
+}
+
+func (f *fakeTransactionRepo) GetFamilyStats(ctx context.Context, familyID uuid.UUID) (*transaction.FamilyStats, error) {
+	transactions, err := f.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+	return transaction.ComputeFamilyStats(transactions), nil
+}
+
+func (f *fakeTransactionRepo) GetTotalByFamilyAndDateRange(ctx context.Context, familyID uuid.UUID, start, end time.Time, txType transaction.Type) (float64, error) {
+	transactions, err := f.GetByFilter(ctx, transaction.Filter{
+		FamilyID: familyID,
+		Type:     &txType,
+		DateFrom: &start,
+		DateTo:   &end,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var total float64
+	for _, t := range transactions {
+		total += t.Amount
+	}
+	return total, nil
+}
+
+func (f *fakeTransactionRepo) GetMonthlyTotals(ctx context.Context, familyID uuid.UUID, year int) ([]transaction.MonthlyTotal, error) {
+	transactions, err := f.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+	return transaction.ComputeMonthlyTotals(transactions, year), nil
+}
+
+func (f *fakeTransactionRepo) GetCategoryTotals(ctx context.Context, filter transaction.Filter) ([]transaction.CategoryTotal, error) {
+	transactions, err := f.GetByFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return transaction.ComputeCategoryTotals(transactions), nil
+}
+
+func (f *fakeTransactionRepo) Update(_ context.Context, updated *transaction.Transaction) error {
+	for i, t := range f.transactions {
+		if t.ID == updated.ID {
+			f.transactions[i] = updated
+			return nil
+		}
+	}
+	return transaction.ErrNotFound
+}
+
+func (f *fakeTransactionRepo) Delete(_ context.Context, id uuid.UUID) error {
+	for i, t := range f.transactions {
+		if t.ID == id {
+			f.transactions = append(f.transactions[:i], f.transactions[i+1:]...)
+			return nil
+		}
+	}
+	return transaction.ErrNotFound
+}
+
+func expenseOn(familyID, categoryID uuid.UUID, amount float64, date time.Time) *transaction.Transaction {
+	return &transaction.Transaction{
+		ID:         uuid.New(),
+		Amount:     amount,
+		Type:       transaction.TypeExpense,
+		CategoryID: categoryID,
+		UserID:     uuid.New(),
+		FamilyID:   familyID,
+		Date:       date,
+	}
+}
+
+func TestGenerateExpenseReport_UsesAllCategoryFilters(t *testing.T) {
+	familyID := uuid.New()
+	food := uuid.New()
+	transport := uuid.New()
+	entertainment := uuid.New()
+	day := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	repo := &fakeTransactionRepo{transactions: []*transaction.Transaction{
+		expenseOn(familyID, food, 40, day),
+		expenseOn(familyID, transport, 15, day),
+		expenseOn(familyID, entertainment, 100, day),
+	}}
+	svc := NewReportService(newFakeReportRepo(), repo, nil, nil, nil)
+
+	result, err := svc.GenerateExpenseReport(context.Background(), dto.ReportRequestDTO{
+		FamilyID:  familyID,
+		StartDate: day.AddDate(0, 0, -1),
+		EndDate:   day.AddDate(0, 0, 1),
+		Filters:   &dto.ReportFilterDTO{CategoryIDs: []uuid.UUID{food, transport}},
+	})
+	if err != nil {
+		t.Fatalf("GenerateExpenseReport: %v", err)
+	}
+
+	if got, want := result.TotalExpenses, 55.0; got != want {
+		t.Errorf("TotalExpenses = %v, want %v (both filtered categories)", got, want)
+	}
+	if got, want := len(result.Transactions), 2; got != want {
+		t.Errorf("len(Transactions) = %d, want %d", got, want)
+	}
+	seen := map[uuid.UUID]bool{}
+	for _, c := range result.ByCategory {
+		seen[c.CategoryID] = true
+	}
+	if !seen[food] || !seen[transport] {
+		t.Errorf("ByCategory = %v, want both %s and %s", result.ByCategory, food, transport)
+	}
+	if seen[entertainment] {
+		t.Errorf("entertainment category must be filtered out")
+	}
+}
+
+func TestGenerateExpenseReport_SplitPortionsPerCategory(t *testing.T) {
+	familyID := uuid.New()
+	groceries := uuid.New()
+	household := uuid.New()
+	day := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	split := expenseOn(familyID, groceries, 100, day)
+	split.Splits = []transaction.Split{
+		{CategoryID: groceries, Amount: 70},
+		{CategoryID: household, Amount: 30},
+	}
+	repo := &fakeTransactionRepo{transactions: []*transaction.Transaction{split}}
+	svc := NewReportService(newFakeReportRepo(), repo, nil, nil, nil)
+
+	result, err := svc.GenerateExpenseReport(context.Background(), dto.ReportRequestDTO{
+		FamilyID:  familyID,
+		StartDate: day.AddDate(0, 0, -1),
+		EndDate:   day.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		t.Fatalf("GenerateExpenseReport: %v", err)
+	}
+	if result.TotalExpenses != 100 {
+		t.Errorf("TotalExpenses = %v, want the whole 100 once", result.TotalExpenses)
+	}
+	byID := map[uuid.UUID]float64{}
+	for _, c := range result.ByCategory {
+		byID[c.CategoryID] = c.Amount
+	}
+	if byID[groceries] != 70 || byID[household] != 30 {
+		t.Errorf("ByCategory = %v, want 70 groceries and 30 household portions", byID)
+	}
+}
+
+func TestTransactionFilterDTO_LegacySingleIDsStillApply(t *testing.T) {
+	familyID := uuid.New()
+	food := uuid.New()
+	transport := uuid.New()
+	day := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	repo := &fakeTransactionRepo{transactions: []*transaction.Transaction{
+		expenseOn(familyID, food, 40, day),
+		expenseOn(familyID, transport, 15, day),
+	}}
+	svc := NewTransactionService(repo, memory.NewUserRepository())
+
+	got, err := svc.GetTransactionsByFilter(context.Background(), dto.TransactionFilterDTO{
+		FamilyID:   familyID,
+		CategoryID: &food,
+	})
+	if err != nil {
+		t.Fatalf("GetTransactionsByFilter: %v", err)
+	}
+	if len(got) != 1 || got[0].CategoryID != food {
+		t.Errorf("legacy CategoryID filter returned %d rows, want the single food transaction", len(got))
+	}
+
+	// Single and plural forms combine instead of shadowing each other.
+	got, err = svc.GetTransactionsByFilter(context.Background(), dto.TransactionFilterDTO{
+		FamilyID:    familyID,
+		CategoryID:  &food,
+		CategoryIDs: []uuid.UUID{transport},
+	})
+	if err != nil {
+		t.Fatalf("GetTransactionsByFilter: %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("combined single+plural filter returned %d rows, want 2", len(got))
+	}
+}
+
+func TestGenerateExpenseReport_PaginatesPastQueryLimit(t *testing.T) {
+	familyID := uuid.New()
+	categoryID := uuid.New()
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	repo := &fakeTransactionRepo{}
+	const count = 1500
+	for i := 0; i < count; i++ {
+		repo.transactions = append(repo.transactions, expenseOn(familyID, categoryID, 1, day.Add(time.Duration(i)*time.Minute)))
+	}
+	svc := NewReportService(newFakeReportRepo(), repo, nil, nil, nil)
+
+	result, err := svc.GenerateExpenseReport(context.Background(), dto.ReportRequestDTO{
+		FamilyID:  familyID,
+		StartDate: day.AddDate(0, 0, -1),
+		EndDate:   day.AddDate(0, 1, 0),
+	})
+	if err != nil {
+		t.Fatalf("GenerateExpenseReport: %v", err)
+	}
+	if result.TotalExpenses != count {
+		t.Errorf("TotalExpenses = %v, want %v (all pages summed)", result.TotalExpenses, count)
+	}
+	if len(result.Transactions) != count {
+		t.Errorf("len(Transactions) = %d, want %d", len(result.Transactions), count)
+	}
+}
+
+func TestCheckReportBreadth(t *testing.T) {
+	svc := NewReportService(newFakeReportRepo(), &fakeTransactionRepo{}, nil, nil, nil)
+	now := time.Now()
+
+	if w := svc.CheckReportBreadth(dto.ReportRequestDTO{StartDate: now.AddDate(0, -1, 0), EndDate: now}); w != nil {
+		t.Errorf("one-month report warned: %+v", w)
+	}
+
+	w := svc.CheckReportBreadth(dto.ReportRequestDTO{StartDate: now.AddDate(-10, 0, 0), EndDate: now})
+	if w == nil {
+		t.Fatal("ten-year report should produce a breadth warning")
+	}
+	if w.MaxSpanDays != DefaultMaxReportSpanDays || w.SpanDays <= w.MaxSpanDays {
+		t.Errorf("warning = %+v, want span above default limit", w)
+	}
+
+	svc.SetMaxReportSpanDays(10)
+	if w := svc.CheckReportBreadth(dto.ReportRequestDTO{StartDate: now.AddDate(0, -1, 0), EndDate: now}); w == nil {
+		t.Error("one-month report should warn under a 10-day limit")
+	}
+}
+
+func TestReportMetrics_GenerationDurationScrapable(t *testing.T) {
+	familyID := uuid.New()
+	day := time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)
+	repo := &fakeTransactionRepo{transactions: []*transaction.Transaction{
+		expenseOn(familyID, uuid.New(), 25, day),
+	}}
+	svc := NewReportService(newFakeReportRepo(), repo, nil, nil, nil)
+	registry := metrics.NewRegistry()
+	svc.SetMetrics(registry)
+
+	if _, err := svc.GenerateExpenseReport(context.Background(), dto.ReportRequestDTO{
+		FamilyID:  familyID,
+		StartDate: day.AddDate(0, 0, -1),
+		EndDate:   day.AddDate(0, 0, 1),
+	}); err != nil {
+		t.Fatalf("GenerateExpenseReport: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	registry.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+	if !strings.Contains(body, "report_generation_duration_seconds") {
+		t.Fatalf("metrics exposition missing report_generation_duration_seconds:\n%s", body)
+	}
+	if !strings.Contains(body, `report_generation_duration_seconds_count{type="expenses"} 1`) {
+		t.Errorf("expected one expenses generation observed:\n%s", body)
+	}
+}
+
+func TestReportTracing_EmitsSpanTree(t *testing.T) {
+	familyID := uuid.New()
+	day := time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)
+	repo := &fakeTransactionRepo{transactions: []*transaction.Transaction{
+		expenseOn(familyID, uuid.New(), 25, day),
+	}}
+	svc := NewReportService(newFakeReportRepo(), repo, nil, nil, nil)
+	exporter := tracing.NewInMemoryExporter()
+	svc.SetTracer(tracing.New(exporter))
+
+	if _, err := svc.GenerateExpenseReport(context.Background(), dto.ReportRequestDTO{
+		FamilyID:  familyID,
+		StartDate: day.AddDate(0, 0, -1),
+		EndDate:   day.AddDate(0, 0, 1),
+	}); err != nil {
+		t.Fatalf("GenerateExpenseReport: %v", err)
+	}
+
+	spans := exporter.Spans()
+	var root, query *tracing.Span
+	for _, s := range spans {
+		switch s.Name {
+		case "report.generate":
+			root = s
+		case "repository.get_by_filter":
+			query = s
+		}
+	}
+	if root == nil || query == nil {
+		t.Fatalf("missing spans, got %d: root=%v query=%v", len(spans), root, query)
+	}
+	if query.ParentID != root.SpanID || query.TraceID != root.TraceID {
+		t.Errorf("query span not a child of the generation span")
+	}
+	if root.Attributes["report.type"] != "expenses" {
+		t.Errorf("report.type = %q, want expenses", root.Attributes["report.type"])
+	}
+	if root.Attributes["transaction.count"] != "1" {
+		t.Errorf("transaction.count = %q, want 1", root.Attributes["transaction.count"])
+	}
+	if raw := root.Attributes["family.id"]; raw == "" || raw == familyID.String() {
+		t.Errorf("family.id attribute must be a non-empty hash, got %q", raw)
+	}
+}
+
+func TestReportService_NoTracerIsNoop(t *testing.T) {
+	familyID := uuid.New()
+	day := time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)
+	repo := &fakeTransactionRepo{transactions: []*transaction.Transaction{
+		expenseOn(familyID, uuid.New(), 25, day),
+	}}
+	svc := NewReportService(newFakeReportRepo(), repo, nil, nil, nil)
+
+	// No SetTracer call: generation must still work untraced.
+	if _, err := svc.GenerateExpenseReport(context.Background(), dto.ReportRequestDTO{
+		FamilyID:  familyID,
+		StartDate: day.AddDate(0, 0, -1),
+		EndDate:   day.AddDate(0, 0, 1),
+	}); err != nil {
+		t.Fatalf("GenerateExpenseReport without tracer: %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrUnsupportedTrendPeriod is returned when trend analysis is asked for
+// a period without a defined bucketing granularity.
+var ErrUnsupportedTrendPeriod = errors.New("unsupported trend analysis period")
+
+// trendBuckets maps a report period to its bucketing: how many buckets
+// the series has and how long each bucket is.
+func trendBuckets(period report.Period) (count int, bucket time.Duration, err error) {
+	const day = 24 * time.Hour
+	switch period {
+	case report.PeriodWeekly:
+		return 7, day, nil // daily buckets over a week
+	case report.PeriodMonthly:
+		return 4, 7 * day, nil // weekly buckets over four weeks
+	case report.PeriodYearly:
+		return 12, 0, nil // calendar-month buckets over a year
+	default:
+		return 0, 0, fmt.Errorf("%w: %q", ErrUnsupportedTrendPeriod, period)
+	}
+}
+
+// GenerateTrendAnalysis produces a bucketed spending time series ending
+// now, with its least-squares slope, direction and volatility. When
+// categoryID is nil the analysis covers total expenses across all
+// categories. Bucket granularity follows the period: daily for weekly,
+// weekly for monthly, monthly for yearly.
+func (s *ReportService) GenerateTrendAnalysis(
+	ctx context.Context,
+	familyID uuid.UUID,
+	categoryID *uuid.UUID,
+	period report.Period,
+) (*dto.TrendAnalysisDTO, error) {
+	count, bucketLen, err := trendBuckets(period)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	// Bucket start times, oldest first.
+	starts := make([]time.Time, count)
+	if bucketLen == 0 { // calendar months
+		currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		for i := 0; i < count; i++ {
+			starts[i] = currentMonth.AddDate(0, i-(count-1), 0)
+		}
+	} else {
+		end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).Add(24 * time.Hour)
+		for i := 0; i < count; i++ {
+			starts[i] = end.Add(-time.Duration(count-i) * bucketLen)
+		}
+	}
+	windowStart := starts[0]
+
+	expenseType := transaction.TypeExpense
+	var filters *dto.ReportFilterDTO
+	if categoryID != nil {
+		filters = &dto.ReportFilterDTO{CategoryIDs: []uuid.UUID{*categoryID}}
+	}
+	transactions, err := s.getTransactionsForPeriod(ctx, familyID, windowStart, now, &expenseType, filters)
+	if err != nil {
+		return nil, fmt.Errorf("generate trend analysis: %w", err)
+	}
+
+	amounts := make([]float64, count)
+	for _, t := range transactions {
+		idx := bucketIndex(starts, bucketLen, t.Date)
+		if idx >= 0 {
+			amounts[idx] += t.Amount
+		}
+	}
+
+	result := &dto.TrendAnalysisDTO{
+		FamilyID:   familyID,
+		CategoryID: categoryID,
+		Period:     period,
+	}
+	for i, start := range starts {
+		result.Points = append(result.Points, dto.TrendPointDTO{PeriodStart: start, Amount: amounts[i]})
+	}
+	result.Slope = leastSquaresSlope(amounts)
+	result.Volatility = standardDeviation(amounts)
+	result.Direction = trendDirection(result.Slope, amounts)
+	return result, nil
+}
+
+// bucketIndex finds which bucket a date belongs to, -1 if outside.
+func bucketIndex(starts []time.Time, bucketLen time.Duration, date time.Time) int {
+	for i := len(starts) - 1; i >= 0; i-- {
+		if !date.Before(starts[i]) {
+			var end time.Time
+			if bucketLen == 0 {
+				end = starts[i].AddDate(0, 1, 0)
+			} else {
+				end = starts[i].Add(bucketLen)
+			}
+			if date.Before(end) {
+				return i
+			}
+			return -1
+		}
+	}
+	return -1
+}
+
+// leastSquaresSlope fits amount = a + slope*i over bucket indexes.
+func leastSquaresSlope(amounts []float64) float64 {
+	n := float64(len(amounts))
+	if n < 2 {
+		return 0
+	}
+	var sumX, sumY, sumXY, sumXX float64
+	for i, y := range amounts {
+		x := float64(i)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	denom := n*sumXX - sumX*sumX
+	if denom == 0 {
+		return 0
+	}
+	return (n*sumXY - sumX*sumY) / denom
+}
+
+func standardDeviation(amounts []float64) float64 {
+	if len(amounts) == 0 {
+		return 0
+	}
+	var mean float64
+	for _, a := range amounts {
+		mean += a
+	}
+	mean /= float64(len(amounts))
+	var variance float64
+	for _, a := range amounts {
+		variance += (a - mean) * (a - mean)
+	}
+	return math.Sqrt(variance / float64(len(amounts)))
+}
+
+// trendDirection classifies the slope relative to the series mean: a
+// per-bucket change of more than 1% of the mean counts as a trend.
+func trendDirection(slope float64, amounts []float64) string {
+	var mean float64
+	for _, a := range amounts {
+		mean += a
+	}
+	if len(amounts) > 0 {
+		mean /= float64(len(amounts))
+	}
+	threshold := 0.01 * math.Abs(mean)
+	switch {
+	case slope > threshold:
+		return dto.TrendIncreasing
+	case slope < -threshold:
+		return dto.TrendDecreasing
+	default:
+		return dto.TrendStable
+	}
+}
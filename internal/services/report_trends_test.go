@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestGenerateTrendAnalysis_IncreasingSeries(t *testing.T) {
+	transactions := memory.NewTransactionRepository()
+	svc := NewReportService(memory.NewReportRepository(), transactions, nil, nil, nil)
+	familyID := uuid.New()
+	categoryID := uuid.New()
+
+	// Strictly increasing daily spend over the trailing week.
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	for i := 0; i < 7; i++ {
+		day := today.AddDate(0, 0, -6+i)
+		seedInsightTransaction(t, transactions, familyID, categoryID, transaction.TypeExpense, float64(10*(i+1)), day.Add(10*time.Hour))
+	}
+
+	got, err := svc.GenerateTrendAnalysis(context.Background(), familyID, &categoryID, report.PeriodWeekly)
+	if err != nil {
+		t.Fatalf("GenerateTrendAnalysis: %v", err)
+	}
+	if len(got.Points) != 7 {
+		t.Fatalf("weekly trend has %d points, want 7 daily buckets", len(got.Points))
+	}
+	if got.Direction != dto.TrendIncreasing {
+		t.Errorf("direction = %s (slope %v), want increasing", got.Direction, got.Slope)
+	}
+	if got.Slope <= 0 {
+		t.Errorf("slope = %v, want positive", got.Slope)
+	}
+	if got.Volatility <= 0 {
+		t.Errorf("volatility = %v, want positive for a varying series", got.Volatility)
+	}
+}
+
+func TestGenerateTrendAnalysis_NilCategoryCoversAllSpending(t *testing.T) {
+	transactions := memory.NewTransactionRepository()
+	svc := NewReportService(memory.NewReportRepository(), transactions, nil, nil, nil)
+	familyID := uuid.New()
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	seedInsightTransaction(t, transactions, familyID, uuid.New(), transaction.TypeExpense, 30, today.Add(9*time.Hour))
+	seedInsightTransaction(t, transactions, familyID, uuid.New(), transaction.TypeExpense, 20, today.Add(11*time.Hour))
+
+	got, err := svc.GenerateTrendAnalysis(context.Background(), familyID, nil, report.PeriodWeekly)
+	if err != nil {
+		t.Fatalf("GenerateTrendAnalysis: %v", err)
+	}
+	var total float64
+	for _, p := range got.Points {
+		total += p.Amount
+	}
+	if total != 50 {
+		t.Errorf("total across buckets = %v, want 50 from both categories", total)
+	}
+}
+
+func TestGenerateTrendAnalysis_YearlyUsesMonthlyBuckets(t *testing.T) {
+	svc := NewReportService(memory.NewReportRepository(), memory.NewTransactionRepository(), nil, nil, nil)
+	got, err := svc.GenerateTrendAnalysis(context.Background(), uuid.New(), nil, report.PeriodYearly)
+	if err != nil {
+		t.Fatalf("GenerateTrendAnalysis: %v", err)
+	}
+	if len(got.Points) != 12 {
+		t.Errorf("yearly trend has %d points, want 12 monthly buckets", len(got.Points))
+	}
+	if got.Direction != dto.TrendStable {
+		t.Errorf("empty series direction = %s, want stable", got.Direction)
+	}
+}
+
+func TestGenerateTrendAnalysis_UnsupportedPeriod(t *testing.T) {
+	svc := NewReportService(memory.NewReportRepository(), memory.NewTransactionRepository(), nil, nil, nil)
+	if _, err := svc.GenerateTrendAnalysis(context.Background(), uuid.New(), nil, report.PeriodCustom); !errors.Is(err, ErrUnsupportedTrendPeriod) {
+		t.Errorf("err = %v, want ErrUnsupportedTrendPeriod", err)
+	}
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestGenerateUserSpendingTrendReport_PerUserMonthlySeries(t *testing.T) {
+	users := memory.NewUserRepository()
+	transactions := memory.NewTransactionRepository()
+	svc := NewReportService(memory.NewReportRepository(), transactions, nil, nil, users)
+
+	familyID := uuid.New()
+	alice := &user.User{ID: uuid.New(), Email: "alice@example.com", FirstName: "Alice", LastName: "Smith", Role: user.RoleAdmin, FamilyID: familyID}
+	bob := &user.User{ID: uuid.New(), Email: "bob@example.com", FirstName: "Bob", LastName: "Smith", Role: user.RoleMember, FamilyID: familyID}
+	for _, u := range []*user.User{alice, bob} {
+		if err := users.Create(context.Background(), u); err != nil {
+			t.Fatalf("seed user: %v", err)
+		}
+	}
+
+	jan := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC)
+	seed := func(userID uuid.UUID, amount float64, date time.Time) {
+		t.Helper()
+		err := transactions.Create(context.Background(), &transaction.Transaction{
+			ID: uuid.New(), Amount: amount, Type: transaction.TypeExpense,
+			CategoryID: uuid.New(), UserID: userID, FamilyID: familyID, Date: date,
+		})
+		if err != nil {
+			t.Fatalf("seed transaction: %v", err)
+		}
+	}
+	seed(alice.ID, 100, jan)
+	seed(alice.ID, 150, feb)
+	seed(bob.ID, 40, feb)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)
+	got, err := svc.GenerateUserSpendingTrendReport(context.Background(), familyID, from, to)
+	if err != nil {
+		t.Fatalf("GenerateUserSpendingTrendReport: %v", err)
+	}
+
+	if len(got.Months) != 2 {
+		t.Fatalf("Months = %v, want January and February", got.Months)
+	}
+	if len(got.Users) != 2 {
+		t.Fatalf("Users = %d, want 2", len(got.Users))
+	}
+	// Ordered by total spend: Alice (250) first.
+	first := got.Users[0]
+	if first.UserID != alice.ID || first.UserName != "Alice Smith" || first.Total != 250 {
+		t.Errorf("first series = %+v, want Alice Smith with 250", first)
+	}
+	if first.Monthly[0].Amount != 100 || first.Monthly[1].Amount != 150 {
+		t.Errorf("Alice monthly = %+v, want 100 then 150", first.Monthly)
+	}
+	second := got.Users[1]
+	if second.UserID != bob.ID || second.Monthly[0].Amount != 0 || second.Monthly[1].Amount != 40 {
+		t.Errorf("Bob series = %+v, want 0 then 40", second)
+	}
+}
+
+func TestGenerateUserSpendingTrendReport_UnknownUserLabeled(t *testing.T) {
+	users := memory.NewUserRepository()
+	transactions := memory.NewTransactionRepository()
+	svc := NewReportService(memory.NewReportRepository(), transactions, nil, nil, users)
+
+	familyID := uuid.New()
+	day := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	err := transactions.Create(context.Background(), &transaction.Transaction{
+		ID: uuid.New(), Amount: 10, Type: transaction.TypeExpense,
+		CategoryID: uuid.New(), UserID: uuid.New(), FamilyID: familyID, Date: day,
+	})
+	if err != nil {
+		t.Fatalf("seed transaction: %v", err)
+	}
+
+	got, err := svc.GenerateUserSpendingTrendReport(context.Background(), familyID, day.AddDate(0, 0, -10), day.AddDate(0, 0, 10))
+	if err != nil {
+		t.Fatalf("GenerateUserSpendingTrendReport: %v", err)
+	}
+	if len(got.Users) != 1 || got.Users[0].UserName != "Unknown" {
+		t.Errorf("Users = %+v, want one Unknown series", got.Users)
+	}
+}
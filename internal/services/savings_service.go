@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"family-budget-service/internal/domain/savings"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrInvalidSavingsGoal rejects goals without a name, with a
+// non-positive target, or whose target date is not after the start.
+var ErrInvalidSavingsGoal = errors.New("invalid savings goal")
+
+// SavingsService manages savings goals and the progress derived from
+// transactions.
+type SavingsService struct {
+	savingsRepo     savings.Repository
+	transactionRepo transaction.Repository
+}
+
+// NewSavingsService builds a SavingsService.
+func NewSavingsService(savingsRepo savings.Repository, transactionRepo transaction.Repository) *SavingsService {
+	return &SavingsService{savingsRepo: savingsRepo, transactionRepo: transactionRepo}
+}
+
+// CreateGoal validates and persists a savings goal. An unset start date
+// defaults to now.
+func (s *SavingsService) CreateGoal(ctx context.Context, g *savings.Goal) error {
+	if g.StartDate.IsZero() {
+		g.StartDate = time.Now()
+	}
+	if err := validateGoal(g); err != nil {
+		return err
+	}
+	if g.ID.IsNil() {
+		g.ID = uuid.New()
+	}
+	now := time.Now()
+	g.CreatedAt = now
+	g.UpdatedAt = now
+	if err := s.savingsRepo.Create(ctx, g); err != nil {
+		return fmt.Errorf("create savings goal: %w", err)
+	}
+	return nil
+}
+
+// GetGoalByID returns a single savings goal.
+func (s *SavingsService) GetGoalByID(ctx context.Context, id uuid.UUID) (*savings.Goal, error) {
+	return s.savingsRepo.GetByID(ctx, id)
+}
+
+// GetGoalsByFamily returns every savings goal of a family, soonest
+// target first.
+func (s *SavingsService) GetGoalsByFamily(ctx context.Context, familyID uuid.UUID) ([]*savings.Goal, error) {
+	return s.savingsRepo.GetByFamilyID(ctx, familyID)
+}
+
+// UpdateGoal validates and persists changes to a goal.
+func (s *SavingsService) UpdateGoal(ctx context.Context, g *savings.Goal) error {
+	if err := validateGoal(g); err != nil {
+		return err
+	}
+	g.UpdatedAt = time.Now()
+	if err := s.savingsRepo.Update(ctx, g); err != nil {
+		return fmt.Errorf("update savings goal: %w", err)
+	}
+	return nil
+}
+
+// DeleteGoal removes a savings goal.
+func (s *SavingsService) DeleteGoal(ctx context.Context, id uuid.UUID) error {
+	return s.savingsRepo.Delete(ctx, id)
+}
+
+// GoalProgress is a goal together with its derived progress.
+type GoalProgress struct {
+	Goal    *savings.Goal
+	Saved   float64
+	Percent float64
+	// ProjectedCompletion estimates when the target is reached at the
+	// savings rate observed since the goal started; nil when nothing
+	// has been saved yet (or the balance is shrinking).
+	ProjectedCompletion *time.Time
+	// OnTrack reports whether the projection lands on or before the
+	// target date.
+	OnTrack bool
+}
+
+// GetGoalProgress computes how far along a goal is as of now.
+func (s *SavingsService) GetGoalProgress(ctx context.Context, goalID uuid.UUID, now time.Time) (*GoalProgress, error) {
+	g, err := s.savingsRepo.GetByID(ctx, goalID)
+	if err != nil {
+		return nil, err
+	}
+	saved, err := s.savedSince(ctx, g, now)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &GoalProgress{Goal: g, Saved: saved}
+	if g.TargetAmount > 0 {
+		progress.Percent = math.Min(saved/g.TargetAmount*100, 100)
+	}
+	if saved >= g.TargetAmount {
+		progress.ProjectedCompletion = &now
+		progress.OnTrack = true
+		return progress, nil
+	}
+
+	elapsedDays := now.Sub(g.StartDate).Hours() / 24
+	if elapsedDays <= 0 || saved <= 0 {
+		return progress, nil
+	}
+	dailyRate := saved / elapsedDays
+	remainingDays := (g.TargetAmount - saved) / dailyRate
+	projected := now.Add(time.Duration(remainingDays * 24 * float64(time.Hour)))
+	progress.ProjectedCompletion = &projected
+	progress.OnTrack = !projected.After(g.TargetDate)
+	return progress, nil
+}
+
+// savedSince derives the amount saved toward g between its start date
+// and now: expenses into the dedicated savings category when one is
+// configured, net income otherwise.
+func (s *SavingsService) savedSince(ctx context.Context, g *savings.Goal, now time.Time) (float64, error) {
+	filter := transaction.Filter{
+		FamilyID: g.FamilyID,
+		DateFrom: &g.StartDate,
+		DateTo:   &now,
+	}
+	if g.CategoryID != nil {
+		filter.CategoryIDs = []uuid.UUID{*g.CategoryID}
+	}
+	transactions, err := s.transactionRepo.GetByFilter(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("load transactions for goal: %w", err)
+	}
+	var saved float64
+	for _, t := range transactions {
+		switch {
+		case g.CategoryID != nil:
+			// Money moved into the savings category counts as saved.
+			if t.Type == transaction.TypeExpense {
+				saved += t.Amount
+			}
+		case t.Type == transaction.TypeIncome:
+			saved += t.Amount
+		default:
+			saved -= t.Amount
+		}
+	}
+	return saved, nil
+}
+
+func validateGoal(g *savings.Goal) error {
+	if g.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidSavingsGoal)
+	}
+	if g.TargetAmount <= 0 {
+		return fmt.Errorf("%w: target amount must be positive", ErrInvalidSavingsGoal)
+	}
+	if !g.TargetDate.After(g.StartDate) {
+		return fmt.Errorf("%w: target date must be after the start date", ErrInvalidSavingsGoal)
+	}
+	return nil
+}
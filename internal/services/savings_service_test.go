@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/savings"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func newSavingsFixture(t *testing.T) (*SavingsService, *TransactionService, *memory.UserRepository) {
+	t.Helper()
+	users := memory.NewUserRepository()
+	transactions := memory.NewTransactionRepository()
+	return NewSavingsService(memory.NewSavingsRepository(), transactions),
+		NewTransactionService(transactions, users), users
+}
+
+func seedGoal(t *testing.T, svc *SavingsService, familyID uuid.UUID, target float64, startedDaysAgo, daysLeft int) *savings.Goal {
+	t.Helper()
+	g := &savings.Goal{
+		FamilyID:     familyID,
+		Name:         "vacation fund",
+		TargetAmount: target,
+		StartDate:    time.Now().AddDate(0, 0, -startedDaysAgo),
+		TargetDate:   time.Now().AddDate(0, 0, daysLeft),
+	}
+	if err := svc.CreateGoal(context.Background(), g); err != nil {
+		t.Fatalf("CreateGoal: %v", err)
+	}
+	return g
+}
+
+func TestSavingsGoal_NetIncomeProgress(t *testing.T) {
+	svc, txService, users := newSavingsFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+	g := seedGoal(t, svc, familyID, 200, 10, 30)
+
+	salary := expenseFor(familyID, u.ID)
+	salary.Type = transaction.TypeIncome
+	salary.Amount = 150
+	groceries := expenseFor(familyID, u.ID)
+	groceries.Amount = 50
+	for _, tx := range []*transaction.Transaction{salary, groceries} {
+		if err := txService.CreateTransaction(context.Background(), tx); err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+	}
+
+	progress, err := svc.GetGoalProgress(context.Background(), g.ID, time.Now())
+	if err != nil {
+		t.Fatalf("GetGoalProgress: %v", err)
+	}
+	if progress.Saved != 100 || progress.Percent != 50 {
+		t.Errorf("saved = %v (%v%%), want net income 100 (50%%)", progress.Saved, progress.Percent)
+	}
+}
+
+func TestSavingsGoal_CategoryProgressAndPercentCap(t *testing.T) {
+	svc, txService, users := newSavingsFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+	savingsCategory := uuid.New()
+	g := seedGoal(t, svc, familyID, 100, 10, 30)
+	g.CategoryID = &savingsCategory
+	if err := svc.UpdateGoal(context.Background(), g); err != nil {
+		t.Fatalf("UpdateGoal: %v", err)
+	}
+
+	deposit := expenseFor(familyID, u.ID)
+	deposit.CategoryID = savingsCategory
+	deposit.Amount = 120
+	other := expenseFor(familyID, u.ID)
+	other.Amount = 500
+	for _, tx := range []*transaction.Transaction{deposit, other} {
+		if err := txService.CreateTransaction(context.Background(), tx); err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+	}
+
+	progress, err := svc.GetGoalProgress(context.Background(), g.ID, time.Now())
+	if err != nil {
+		t.Fatalf("GetGoalProgress: %v", err)
+	}
+	if progress.Saved != 120 {
+		t.Errorf("saved = %v, want only the 120 in the savings category", progress.Saved)
+	}
+	if progress.Percent != 100 {
+		t.Errorf("percent = %v, want capped at 100", progress.Percent)
+	}
+	if !progress.OnTrack || progress.ProjectedCompletion == nil {
+		t.Errorf("reached goal should be on track with a completion date, got %+v", progress)
+	}
+}
+
+func TestSavingsGoal_ProjectedCompletion(t *testing.T) {
+	svc, txService, users := newSavingsFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+	// 100 saved over 10 days toward 300: 10/day leaves 20 days to go.
+	g := seedGoal(t, svc, familyID, 300, 10, 40)
+
+	salary := expenseFor(familyID, u.ID)
+	salary.Type = transaction.TypeIncome
+	salary.Amount = 100
+	if err := txService.CreateTransaction(context.Background(), salary); err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+
+	now := time.Now()
+	progress, err := svc.GetGoalProgress(context.Background(), g.ID, now)
+	if err != nil {
+		t.Fatalf("GetGoalProgress: %v", err)
+	}
+	if progress.ProjectedCompletion == nil {
+		t.Fatal("expected a projected completion date")
+	}
+	want := now.AddDate(0, 0, 20)
+	if diff := progress.ProjectedCompletion.Sub(want); diff < -time.Hour || diff > time.Hour {
+		t.Errorf("projected = %v, want about %v", progress.ProjectedCompletion, want)
+	}
+	if !progress.OnTrack {
+		t.Error("projection 20 days out against a 40-day target date should be on track")
+	}
+
+	// Shrink the deadline below the projection: no longer on track.
+	g.TargetDate = now.AddDate(0, 0, 5)
+	if err := svc.UpdateGoal(context.Background(), g); err != nil {
+		t.Fatalf("UpdateGoal: %v", err)
+	}
+	progress, err = svc.GetGoalProgress(context.Background(), g.ID, now)
+	if err != nil {
+		t.Fatalf("GetGoalProgress: %v", err)
+	}
+	if progress.OnTrack {
+		t.Error("projection past the target date should not be on track")
+	}
+}
+
+func TestSavingsGoal_NoSavingsNoProjection(t *testing.T) {
+	svc, _, _ := newSavingsFixture(t)
+	familyID := uuid.New()
+	g := seedGoal(t, svc, familyID, 300, 10, 30)
+
+	progress, err := svc.GetGoalProgress(context.Background(), g.ID, time.Now())
+	if err != nil {
+		t.Fatalf("GetGoalProgress: %v", err)
+	}
+	if progress.Saved != 0 || progress.ProjectedCompletion != nil || progress.OnTrack {
+		t.Errorf("empty goal should have no projection, got %+v", progress)
+	}
+}
+
+func TestCreateGoal_Validation(t *testing.T) {
+	svc, _, _ := newSavingsFixture(t)
+	bad := &savings.Goal{
+		FamilyID:     uuid.New(),
+		Name:         "",
+		TargetAmount: 100,
+		TargetDate:   time.Now().AddDate(0, 1, 0),
+	}
+	if err := svc.CreateGoal(context.Background(), bad); !errors.Is(err, ErrInvalidSavingsGoal) {
+		t.Errorf("err = %v, want ErrInvalidSavingsGoal for empty name", err)
+	}
+	bad.Name = "fund"
+	bad.TargetDate = time.Now().AddDate(0, 0, -1)
+	if err := svc.CreateGoal(context.Background(), bad); !errors.Is(err, ErrInvalidSavingsGoal) {
+		t.Errorf("err = %v, want ErrInvalidSavingsGoal for past target date", err)
+	}
+}
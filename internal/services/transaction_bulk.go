@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrCrossFamilyTransaction rejects batch operations that reference a
+// transaction of another family. The whole batch fails so a bad ID can
+// never partially apply.
+var ErrCrossFamilyTransaction = errors.New("transaction belongs to another family")
+
+// ErrCategoryNotInFamily rejects a target category of another family.
+var ErrCategoryNotInFamily = errors.New("category belongs to another family")
+
+// SetBulkCategorization wires the repositories bulk categorization needs:
+// categoryRepo validates the target category and budgetRepo keeps spent
+// amounts in step. budgetRepo may be nil to skip recalculation.
+func (s *TransactionService) SetBulkCategorization(categoryRepo category.Repository, budgetRepo budget.Repository) {
+	s.categoryRepo = categoryRepo
+	s.budgetRepo = budgetRepo
+}
+
+// BulkCategorizeTransactions moves the given transactions of a family to
+// categoryID in one batch and returns how many were updated. Every ID
+// must resolve to a transaction of that family, otherwise nothing is
+// applied. Budgets covering the old or new category have their spent
+// amounts adjusted.
+func (s *TransactionService) BulkCategorizeTransactions(ctx context.Context, familyID uuid.UUID, transactionIDs []uuid.UUID, categoryID uuid.UUID) (int, error) {
+	if err := s.validateTargetCategory(ctx, familyID, categoryID); err != nil {
+		return 0, err
+	}
+
+	// Resolve the whole batch before touching anything.
+	batch := make([]*transaction.Transaction, 0, len(transactionIDs))
+	for _, id := range transactionIDs {
+		t, err := s.transactionRepo.GetByID(ctx, id)
+		if err != nil {
+			return 0, fmt.Errorf("load transaction %s: %w", id, err)
+		}
+		if t.FamilyID != familyID {
+			return 0, fmt.Errorf("%w: %s", ErrCrossFamilyTransaction, id)
+		}
+		batch = append(batch, t)
+	}
+
+	updated := 0
+	for _, t := range batch {
+		if t.CategoryID == categoryID {
+			continue
+		}
+		if err := s.recategorize(ctx, t, categoryID); err != nil {
+			return updated, err
+		}
+		updated++
+	}
+	return updated, nil
+}
+
+// BulkDeleteTransactions moves a batch of the family's transactions to
+// the trash and returns how many were deleted. Every ID must resolve to
+// a live transaction of that family, otherwise nothing is touched. The
+// budget recalculation is batched: each affected budget has the batch's
+// expense contributions subtracted in one update, however many deleted
+// transactions it covered.
+func (s *TransactionService) BulkDeleteTransactions(ctx context.Context, familyID uuid.UUID, transactionIDs []uuid.UUID) (int, error) {
+	// Resolve the whole batch before touching anything; repeated IDs
+	// collapse so a duplicate cannot double-subtract from a budget.
+	seen := make(map[uuid.UUID]struct{}, len(transactionIDs))
+	batch := make([]*transaction.Transaction, 0, len(transactionIDs))
+	for _, id := range transactionIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		t, err := s.transactionRepo.GetByID(ctx, id)
+		if err != nil {
+			return 0, fmt.Errorf("load transaction %s: %w", id, err)
+		}
+		if t.FamilyID != familyID {
+			return 0, fmt.Errorf("%w: %s", ErrCrossFamilyTransaction, id)
+		}
+		if t.DeletedAt != nil {
+			return 0, fmt.Errorf("load transaction %s: %w", id, transaction.ErrNotFound)
+		}
+		batch = append(batch, t)
+	}
+
+	deleted := 0
+	deltas := make(map[uuid.UUID]float64)
+	var budgets []*budget.Budget
+	if s.budgetRepo != nil {
+		var err error
+		if budgets, err = s.budgetRepo.GetActiveBudgets(ctx, familyID); err != nil {
+			return 0, fmt.Errorf("load active budgets: %w", err)
+		}
+	}
+	for _, t := range batch {
+		now := time.Now()
+		t.DeletedAt = &now
+		t.UpdatedAt = now
+		if err := s.transactionRepo.Update(ctx, t); err != nil {
+			return deleted, fmt.Errorf("trash transaction %s: %w", t.ID, err)
+		}
+		deleted++
+		if t.Type != transaction.TypeExpense {
+			continue
+		}
+		for _, b := range budgets {
+			if t.Date.Before(b.StartDate) || t.Date.After(b.EndDate) {
+				continue
+			}
+			deltas[b.ID] += budgetContribution(b, t, t.CategoryID)
+		}
+	}
+
+	for _, b := range budgets {
+		delta := deltas[b.ID]
+		if delta == 0 {
+			continue
+		}
+		b.Spent = AddAmounts(b.Spent, -delta)
+		b.UpdatedAt = time.Now()
+		if err := s.budgetRepo.Update(ctx, b); err != nil {
+			return deleted, fmt.Errorf("update budget spend: %w", err)
+		}
+	}
+	return deleted, nil
+}
+
+// validateTargetCategory checks that the batch target exists and belongs
+// to the family. Without a category repository the check is skipped.
+func (s *TransactionService) validateTargetCategory(ctx context.Context, familyID, categoryID uuid.UUID) error {
+	if s.categoryRepo == nil {
+		return nil
+	}
+	c, err := s.categoryRepo.GetByID(ctx, categoryID)
+	if err != nil {
+		return fmt.Errorf("load target category: %w", err)
+	}
+	if c.FamilyID != familyID {
+		return fmt.Errorf("%w: %s", ErrCategoryNotInFamily, categoryID)
+	}
+	return nil
+}
+
+// recategorize moves one transaction to categoryID and shifts budget
+// spent amounts by the difference each budget sees before and after.
+func (s *TransactionService) recategorize(ctx context.Context, t *transaction.Transaction, categoryID uuid.UUID) error {
+	before := t.CategoryID
+	t.CategoryID = categoryID
+	t.UpdatedAt = time.Now()
+	if err := s.transactionRepo.Update(ctx, t); err != nil {
+		t.CategoryID = before
+		return fmt.Errorf("update transaction %s: %w", t.ID, err)
+	}
+	if s.budgetRepo == nil || t.Type != transaction.TypeExpense {
+		return nil
+	}
+
+	budgets, err := s.budgetRepo.GetActiveBudgets(ctx, t.FamilyID)
+	if err != nil {
+		return fmt.Errorf("load active budgets: %w", err)
+	}
+	for _, b := range budgets {
+		if t.Date.Before(b.StartDate) || t.Date.After(b.EndDate) {
+			continue
+		}
+		delta := budgetContribution(b, t, categoryID) - budgetContribution(b, t, before)
+		if delta == 0 {
+			continue
+		}
+		b.Spent = AddAmounts(b.Spent, delta)
+		b.UpdatedAt = time.Now()
+		if err := s.budgetRepo.Update(ctx, b); err != nil {
+			return fmt.Errorf("update budget spend: %w", err)
+		}
+	}
+	return nil
+}
+
+// budgetContribution is the amount t adds to b's spent total when its
+// top-level category is categoryID. Split portions keep their own
+// categories, so only the unsplit amount moves with a recategorization.
+func budgetContribution(b *budget.Budget, t *transaction.Transaction, categoryID uuid.UUID) float64 {
+	var amount float64
+	for _, allocation := range t.Allocations() {
+		allocated := allocation.CategoryID
+		if len(t.Splits) == 0 {
+			allocated = categoryID
+		}
+		if b.CategoryID == nil || *b.CategoryID == allocated {
+			amount += allocation.Amount
+		}
+	}
+	return amount
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+// countingBudgetRepo wraps the memory budget repository and counts
+// Update calls, so tests can assert each budget is recalculated once.
+type countingBudgetRepo struct {
+	*memory.BudgetRepository
+	updates int
+}
+
+func (c *countingBudgetRepo) Update(ctx context.Context, b *budget.Budget) error {
+	c.updates++
+	return c.BudgetRepository.Update(ctx, b)
+}
+
+func TestBulkDeleteTransactions_RecalculatesEachBudgetOnce(t *testing.T) {
+	users := memory.NewUserRepository()
+	budgets := &countingBudgetRepo{BudgetRepository: memory.NewBudgetRepository()}
+	svc := NewTransactionService(memory.NewTransactionRepository(), users)
+	svc.SetBulkCategorization(memory.NewCategoryRepository(), budgets)
+	familyID := uuid.New()
+	member := seedFamilyUser(t, users, familyID)
+
+	groceriesID, travelID := uuid.New(), uuid.New()
+	groceriesBudget := seedLimitBudget(t, budgets.BudgetRepository, familyID, groceriesID, 500, 100)
+	travelBudget := seedLimitBudget(t, budgets.BudgetRepository, familyID, travelID, 300, 30)
+
+	var ids []uuid.UUID
+	for _, spec := range []struct {
+		categoryID uuid.UUID
+		amount     float64
+	}{{groceriesID, 60}, {groceriesID, 40}, {travelID, 30}} {
+		tx := expenseFor(familyID, member.ID)
+		tx.CategoryID = spec.categoryID
+		tx.Amount = spec.amount
+		if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+		ids = append(ids, tx.ID)
+	}
+	budgets.updates = 0
+
+	deleted, err := svc.BulkDeleteTransactions(context.Background(), familyID, ids)
+	if err != nil {
+		t.Fatalf("BulkDeleteTransactions() error = %v", err)
+	}
+	if deleted != 3 {
+		t.Errorf("deleted = %d, want 3", deleted)
+	}
+	spent := func(id uuid.UUID) float64 {
+		t.Helper()
+		b, loadErr := budgets.GetByID(context.Background(), id)
+		if loadErr != nil {
+			t.Fatalf("load budget: %v", loadErr)
+		}
+		return b.Spent
+	}
+	if got := spent(groceriesBudget.ID); got != 0 {
+		t.Errorf("groceries spent = %v, want 0 after both deletions", got)
+	}
+	if got := spent(travelBudget.ID); got != 0 {
+		t.Errorf("travel spent = %v, want 0", got)
+	}
+	if budgets.updates != 2 {
+		t.Errorf("budget updates = %d, want one per affected budget", budgets.updates)
+	}
+	listed, err := svc.GetTransactionsByFamily(context.Background(), familyID)
+	if err != nil || len(listed) != 0 {
+		t.Fatalf("list after bulk delete = %d, %v; want empty", len(listed), err)
+	}
+}
+
+func TestBulkDeleteTransactions_CrossFamilyRejectsWholeBatch(t *testing.T) {
+	users := memory.NewUserRepository()
+	svc := NewTransactionService(memory.NewTransactionRepository(), users)
+	familyID, otherFamilyID := uuid.New(), uuid.New()
+	member := seedFamilyUser(t, users, familyID)
+	outsider := seedFamilyUser(t, users, otherFamilyID)
+
+	mine := expenseFor(familyID, member.ID)
+	if err := svc.CreateTransaction(context.Background(), mine); err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+	foreign := expenseFor(otherFamilyID, outsider.ID)
+	if err := svc.CreateTransaction(context.Background(), foreign); err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+
+	_, err := svc.BulkDeleteTransactions(context.Background(), familyID, []uuid.UUID{mine.ID, foreign.ID})
+	if !errors.Is(err, ErrCrossFamilyTransaction) {
+		t.Fatalf("BulkDeleteTransactions() error = %v, want ErrCrossFamilyTransaction", err)
+	}
+	listed, err := svc.GetTransactionsByFamily(context.Background(), familyID)
+	if err != nil || len(listed) != 1 {
+		t.Fatalf("list after rejected batch = %d, %v; want the transaction untouched", len(listed), err)
+	}
+}
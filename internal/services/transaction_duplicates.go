@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// Defaults for duplicate detection. A window of one day catches the
+// common "entered by hand, then imported" pair without sweeping up
+// genuine weekly recurrences.
+const (
+	DefaultDuplicateDayWindow = 1
+	DefaultDuplicateDistance  = 3
+)
+
+// DuplicateDetectionOptions tunes what counts as a likely duplicate.
+// Zero values fall back to the defaults.
+type DuplicateDetectionOptions struct {
+	// DayWindow is how many days apart two transactions may be.
+	DayWindow int
+	// MaxDescriptionDistance is the Levenshtein distance descriptions may
+	// differ by, compared case-insensitively.
+	MaxDescriptionDistance int
+}
+
+// DuplicateGroup is one cluster of likely-duplicate transactions,
+// ordered oldest first.
+type DuplicateGroup struct {
+	Transactions []*transaction.Transaction
+}
+
+// FindDuplicateTransactions scans a family's transactions for likely
+// duplicates: same amount, same category, dates within the day window
+// and descriptions within the edit-distance threshold. Groups come back
+// ordered by their oldest transaction.
+func (s *TransactionService) FindDuplicateTransactions(ctx context.Context, familyID uuid.UUID, opts DuplicateDetectionOptions) ([]DuplicateGroup, error) {
+	if opts.DayWindow <= 0 {
+		opts.DayWindow = DefaultDuplicateDayWindow
+	}
+	if opts.MaxDescriptionDistance <= 0 {
+		opts.MaxDescriptionDistance = DefaultDuplicateDistance
+	}
+
+	transactions, err := s.transactionRepo.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load transactions: %w", err)
+	}
+	sort.Slice(transactions, func(i, j int) bool {
+		return transactions[i].Date.Before(transactions[j].Date)
+	})
+
+	// Greedy clustering: each transaction joins the first group whose
+	// seed it matches, so a group never grows beyond the window around
+	// its oldest member.
+	var groups []DuplicateGroup
+	grouped := make(map[uuid.UUID]bool, len(transactions))
+	for i, seed := range transactions {
+		if grouped[seed.ID] {
+			continue
+		}
+		group := DuplicateGroup{Transactions: []*transaction.Transaction{seed}}
+		for _, candidate := range transactions[i+1:] {
+			if grouped[candidate.ID] {
+				continue
+			}
+			if likelyDuplicates(seed, candidate, opts) {
+				group.Transactions = append(group.Transactions, candidate)
+				grouped[candidate.ID] = true
+			}
+		}
+		if len(group.Transactions) > 1 {
+			grouped[seed.ID] = true
+			groups = append(groups, group)
+		}
+	}
+	return groups, nil
+}
+
+// likelyDuplicates applies the duplicate heuristic to one pair.
+func likelyDuplicates(a, b *transaction.Transaction, opts DuplicateDetectionOptions) bool {
+	if a.Amount != b.Amount || a.CategoryID != b.CategoryID || a.Type != b.Type {
+		return false
+	}
+	gap := b.Date.Sub(a.Date)
+	if gap < 0 {
+		gap = -gap
+	}
+	if gap > time.Duration(opts.DayWindow)*24*time.Hour {
+		return false
+	}
+	return levenshtein(strings.ToLower(a.Description), strings.ToLower(b.Description)) <= opts.MaxDescriptionDistance
+}
+
+// ResolveDuplicates keeps one transaction of a reviewed group and
+// deletes the listed duplicates, returning how many were removed. Every
+// ID must belong to the family; budgets covering a deleted expense get
+// its amount subtracted from their spent totals.
+func (s *TransactionService) ResolveDuplicates(ctx context.Context, familyID, keepID uuid.UUID, removeIDs []uuid.UUID) (int, error) {
+	keep, err := s.transactionRepo.GetByID(ctx, keepID)
+	if err != nil {
+		return 0, fmt.Errorf("load kept transaction: %w", err)
+	}
+	if keep.FamilyID != familyID {
+		return 0, fmt.Errorf("%w: %s", ErrCrossFamilyTransaction, keepID)
+	}
+
+	// Resolve the whole batch before deleting anything.
+	batch := make([]*transaction.Transaction, 0, len(removeIDs))
+	for _, id := range removeIDs {
+		if id == keepID {
+			continue
+		}
+		t, err := s.transactionRepo.GetByID(ctx, id)
+		if err != nil {
+			return 0, fmt.Errorf("load transaction %s: %w", id, err)
+		}
+		if t.FamilyID != familyID {
+			return 0, fmt.Errorf("%w: %s", ErrCrossFamilyTransaction, id)
+		}
+		batch = append(batch, t)
+	}
+
+	deleted := 0
+	for _, t := range batch {
+		if err := s.transactionRepo.Delete(ctx, t.ID); err != nil {
+			return deleted, fmt.Errorf("delete transaction %s: %w", t.ID, err)
+		}
+		if err := s.subtractFromBudgets(ctx, t); err != nil {
+			return deleted, err
+		}
+		if s.attachments != nil {
+			if err := s.attachments.DeleteTransactionAttachments(ctx, t.ID); err != nil {
+				return deleted, err
+			}
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// subtractFromBudgets removes a deleted expense's contribution from the
+// active budgets that covered it.
+func (s *TransactionService) subtractFromBudgets(ctx context.Context, t *transaction.Transaction) error {
+	if s.budgetRepo == nil || t.Type != transaction.TypeExpense {
+		return nil
+	}
+	budgets, err := s.budgetRepo.GetActiveBudgets(ctx, t.FamilyID)
+	if err != nil {
+		return fmt.Errorf("load active budgets: %w", err)
+	}
+	for _, b := range budgets {
+		if t.Date.Before(b.StartDate) || t.Date.After(b.EndDate) {
+			continue
+		}
+		amount := budgetContribution(b, t, t.CategoryID)
+		if amount == 0 {
+			continue
+		}
+		b.Spent = AddAmounts(b.Spent, -amount)
+		b.UpdatedAt = time.Now()
+		if err := s.budgetRepo.Update(ctx, b); err != nil {
+			return fmt.Errorf("update budget spend: %w", err)
+		}
+	}
+	return nil
+}
+
+// levenshtein is the edit distance between two strings, computed with a
+// rolling single-row table.
+func levenshtein(a, b string) int {
+	if a == b {
+		return 0
+	}
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 {
+		return len(rb)
+	}
+	row := make([]int, len(rb)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(rb); j++ {
+			insertion := row[j-1] + 1
+			deletion := row[j] + 1
+			substitution := prev
+			if ra[i-1] != rb[j-1] {
+				substitution++
+			}
+			prev = row[j]
+			row[j] = min(insertion, deletion, substitution)
+		}
+	}
+	return row[len(rb)]
+}
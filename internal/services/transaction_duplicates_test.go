@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func seedDuplicate(t *testing.T, repo *memory.TransactionRepository, familyID, categoryID uuid.UUID, amount float64, description string, date time.Time) *transaction.Transaction {
+	t.Helper()
+	tr := &transaction.Transaction{
+		ID: uuid.New(), Amount: amount, Type: transaction.TypeExpense,
+		Description: description, CategoryID: categoryID, UserID: uuid.New(),
+		FamilyID: familyID, Date: date,
+	}
+	if err := repo.Create(context.Background(), tr); err != nil {
+		t.Fatalf("seed transaction: %v", err)
+	}
+	return tr
+}
+
+func TestFindDuplicateTransactions_GroupsNearIdenticalPair(t *testing.T) {
+	repo := memory.NewTransactionRepository()
+	svc := NewTransactionService(repo, memory.NewUserRepository())
+	familyID, categoryID := uuid.New(), uuid.New()
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	first := seedDuplicate(t, repo, familyID, categoryID, 42.50, "Coffee Shop", date)
+	second := seedDuplicate(t, repo, familyID, categoryID, 42.50, "coffee shp", date.AddDate(0, 0, 1))
+	// Same merchant, different day and amount: a genuine second visit.
+	seedDuplicate(t, repo, familyID, categoryID, 17.80, "Coffee Shop", date.AddDate(0, 0, 4))
+
+	groups, err := svc.FindDuplicateTransactions(context.Background(), familyID, DuplicateDetectionOptions{})
+	if err != nil {
+		t.Fatalf("FindDuplicateTransactions: %v", err)
+	}
+	if len(groups) != 1 {
+		t.Fatalf("groups = %d, want exactly one", len(groups))
+	}
+	got := groups[0].Transactions
+	if len(got) != 2 || got[0].ID != first.ID || got[1].ID != second.ID {
+		t.Errorf("group = %v, want the near-identical pair oldest first", got)
+	}
+}
+
+func TestFindDuplicateTransactions_RespectsWindowAndDistance(t *testing.T) {
+	repo := memory.NewTransactionRepository()
+	svc := NewTransactionService(repo, memory.NewUserRepository())
+	familyID, categoryID := uuid.New(), uuid.New()
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	seedDuplicate(t, repo, familyID, categoryID, 42.50, "Coffee Shop", date)
+	outsideWindow := seedDuplicate(t, repo, familyID, categoryID, 42.50, "Coffee Shop", date.AddDate(0, 0, 3))
+
+	groups, err := svc.FindDuplicateTransactions(context.Background(), familyID, DuplicateDetectionOptions{})
+	if err != nil {
+		t.Fatalf("FindDuplicateTransactions: %v", err)
+	}
+	if len(groups) != 0 {
+		t.Errorf("groups = %d, want none with the default 1-day window", len(groups))
+	}
+
+	// Widening the window groups the pair.
+	groups, err = svc.FindDuplicateTransactions(context.Background(), familyID, DuplicateDetectionOptions{DayWindow: 5})
+	if err != nil {
+		t.Fatalf("FindDuplicateTransactions: %v", err)
+	}
+	if len(groups) != 1 || len(groups[0].Transactions) != 2 {
+		t.Fatalf("groups = %v, want one pair with a 5-day window", groups)
+	}
+	if groups[0].Transactions[1].ID != outsideWindow.ID {
+		t.Errorf("grouped the wrong transaction")
+	}
+}
+
+func TestResolveDuplicates_DeletesAndAdjustsBudget(t *testing.T) {
+	repo := memory.NewTransactionRepository()
+	budgets := memory.NewBudgetRepository()
+	svc := NewTransactionService(repo, memory.NewUserRepository())
+	svc.SetBulkCategorization(nil, budgets)
+	familyID, categoryID := uuid.New(), uuid.New()
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	keep := seedDuplicate(t, repo, familyID, categoryID, 42.50, "Coffee Shop", date)
+	dupe := seedDuplicate(t, repo, familyID, categoryID, 42.50, "coffee shp", date)
+	b := &budget.Budget{
+		ID: uuid.New(), Name: "Coffee cap", Amount: 200, Spent: 85,
+		CategoryID: &categoryID, FamilyID: familyID, IsActive: true,
+		StartDate: date.AddDate(0, 0, -9), EndDate: date.AddDate(0, 0, 21),
+	}
+	if err := budgets.Create(context.Background(), b); err != nil {
+		t.Fatalf("seed budget: %v", err)
+	}
+
+	deleted, err := svc.ResolveDuplicates(context.Background(), familyID, keep.ID, []uuid.UUID{dupe.ID})
+	if err != nil {
+		t.Fatalf("ResolveDuplicates: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("deleted = %d, want 1", deleted)
+	}
+	if _, err := repo.GetByID(context.Background(), dupe.ID); err == nil {
+		t.Errorf("duplicate should be gone")
+	}
+	if _, err := repo.GetByID(context.Background(), keep.ID); err != nil {
+		t.Errorf("kept transaction should survive: %v", err)
+	}
+	reloaded, err := budgets.GetByID(context.Background(), b.ID)
+	if err != nil {
+		t.Fatalf("reload budget: %v", err)
+	}
+	if reloaded.Spent != 42.50 {
+		t.Errorf("budget spent = %.2f, want 42.50 after removing one of two", reloaded.Spent)
+	}
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/preferences"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrSpendingLimitExceeded rejects a child's expense that would push
+// their monthly total past the cap configured in the family preferences.
+var ErrSpendingLimitExceeded = errors.New("monthly spending limit exceeded")
+
+// SetSpendingLimits wires the preferences lookup that backs per-member
+// spending caps. Without it every transaction passes the limit check.
+func (s *TransactionService) SetSpendingLimits(preferencesRepo preferences.Repository) {
+	s.preferencesRepo = preferencesRepo
+}
+
+// ValidateTransactionLimits enforces the limits that apply to a new
+// transaction: the per-member monthly spending cap and, under the block
+// policy, budget availability.
+func (s *TransactionService) ValidateTransactionLimits(ctx context.Context, t *transaction.Transaction) error {
+	if err := s.validateSpendingCap(ctx, t); err != nil {
+		return err
+	}
+	return s.validateBudgetAvailability(ctx, t)
+}
+
+// validateSpendingCap enforces the per-member monthly spending cap on t.
+// Only expenses by child accounts are limited: admins and members record
+// freely regardless of any configured cap.
+func (s *TransactionService) validateSpendingCap(ctx context.Context, t *transaction.Transaction) error {
+	if s.preferencesRepo == nil || t.Type != transaction.TypeExpense {
+		return nil
+	}
+	u, err := s.userRepo.GetByID(ctx, t.UserID)
+	if err != nil {
+		return fmt.Errorf("load transaction user: %w", err)
+	}
+	if u.Role != user.RoleChild {
+		return nil
+	}
+	prefs, err := s.preferencesRepo.GetByFamilyID(ctx, t.FamilyID)
+	if errors.Is(err, preferences.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("load preferences: %w", err)
+	}
+	limit := prefs.MemberSpendingLimits[t.UserID]
+	if limit <= 0 {
+		return nil
+	}
+
+	spent, err := s.monthlyExpenseTotal(ctx, t.FamilyID, t.UserID, t.Date)
+	if err != nil {
+		return err
+	}
+	if spent+t.Amount > limit {
+		return fmt.Errorf("%w: cap %.2f, %.2f already spent this month", ErrSpendingLimitExceeded, limit, spent)
+	}
+	return nil
+}
+
+// BudgetLimitPolicy selects what happens when an expense would push an
+// active budget past 100%.
+type BudgetLimitPolicy string
+
+const (
+	// BudgetLimitWarn lets the expense through; callers surface the
+	// overage via CheckBudgetAvailability. The default.
+	BudgetLimitWarn BudgetLimitPolicy = "warn"
+	// BudgetLimitBlock rejects the expense outright.
+	BudgetLimitBlock BudgetLimitPolicy = "block"
+)
+
+// ErrBudgetExceeded tags BudgetExceededError so callers can branch with
+// errors.Is without unpacking the struct.
+var ErrBudgetExceeded = errors.New("budget exceeded")
+
+// BudgetExceededError reports which budget an expense would overrun and
+// by how much.
+type BudgetExceededError struct {
+	BudgetID   uuid.UUID
+	BudgetName string
+	// Amount and Spent are the budget's cap and current spend; Overage
+	// is how far past the cap the expense would land.
+	Amount  float64
+	Spent   float64
+	Overage float64
+}
+
+func (e *BudgetExceededError) Error() string {
+	return fmt.Sprintf("%s: %q would be %.2f over its %.2f cap", ErrBudgetExceeded, e.BudgetName, e.Overage, e.Amount)
+}
+
+// Unwrap lets errors.Is(err, ErrBudgetExceeded) match.
+func (e *BudgetExceededError) Unwrap() error { return ErrBudgetExceeded }
+
+// SetBudgetLimitEnforcement wires budget availability checks into
+// transaction creation. With BudgetLimitBlock an over-budget expense is
+// rejected; with BudgetLimitWarn creation proceeds and the overage is
+// reported only through CheckBudgetAvailability.
+func (s *TransactionService) SetBudgetLimitEnforcement(budgetRepo budget.Repository, policy BudgetLimitPolicy) {
+	s.budgetRepo = budgetRepo
+	if policy == "" {
+		policy = BudgetLimitWarn
+	}
+	s.budgetLimitPolicy = policy
+}
+
+// CheckBudgetAvailability reports whether t would push an active budget
+// covering its date past 100%. A nil *BudgetExceededError means every
+// affected budget has room.
+func (s *TransactionService) CheckBudgetAvailability(ctx context.Context, t *transaction.Transaction) (*BudgetExceededError, error) {
+	if s.budgetRepo == nil || t.Type != transaction.TypeExpense {
+		return nil, nil
+	}
+	budgets, err := s.budgetRepo.GetActiveBudgets(ctx, t.FamilyID)
+	if err != nil {
+		return nil, fmt.Errorf("load active budgets: %w", err)
+	}
+	for _, b := range budgets {
+		if t.Date.Before(b.StartDate) || t.Date.After(b.EndDate) {
+			continue
+		}
+		contribution := budgetContribution(b, t, t.CategoryID)
+		if contribution == 0 {
+			continue
+		}
+		if projected := b.Spent + contribution; projected > b.Amount {
+			return &BudgetExceededError{
+				BudgetID:   b.ID,
+				BudgetName: b.Name,
+				Amount:     b.Amount,
+				Spent:      b.Spent,
+				Overage:    projected - b.Amount,
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// validateBudgetAvailability blocks over-budget expenses under the
+// block policy; under warn it always passes.
+func (s *TransactionService) validateBudgetAvailability(ctx context.Context, t *transaction.Transaction) error {
+	if s.budgetLimitPolicy != BudgetLimitBlock {
+		return nil
+	}
+	exceeded, err := s.CheckBudgetAvailability(ctx, t)
+	if err != nil {
+		return err
+	}
+	if exceeded != nil {
+		return exceeded
+	}
+	return nil
+}
+
+// monthlyExpenseTotal sums a member's expenses in the calendar month of
+// date.
+func (s *TransactionService) monthlyExpenseTotal(ctx context.Context, familyID, userID uuid.UUID, date time.Time) (float64, error) {
+	all, err := s.transactionRepo.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return 0, fmt.Errorf("load family transactions: %w", err)
+	}
+	var total float64
+	for _, t := range all {
+		if t.UserID != userID || t.Type != transaction.TypeExpense {
+			continue
+		}
+		if t.Date.Year() == date.Year() && t.Date.Month() == date.Month() {
+			total += t.Amount
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func newSpendingLimitFixture(t *testing.T) (*TransactionService, *PreferencesService, *memory.UserRepository, uuid.UUID) {
+	t.Helper()
+	users := memory.NewUserRepository()
+	transactions := memory.NewTransactionRepository()
+	preferencesRepo := memory.NewPreferencesRepository()
+	svc := NewTransactionService(transactions, users)
+	svc.SetSpendingLimits(preferencesRepo)
+	prefs := NewPreferencesService(preferencesRepo, transactions)
+	return svc, prefs, users, uuid.New()
+}
+
+func seedRoleMember(t *testing.T, users *memory.UserRepository, familyID uuid.UUID, role user.Role) *user.User {
+	t.Helper()
+	u := &user.User{
+		ID: uuid.New(), Email: uuid.New().String() + "@example.com",
+		Role: role, FamilyID: familyID,
+	}
+	if err := users.Create(context.Background(), u); err != nil {
+		t.Fatalf("seed user: %v", err)
+	}
+	return u
+}
+
+func TestSpendingLimit_ChildUnderLimitSucceeds(t *testing.T) {
+	svc, prefs, users, familyID := newSpendingLimitFixture(t)
+	child := seedRoleMember(t, users, familyID, user.RoleChild)
+	if _, err := prefs.SetMemberSpendingLimit(context.Background(), familyID, child.ID, 100); err != nil {
+		t.Fatalf("SetMemberSpendingLimit: %v", err)
+	}
+
+	tx := expenseFor(familyID, child.ID)
+	tx.Amount = 40
+	if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("first expense under limit: %v", err)
+	}
+	second := expenseFor(familyID, child.ID)
+	second.Amount = 60
+	if err := svc.CreateTransaction(context.Background(), second); err != nil {
+		t.Errorf("expense reaching the cap exactly: %v, want success", err)
+	}
+}
+
+func TestSpendingLimit_ChildOverLimitRejected(t *testing.T) {
+	svc, prefs, users, familyID := newSpendingLimitFixture(t)
+	child := seedRoleMember(t, users, familyID, user.RoleChild)
+	if _, err := prefs.SetMemberSpendingLimit(context.Background(), familyID, child.ID, 100); err != nil {
+		t.Fatalf("SetMemberSpendingLimit: %v", err)
+	}
+
+	tx := expenseFor(familyID, child.ID)
+	tx.Amount = 80
+	if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("seed expense: %v", err)
+	}
+	over := expenseFor(familyID, child.ID)
+	over.Amount = 30
+	if err := svc.CreateTransaction(context.Background(), over); !errors.Is(err, ErrSpendingLimitExceeded) {
+		t.Fatalf("err = %v, want ErrSpendingLimitExceeded", err)
+	}
+
+	// Spending in another month starts from a fresh total.
+	nextMonth := expenseFor(familyID, child.ID)
+	nextMonth.Amount = 30
+	nextMonth.Date = time.Now().AddDate(0, 1, 0)
+	if err := svc.CreateTransaction(context.Background(), nextMonth); err != nil {
+		t.Errorf("next-month expense: %v, want success", err)
+	}
+}
+
+func TestSpendingLimit_AdminNotLimited(t *testing.T) {
+	svc, prefs, users, familyID := newSpendingLimitFixture(t)
+	admin := seedRoleMember(t, users, familyID, user.RoleAdmin)
+	if _, err := prefs.SetMemberSpendingLimit(context.Background(), familyID, admin.ID, 10); err != nil {
+		t.Fatalf("SetMemberSpendingLimit: %v", err)
+	}
+
+	tx := expenseFor(familyID, admin.ID)
+	tx.Amount = 500
+	if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+		t.Errorf("admin expense over their nominal cap: %v, want success", err)
+	}
+
+	// Income never counts against a cap either, even for children.
+	child := seedRoleMember(t, users, familyID, user.RoleChild)
+	if _, err := prefs.SetMemberSpendingLimit(context.Background(), familyID, child.ID, 10); err != nil {
+		t.Fatalf("SetMemberSpendingLimit: %v", err)
+	}
+	income := expenseFor(familyID, child.ID)
+	income.Type = transaction.TypeIncome
+	income.Amount = 500
+	if err := svc.CreateTransaction(context.Background(), income); err != nil {
+		t.Errorf("child income: %v, want success", err)
+	}
+}
+
+func newBudgetLimitFixture(t *testing.T, policy BudgetLimitPolicy) (*TransactionService, *memory.UserRepository, *memory.BudgetRepository, uuid.UUID) {
+	t.Helper()
+	users := memory.NewUserRepository()
+	budgets := memory.NewBudgetRepository()
+	svc := NewTransactionService(memory.NewTransactionRepository(), users)
+	svc.SetBudgetLimitEnforcement(budgets, policy)
+	return svc, users, budgets, uuid.New()
+}
+
+func seedLimitBudget(t *testing.T, budgets *memory.BudgetRepository, familyID, categoryID uuid.UUID, amount, spent float64) *budget.Budget {
+	t.Helper()
+	b := &budget.Budget{
+		ID: uuid.New(), Name: "Groceries", Amount: amount, Spent: spent,
+		CategoryID: &categoryID, FamilyID: familyID,
+		Period: budget.PeriodMonthly, IsActive: true,
+		StartDate: time.Now().AddDate(0, 0, -10), EndDate: time.Now().AddDate(0, 0, 20),
+	}
+	if err := budgets.Create(context.Background(), b); err != nil {
+		t.Fatalf("seed budget: %v", err)
+	}
+	return b
+}
+
+func TestBudgetAvailability_WithinBudgetPasses(t *testing.T) {
+	svc, users, budgets, familyID := newBudgetLimitFixture(t, BudgetLimitBlock)
+	member := seedRoleMember(t, users, familyID, user.RoleMember)
+	b := seedLimitBudget(t, budgets, familyID, uuid.New(), 200, 150)
+
+	tx := expenseFor(familyID, member.ID)
+	tx.CategoryID = *b.CategoryID
+	tx.Amount = 50
+	if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("within-budget expense: %v, want success", err)
+	}
+}
+
+func TestBudgetAvailability_SoftWarningOver(t *testing.T) {
+	svc, users, budgets, familyID := newBudgetLimitFixture(t, BudgetLimitWarn)
+	member := seedRoleMember(t, users, familyID, user.RoleMember)
+	b := seedLimitBudget(t, budgets, familyID, uuid.New(), 200, 180)
+
+	tx := expenseFor(familyID, member.ID)
+	tx.CategoryID = *b.CategoryID
+	tx.Amount = 50
+
+	exceeded, err := svc.CheckBudgetAvailability(context.Background(), tx)
+	if err != nil {
+		t.Fatalf("CheckBudgetAvailability: %v", err)
+	}
+	if exceeded == nil || exceeded.Overage != 30 || exceeded.BudgetID != b.ID {
+		t.Fatalf("exceeded = %+v, want 30 over budget %s", exceeded, b.ID)
+	}
+	// Warn is advisory: creation still goes through.
+	if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+		t.Errorf("over-budget expense under warn policy: %v, want success", err)
+	}
+}
+
+func TestBudgetAvailability_HardBlockOver(t *testing.T) {
+	svc, users, budgets, familyID := newBudgetLimitFixture(t, BudgetLimitBlock)
+	member := seedRoleMember(t, users, familyID, user.RoleMember)
+	b := seedLimitBudget(t, budgets, familyID, uuid.New(), 200, 180)
+
+	tx := expenseFor(familyID, member.ID)
+	tx.CategoryID = *b.CategoryID
+	tx.Amount = 50
+	err := svc.CreateTransaction(context.Background(), tx)
+	if !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("err = %v, want ErrBudgetExceeded", err)
+	}
+	var exceeded *BudgetExceededError
+	if !errors.As(err, &exceeded) || exceeded.Overage != 30 {
+		t.Errorf("exceeded = %+v, want structured 30 overage", exceeded)
+	}
+}
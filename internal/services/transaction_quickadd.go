@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrQuickAddNeedsCategories is returned when quick add must infer the
+// transaction type but no category repository is wired.
+var ErrQuickAddNeedsCategories = errors.New("quick add requires a category repository to infer the type")
+
+// QuickAddTransaction records a minimal entry: amount, category and an
+// optional note. The date defaults to now and the transaction type comes
+// from the category — an income category yields an income transaction —
+// unless override names one explicitly. Everything else (user and family
+// checks, limits, budget accounting) runs through CreateTransaction like
+// any other entry.
+func (s *TransactionService) QuickAddTransaction(
+	ctx context.Context,
+	familyID, userID, categoryID uuid.UUID,
+	amount float64,
+	note string,
+	override transaction.Type,
+) (*transaction.Transaction, error) {
+	txType := override
+	if txType == "" {
+		if s.categoryRepo == nil {
+			return nil, ErrQuickAddNeedsCategories
+		}
+		c, err := s.categoryRepo.GetByID(ctx, categoryID)
+		if err != nil {
+			return nil, fmt.Errorf("load quick-add category: %w", err)
+		}
+		if c.FamilyID != familyID {
+			return nil, fmt.Errorf("%w: %s", ErrCategoryNotInFamily, categoryID)
+		}
+		txType = transaction.Type(c.Type)
+	}
+
+	t := &transaction.Transaction{
+		Amount:      amount,
+		Type:        txType,
+		Description: note,
+		CategoryID:  categoryID,
+		UserID:      userID,
+		FamilyID:    familyID,
+		Date:        time.Now(),
+	}
+	if err := s.CreateTransaction(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
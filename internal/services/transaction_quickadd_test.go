@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func newQuickAddFixture(t *testing.T) (*TransactionService, *memory.UserRepository, *memory.CategoryRepository) {
+	t.Helper()
+	users := memory.NewUserRepository()
+	categories := memory.NewCategoryRepository()
+	svc := NewTransactionService(memory.NewTransactionRepository(), users)
+	svc.SetBulkCategorization(categories, nil)
+	return svc, users, categories
+}
+
+func TestQuickAddTransaction_CategoryTypeDrivesTransactionType(t *testing.T) {
+	svc, users, categories := newQuickAddFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+	salary := &category.Category{
+		ID: uuid.New(), Name: "Salary", Type: category.TypeIncome,
+		FamilyID: familyID, IsActive: true,
+	}
+	if err := categories.Create(context.Background(), salary); err != nil {
+		t.Fatalf("seed category: %v", err)
+	}
+
+	tx, err := svc.QuickAddTransaction(context.Background(), familyID, u.ID, salary.ID, 1200, "august salary", "")
+	if err != nil {
+		t.Fatalf("QuickAddTransaction() error = %v", err)
+	}
+	if tx.Type != transaction.TypeIncome {
+		t.Errorf("Type = %q, want income inferred from the category", tx.Type)
+	}
+	if tx.Amount != 1200 || tx.Description != "august salary" {
+		t.Errorf("stored %v %q, want 1200 %q", tx.Amount, tx.Description, "august salary")
+	}
+	if tx.Date.IsZero() {
+		t.Error("Date not defaulted to today")
+	}
+}
+
+func TestQuickAddTransaction_ExplicitTypeOverridesCategory(t *testing.T) {
+	svc, users, categories := newQuickAddFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+	groceries := seedCarryCategory(t, categories, familyID, "Groceries", false)
+
+	tx, err := svc.QuickAddTransaction(
+		context.Background(), familyID, u.ID, groceries.ID, 80, "refund", transaction.TypeIncome)
+	if err != nil {
+		t.Fatalf("QuickAddTransaction() error = %v", err)
+	}
+	if tx.Type != transaction.TypeIncome {
+		t.Errorf("Type = %q, want the explicit income override, not the category's expense", tx.Type)
+	}
+}
+
+func TestQuickAddTransaction_RejectsForeignCategory(t *testing.T) {
+	svc, users, categories := newQuickAddFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+	other := seedCarryCategory(t, categories, uuid.New(), "Other family", false)
+
+	_, err := svc.QuickAddTransaction(context.Background(), familyID, u.ID, other.ID, 10, "", "")
+	if !errors.Is(err, ErrCategoryNotInFamily) {
+		t.Fatalf("QuickAddTransaction() error = %v, want ErrCategoryNotInFamily", err)
+	}
+}
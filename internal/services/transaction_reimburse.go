@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrNotReimbursable rejects reimbursement operations on transactions
+// that cannot carry them: incomes, or expenses never marked reimbursable.
+var ErrNotReimbursable = errors.New("transaction is not reimbursable")
+
+// MarkReimbursable flags or unflags an expense as awaiting
+// reimbursement. Only expenses can be reimbursable; clearing the flag
+// also clears any reimbursement link.
+func (s *TransactionService) MarkReimbursable(ctx context.Context, id uuid.UUID, reimbursable bool) (*transaction.Transaction, error) {
+	t, err := s.transactionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Type != transaction.TypeExpense {
+		return nil, ErrNotReimbursable
+	}
+	t.Reimbursable = reimbursable
+	if !reimbursable {
+		t.ReimbursedBy = nil
+	}
+	t.UpdatedAt = time.Now()
+	if err := s.transactionRepo.Update(ctx, t); err != nil {
+		return nil, fmt.Errorf("update transaction: %w", err)
+	}
+	return t, nil
+}
+
+// MarkReimbursed settles a reimbursable expense by linking the income
+// transaction that paid it back. Both transactions must belong to the
+// same family.
+func (s *TransactionService) MarkReimbursed(ctx context.Context, id, reimbursedBy uuid.UUID) (*transaction.Transaction, error) {
+	t, err := s.transactionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !t.Reimbursable {
+		return nil, ErrNotReimbursable
+	}
+	settling, err := s.transactionRepo.GetByID(ctx, reimbursedBy)
+	if err != nil {
+		return nil, fmt.Errorf("load reimbursing transaction: %w", err)
+	}
+	if settling.FamilyID != t.FamilyID {
+		return nil, fmt.Errorf("%w: %s", ErrCrossFamilyTransaction, reimbursedBy)
+	}
+	t.ReimbursedBy = &settling.ID
+	t.UpdatedAt = time.Now()
+	if err := s.transactionRepo.Update(ctx, t); err != nil {
+		return nil, fmt.Errorf("update transaction: %w", err)
+	}
+	return t, nil
+}
+
+// ReimbursableReport lists a family's expenses still awaiting
+// reimbursement and their total.
+type ReimbursableReport struct {
+	Transactions []*transaction.Transaction
+	Outstanding  float64
+}
+
+// GetOutstandingReimbursables returns the family's reimbursable expenses
+// that have not been settled yet.
+func (s *TransactionService) GetOutstandingReimbursables(ctx context.Context, familyID uuid.UUID) (*ReimbursableReport, error) {
+	transactions, err := s.transactionRepo.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load family transactions: %w", err)
+	}
+	report := &ReimbursableReport{}
+	for _, t := range transactions {
+		if !t.Reimbursable || t.ReimbursedBy != nil {
+			continue
+		}
+		report.Transactions = append(report.Transactions, t)
+		report.Outstanding += t.Amount
+	}
+	return report, nil
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestReimbursable_OutstandingTotal(t *testing.T) {
+	svc, users, _ := newTransactionServiceFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+
+	fronted := expenseFor(familyID, u.ID)
+	fronted.Amount = 90
+	settled := expenseFor(familyID, u.ID)
+	settled.Amount = 40
+	payback := expenseFor(familyID, u.ID)
+	payback.Type = transaction.TypeIncome
+	payback.Amount = 40
+	plain := expenseFor(familyID, u.ID)
+	for _, tx := range []*transaction.Transaction{fronted, settled, payback, plain} {
+		if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+	}
+	for _, id := range []uuid.UUID{fronted.ID, settled.ID} {
+		if _, err := svc.MarkReimbursable(context.Background(), id, true); err != nil {
+			t.Fatalf("MarkReimbursable: %v", err)
+		}
+	}
+	if _, err := svc.MarkReimbursed(context.Background(), settled.ID, payback.ID); err != nil {
+		t.Fatalf("MarkReimbursed: %v", err)
+	}
+
+	report, err := svc.GetOutstandingReimbursables(context.Background(), familyID)
+	if err != nil {
+		t.Fatalf("GetOutstandingReimbursables: %v", err)
+	}
+	if len(report.Transactions) != 1 || report.Transactions[0].ID != fronted.ID || report.Outstanding != 90 {
+		t.Errorf("outstanding = %+v (total %v), want only the unsettled 90", report.Transactions, report.Outstanding)
+	}
+
+	// Income transactions cannot be marked reimbursable.
+	if _, err := svc.MarkReimbursable(context.Background(), payback.ID, true); !errors.Is(err, ErrNotReimbursable) {
+		t.Errorf("err = %v, want ErrNotReimbursable for income", err)
+	}
+}
+
+func TestReimbursable_ExclusionToggleAffectsExpenseReport(t *testing.T) {
+	users := memory.NewUserRepository()
+	transactions := memory.NewTransactionRepository()
+	preferencesRepo := memory.NewPreferencesRepository()
+	txService := NewTransactionService(transactions, users)
+	reportService := NewReportService(memory.NewReportRepository(), transactions, nil, nil, users)
+	reportService.SetPreferencesRepository(preferencesRepo)
+	prefsService := NewPreferencesService(preferencesRepo, transactions)
+
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+	settled := expenseFor(familyID, u.ID)
+	settled.Amount = 60
+	payback := expenseFor(familyID, u.ID)
+	payback.Type = transaction.TypeIncome
+	payback.Amount = 60
+	plain := expenseFor(familyID, u.ID)
+	plain.Amount = 100
+	for _, tx := range []*transaction.Transaction{settled, payback, plain} {
+		if err := txService.CreateTransaction(context.Background(), tx); err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+	}
+	if _, err := txService.MarkReimbursable(context.Background(), settled.ID, true); err != nil {
+		t.Fatalf("MarkReimbursable: %v", err)
+	}
+	if _, err := txService.MarkReimbursed(context.Background(), settled.ID, payback.ID); err != nil {
+		t.Fatalf("MarkReimbursed: %v", err)
+	}
+
+	req := dto.ReportRequestDTO{
+		FamilyID:  familyID,
+		StartDate: time.Now().AddDate(0, 0, -1),
+		EndDate:   time.Now().AddDate(0, 0, 1),
+	}
+	report, err := reportService.GenerateExpenseReport(context.Background(), req)
+	if err != nil || report.TotalExpenses != 160 {
+		t.Fatalf("default total = %v, %v; want 160 with reimbursed counted", report.TotalExpenses, err)
+	}
+
+	if _, err := prefsService.UpdateSettings(context.Background(), familyID, FamilySettings{ExcludeReimbursed: true}); err != nil {
+		t.Fatalf("UpdateSettings: %v", err)
+	}
+	report, err = reportService.GenerateExpenseReport(context.Background(), req)
+	if err != nil || report.TotalExpenses != 100 {
+		t.Errorf("excluded total = %v, %v; want 100 without the reimbursed expense", report.TotalExpenses, err)
+	}
+}
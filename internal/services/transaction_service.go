@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/preferences"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrUserNotInFamily rejects transactions whose user does not belong to
+// the transaction's family. Without this check reports end up attributing
+// spending to "Unknown" members.
+var ErrUserNotInFamily = errors.New("transaction user does not belong to the family")
+
+// TransactionService manages transactions and the queries over them.
+type TransactionService struct {
+	transactionRepo transaction.Repository
+	userRepo        user.Repository
+
+	// familyRepo and rates enable foreign-currency transactions; both are
+	// nil until SetCurrencyConversion is called, in which case only
+	// base-currency transactions are accepted.
+	familyRepo user.FamilyRepository
+	rates      RateLookup
+	// categoryRepo and budgetRepo back bulk categorization; nil until
+	// wired via SetBulkCategorization.
+	categoryRepo category.Repository
+	budgetRepo   budget.Repository
+	// attachments, when set, removes a transaction's attachments
+	// whenever the transaction itself is deleted.
+	attachments AttachmentCleaner
+	// preferencesRepo backs per-member spending caps; nil until wired
+	// via SetSpendingLimits.
+	preferencesRepo preferences.Repository
+	// budgetLimitPolicy decides whether over-budget expenses are blocked
+	// or merely reported; set via SetBudgetLimitEnforcement.
+	budgetLimitPolicy BudgetLimitPolicy
+	// trashRetention is how long soft-deleted transactions stay
+	// restorable; zero means DefaultTrashRetention.
+	trashRetention time.Duration
+}
+
+// AttachmentCleaner removes every attachment of a deleted transaction.
+// AttachmentService implements it.
+type AttachmentCleaner interface {
+	DeleteTransactionAttachments(ctx context.Context, transactionID uuid.UUID) error
+}
+
+// SetAttachmentCleaner wires attachment cleanup into transaction
+// deletion.
+func (s *TransactionService) SetAttachmentCleaner(cleaner AttachmentCleaner) {
+	s.attachments = cleaner
+}
+
+// NewTransactionService builds a TransactionService.
+func NewTransactionService(transactionRepo transaction.Repository, userRepo user.Repository) *TransactionService {
+	return &TransactionService{transactionRepo: transactionRepo, userRepo: userRepo}
+}
+
+// SetCurrencyConversion enables foreign-currency transactions: amounts
+// recorded with an OriginalCurrency are converted into the family's base
+// currency via rates before they are stored.
+func (s *TransactionService) SetCurrencyConversion(familyRepo user.FamilyRepository, rates RateLookup) {
+	s.familyRepo = familyRepo
+	s.rates = rates
+}
+
+// CreateTransaction validates and persists a transaction. The transaction
+// must reference an existing user from the same family; anything else is
+// rejected up front instead of surfacing later as an "Unknown" member in
+// reports.
+func (s *TransactionService) CreateTransaction(ctx context.Context, t *transaction.Transaction) error {
+	if err := s.validateTransactionUser(ctx, t); err != nil {
+		return err
+	}
+	if err := t.ValidateType(); err != nil {
+		return err
+	}
+	if err := t.ValidateSplits(); err != nil {
+		return err
+	}
+	if err := s.convertCurrency(ctx, t); err != nil {
+		return err
+	}
+	if err := s.ValidateTransactionLimits(ctx, t); err != nil {
+		return err
+	}
+	t.Tags = normalizeTransactionTags(t.Tags)
+	if t.ID.IsNil() {
+		t.ID = uuid.New()
+	}
+	now := time.Now()
+	t.CreatedAt = now
+	t.UpdatedAt = now
+	if err := s.transactionRepo.Create(ctx, t); err != nil {
+		return fmt.Errorf("create transaction: %w", err)
+	}
+	return nil
+}
+
+// validateTransactionUser checks that the transaction's user exists and
+// belongs to the transaction's family.
+func (s *TransactionService) validateTransactionUser(ctx context.Context, t *transaction.Transaction) error {
+	if t.UserID.IsNil() {
+		return ErrUserNotInFamily
+	}
+	u, err := s.userRepo.GetByID(ctx, t.UserID)
+	if errors.Is(err, user.ErrNotFound) {
+		return ErrUserNotInFamily
+	}
+	if err != nil {
+		return fmt.Errorf("load transaction user: %w", err)
+	}
+	if u.FamilyID != t.FamilyID {
+		return ErrUserNotInFamily
+	}
+	return nil
+}
+
+// convertCurrency fills Amount from OriginalAmount when the transaction
+// was recorded in a foreign currency. Transactions in the family's base
+// currency (or with no OriginalCurrency) pass through untouched.
+func (s *TransactionService) convertCurrency(ctx context.Context, t *transaction.Transaction) error {
+	if t.OriginalCurrency == "" {
+		return nil
+	}
+	if s.familyRepo == nil || s.rates == nil {
+		return fmt.Errorf("%w: %s", ErrRateUnavailable, t.OriginalCurrency)
+	}
+	f, err := s.familyRepo.GetByID(ctx, t.FamilyID)
+	if err != nil {
+		return fmt.Errorf("load family for currency conversion: %w", err)
+	}
+	if t.OriginalCurrency == f.Currency {
+		t.OriginalCurrency = ""
+		t.OriginalAmount = 0
+		t.ExchangeRate = 0
+		return nil
+	}
+	rate, err := s.rates.Rate(ctx, t.OriginalCurrency, f.Currency)
+	if err != nil {
+		return err
+	}
+	t.ExchangeRate = rate
+	// Round to cents so converted amounts do not carry float artifacts
+	// into budget and report aggregation.
+	t.Amount = RoundAmount(t.OriginalAmount * rate)
+	return nil
+}
+
+// GetTransactionByID returns a single transaction.
+func (s *TransactionService) GetTransactionByID(ctx context.Context, id uuid.UUID) (*transaction.Transaction, error) {
+	return s.transactionRepo.GetByID(ctx, id)
+}
+
+// GetTransactionsByFamily returns every transaction of a family.
+func (s *TransactionService) GetTransactionsByFamily(ctx context.Context, familyID uuid.UUID) ([]*transaction.Transaction, error) {
+	return s.transactionRepo.GetByFamilyID(ctx, familyID)
+}
+
+// FamilyStatistics are the lifetime aggregates shown on the family
+// overview page.
+type FamilyStatistics struct {
+	transaction.FamilyStats
+	MemberCount int
+}
+
+// GetFamilyStatistics returns a family's lifetime statistics: transaction
+// aggregates from the repository plus the member count.
+func (s *TransactionService) GetFamilyStatistics(ctx context.Context, familyID uuid.UUID) (*FamilyStatistics, error) {
+	stats, err := s.transactionRepo.GetFamilyStats(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate transactions: %w", err)
+	}
+	members, err := s.userRepo.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load family members: %w", err)
+	}
+	return &FamilyStatistics{FamilyStats: *stats, MemberCount: len(members)}, nil
+}
+
+// GetTransactionsByFilter returns the transactions matching the filter.
+// Every category and user ID in the filter is applied; the legacy single
+// CategoryID/UserID fields are merged into the plural forms.
+func (s *TransactionService) GetTransactionsByFilter(ctx context.Context, filters dto.TransactionFilterDTO) ([]*transaction.Transaction, error) {
+	result, err := s.transactionRepo.GetByFilter(ctx, filters.ToDomainFilter())
+	if err != nil {
+		return nil, fmt.Errorf("filter transactions: %w", err)
+	}
+	return result, nil
+}
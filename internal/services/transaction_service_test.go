@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func newTransactionServiceFixture(t *testing.T) (*TransactionService, *memory.UserRepository, *memory.TransactionRepository) {
+	t.Helper()
+	users := memory.NewUserRepository()
+	transactions := memory.NewTransactionRepository()
+	return NewTransactionService(transactions, users), users, transactions
+}
+
+func seedFamilyUser(t *testing.T, users *memory.UserRepository, familyID uuid.UUID) *user.User {
+	t.Helper()
+	u := &user.User{
+		ID: uuid.New(), Email: uuid.New().String() + "@example.com",
+		FirstName: "Alice", LastName: "Smith",
+		Role: user.RoleMember, FamilyID: familyID,
+	}
+	if err := users.Create(context.Background(), u); err != nil {
+		t.Fatalf("seed user: %v", err)
+	}
+	return u
+}
+
+func expenseFor(familyID, userID uuid.UUID) *transaction.Transaction {
+	return &transaction.Transaction{
+		Amount:      25,
+		Type:        transaction.TypeExpense,
+		Description: "Lunch",
+		CategoryID:  uuid.New(),
+		UserID:      userID,
+		FamilyID:    familyID,
+		Date:        time.Now(),
+	}
+}
+
+func TestGetFamilyStatistics_AggregatesSeededData(t *testing.T) {
+	svc, users, transactions := newTransactionServiceFixture(t)
+	familyID := uuid.New()
+	seedFamilyUser(t, users, familyID)
+	seedFamilyUser(t, users, familyID)
+	food := uuid.New()
+	salary := uuid.New()
+	oldest := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	for _, tx := range []*transaction.Transaction{
+		{ID: uuid.New(), Amount: 3000, Type: transaction.TypeIncome, CategoryID: salary, UserID: uuid.New(), FamilyID: familyID, Date: oldest},
+		{ID: uuid.New(), Amount: 50, Type: transaction.TypeExpense, CategoryID: food, UserID: uuid.New(), FamilyID: familyID, Date: oldest.AddDate(0, 1, 0)},
+		{ID: uuid.New(), Amount: 70, Type: transaction.TypeExpense, CategoryID: food, UserID: uuid.New(), FamilyID: familyID, Date: oldest.AddDate(0, 2, 0)},
+	} {
+		if err := transactions.Create(context.Background(), tx); err != nil {
+			t.Fatalf("seed transaction: %v", err)
+		}
+	}
+
+	stats, err := svc.GetFamilyStatistics(context.Background(), familyID)
+	if err != nil {
+		t.Fatalf("GetFamilyStatistics: %v", err)
+	}
+	if stats.TransactionCount != 3 || stats.TotalIncome != 3000 || stats.TotalExpenses != 120 {
+		t.Errorf("totals = %+v, want 3 transactions, 3000 income, 120 expenses", stats.FamilyStats)
+	}
+	if stats.MemberCount != 2 {
+		t.Errorf("MemberCount = %d, want 2", stats.MemberCount)
+	}
+	if stats.OldestTransaction == nil || !stats.OldestTransaction.Equal(oldest) {
+		t.Errorf("OldestTransaction = %v, want %v", stats.OldestTransaction, oldest)
+	}
+	if stats.MostUsedCategoryID != food {
+		t.Errorf("MostUsedCategoryID = %v, want the food category", stats.MostUsedCategoryID)
+	}
+}
+
+func TestGetFamilyStatistics_EmptyFamily(t *testing.T) {
+	svc, _, _ := newTransactionServiceFixture(t)
+
+	stats, err := svc.GetFamilyStatistics(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("GetFamilyStatistics: %v", err)
+	}
+	if stats.TransactionCount != 0 || stats.OldestTransaction != nil || !stats.MostUsedCategoryID.IsNil() {
+		t.Errorf("stats = %+v, want zero values for an empty family", stats)
+	}
+}
+
+func TestCreateTransaction_FamilyUserAccepted(t *testing.T) {
+	svc, users, transactions := newTransactionServiceFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+
+	tx := expenseFor(familyID, u.ID)
+	if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+	if tx.ID.IsNil() {
+		t.Error("CreateTransaction left the ID unset")
+	}
+	stored, err := transactions.GetByFamilyID(context.Background(), familyID)
+	if err != nil || len(stored) != 1 {
+		t.Errorf("stored = %d, %v; want 1", len(stored), err)
+	}
+}
+
+func TestCreateTransaction_ThreeWaySplitAccepted(t *testing.T) {
+	svc, users, transactions := newTransactionServiceFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+
+	tx := expenseFor(familyID, u.ID)
+	tx.Amount = 100
+	tx.Splits = []transaction.Split{
+		{CategoryID: uuid.New(), Amount: 55.50},
+		{CategoryID: uuid.New(), Amount: 24.50},
+		{CategoryID: uuid.New(), Amount: 20},
+	}
+	if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+
+	stored, err := transactions.GetByID(context.Background(), tx.ID)
+	if err != nil || len(stored.Splits) != 3 {
+		t.Errorf("stored splits = %d, %v; want 3", len(stored.Splits), err)
+	}
+}
+
+func TestCreateTransaction_MismatchedSplitRejected(t *testing.T) {
+	svc, users, _ := newTransactionServiceFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+
+	tx := expenseFor(familyID, u.ID)
+	tx.Amount = 100
+	tx.Splits = []transaction.Split{
+		{CategoryID: uuid.New(), Amount: 60},
+		{CategoryID: uuid.New(), Amount: 30},
+	}
+	if err := svc.CreateTransaction(context.Background(), tx); !errors.Is(err, transaction.ErrSplitSumMismatch) {
+		t.Errorf("err = %v, want ErrSplitSumMismatch for a 90/100 split", err)
+	}
+}
+
+func TestCreateTransaction_NonFamilyUserRejected(t *testing.T) {
+	svc, users, _ := newTransactionServiceFixture(t)
+	familyID := uuid.New()
+	outsider := seedFamilyUser(t, users, uuid.New()) // different family
+
+	err := svc.CreateTransaction(context.Background(), expenseFor(familyID, outsider.ID))
+	if !errors.Is(err, ErrUserNotInFamily) {
+		t.Errorf("err = %v, want ErrUserNotInFamily", err)
+	}
+}
+
+func TestCreateTransaction_UnknownUserRejected(t *testing.T) {
+	svc, _, _ := newTransactionServiceFixture(t)
+
+	err := svc.CreateTransaction(context.Background(), expenseFor(uuid.New(), uuid.New()))
+	if !errors.Is(err, ErrUserNotInFamily) {
+		t.Errorf("unknown user err = %v, want ErrUserNotInFamily", err)
+	}
+
+	err = svc.CreateTransaction(context.Background(), expenseFor(uuid.New(), uuid.Nil))
+	if !errors.Is(err, ErrUserNotInFamily) {
+		t.Errorf("nil user err = %v, want ErrUserNotInFamily", err)
+	}
+}
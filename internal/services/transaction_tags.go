@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// AddTransactionTags attaches tags to a transaction. Tags are normalized
+// (lowercased, trimmed); empty and already-present tags are skipped, so
+// tagging is idempotent.
+func (s *TransactionService) AddTransactionTags(ctx context.Context, id uuid.UUID, tags ...string) (*transaction.Transaction, error) {
+	t, err := s.transactionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	changed := false
+	for _, tag := range tags {
+		normalized := transaction.NormalizeTag(tag)
+		if normalized == "" || hasTag(t.Tags, normalized) {
+			continue
+		}
+		t.Tags = append(t.Tags, normalized)
+		changed = true
+	}
+	if !changed {
+		return t, nil
+	}
+	t.UpdatedAt = time.Now()
+	if err := s.transactionRepo.Update(ctx, t); err != nil {
+		return nil, fmt.Errorf("update transaction tags: %w", err)
+	}
+	return t, nil
+}
+
+// RemoveTransactionTag detaches one tag from a transaction. Removing an
+// absent tag is a no-op.
+func (s *TransactionService) RemoveTransactionTag(ctx context.Context, id uuid.UUID, tag string) (*transaction.Transaction, error) {
+	t, err := s.transactionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	normalized := transaction.NormalizeTag(tag)
+	kept := t.Tags[:0]
+	for _, existing := range t.Tags {
+		if existing != normalized {
+			kept = append(kept, existing)
+		}
+	}
+	if len(kept) == len(t.Tags) {
+		return t, nil
+	}
+	t.Tags = kept
+	t.UpdatedAt = time.Now()
+	if err := s.transactionRepo.Update(ctx, t); err != nil {
+		return nil, fmt.Errorf("update transaction tags: %w", err)
+	}
+	return t, nil
+}
+
+// TagTotal aggregates a family's transactions sharing one tag.
+type TagTotal struct {
+	Tag      string
+	Income   float64
+	Expenses float64
+	Count    int
+}
+
+// GetTagTotals groups a family's transactions by tag. A transaction
+// carrying several tags counts toward each of them. Totals come back
+// sorted by tag name for stable rendering.
+func (s *TransactionService) GetTagTotals(ctx context.Context, familyID uuid.UUID) ([]TagTotal, error) {
+	transactions, err := s.transactionRepo.GetByFamilyID(ctx, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("load family transactions: %w", err)
+	}
+	byTag := make(map[string]*TagTotal)
+	for _, t := range transactions {
+		for _, tag := range t.Tags {
+			total, ok := byTag[tag]
+			if !ok {
+				total = &TagTotal{Tag: tag}
+				byTag[tag] = total
+			}
+			switch t.Type {
+			case transaction.TypeIncome:
+				total.Income += t.Amount
+			case transaction.TypeExpense:
+				total.Expenses += t.Amount
+			}
+			total.Count++
+		}
+	}
+	out := make([]TagTotal, 0, len(byTag))
+	for _, total := range byTag {
+		out = append(out, *total)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Tag < out[j].Tag })
+	return out, nil
+}
+
+// normalizeTransactionTags canonicalizes the tags recorded on a new
+// transaction, dropping empties and duplicates.
+func normalizeTransactionTags(tags []string) []string {
+	var out []string
+	for _, tag := range tags {
+		normalized := transaction.NormalizeTag(tag)
+		if normalized == "" || hasTag(out, normalized) {
+			continue
+		}
+		out = append(out, normalized)
+	}
+	return out
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, existing := range tags {
+		if existing == tag {
+			return true
+		}
+	}
+	return false
+}
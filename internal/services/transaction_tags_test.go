@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestTags_AddNormalizesAndDeduplicates(t *testing.T) {
+	svc, users, _ := newTransactionServiceFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+	tx := expenseFor(familyID, u.ID)
+	tx.Tags = []string{" Vacation-2024 ", "REIMBURSABLE"}
+	if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+	if len(tx.Tags) != 2 || tx.Tags[0] != "vacation-2024" || tx.Tags[1] != "reimbursable" {
+		t.Fatalf("tags after create = %v, want normalized", tx.Tags)
+	}
+
+	tagged, err := svc.AddTransactionTags(context.Background(), tx.ID, "Vacation-2024", "  ", "food")
+	if err != nil {
+		t.Fatalf("AddTransactionTags: %v", err)
+	}
+	if len(tagged.Tags) != 3 || tagged.Tags[2] != "food" {
+		t.Errorf("tags after add = %v, want the duplicate and blank skipped, food appended", tagged.Tags)
+	}
+
+	removed, err := svc.RemoveTransactionTag(context.Background(), tx.ID, " REIMBURSABLE ")
+	if err != nil {
+		t.Fatalf("RemoveTransactionTag: %v", err)
+	}
+	if len(removed.Tags) != 2 || hasTag(removed.Tags, "reimbursable") {
+		t.Errorf("tags after remove = %v, want reimbursable gone", removed.Tags)
+	}
+}
+
+func TestTags_FilterByTag(t *testing.T) {
+	svc, users, _ := newTransactionServiceFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+
+	vacation := expenseFor(familyID, u.ID)
+	vacation.Tags = []string{"vacation-2024"}
+	plain := expenseFor(familyID, u.ID)
+	for _, tx := range []*transaction.Transaction{vacation, plain} {
+		if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+	}
+
+	matched, err := svc.GetTransactionsByFilter(context.Background(), dto.TransactionFilterDTO{
+		FamilyID: familyID,
+		Tags:     []string{" Vacation-2024 "},
+	})
+	if err != nil {
+		t.Fatalf("GetTransactionsByFilter: %v", err)
+	}
+	if len(matched) != 1 || matched[0].ID != vacation.ID {
+		t.Errorf("filtered = %d transactions, want only the tagged one", len(matched))
+	}
+}
+
+func TestTags_TagTotals(t *testing.T) {
+	svc, users, _ := newTransactionServiceFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+
+	first := expenseFor(familyID, u.ID)
+	first.Amount = 120
+	first.Tags = []string{"vacation-2024"}
+	second := expenseFor(familyID, u.ID)
+	second.Amount = 80
+	second.Tags = []string{"vacation-2024", "reimbursable"}
+	refund := expenseFor(familyID, u.ID)
+	refund.Type = transaction.TypeIncome
+	refund.Amount = 50
+	refund.Tags = []string{"reimbursable"}
+	for _, tx := range []*transaction.Transaction{first, second, refund} {
+		if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+	}
+
+	totals, err := svc.GetTagTotals(context.Background(), familyID)
+	if err != nil {
+		t.Fatalf("GetTagTotals: %v", err)
+	}
+	if len(totals) != 2 {
+		t.Fatalf("totals = %+v, want 2 tags", totals)
+	}
+	// Sorted by tag: reimbursable first, then vacation-2024.
+	if totals[0].Tag != "reimbursable" || totals[0].Expenses != 80 || totals[0].Income != 50 || totals[0].Count != 2 {
+		t.Errorf("reimbursable total = %+v, want 80 expenses, 50 income over 2 transactions", totals[0])
+	}
+	if totals[1].Tag != "vacation-2024" || totals[1].Expenses != 200 || totals[1].Count != 2 {
+		t.Errorf("vacation total = %+v, want 200 expenses over 2 transactions", totals[1])
+	}
+}
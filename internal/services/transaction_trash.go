@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/pkg/uuid"
+)
+
+// DefaultTrashRetention is how long soft-deleted transactions stay
+// restorable before the purge removes them for good.
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+// SetTrashRetention overrides how long trashed transactions are kept.
+// Non-positive values restore the default.
+func (s *TransactionService) SetTrashRetention(d time.Duration) {
+	if d <= 0 {
+		d = DefaultTrashRetention
+	}
+	s.trashRetention = d
+}
+
+// DeleteTransaction moves a transaction to the trash: queries and
+// aggregations stop seeing it, expense contributions leave their
+// budgets, but the record stays restorable until the purge.
+func (s *TransactionService) DeleteTransaction(ctx context.Context, id uuid.UUID) error {
+	t, err := s.transactionRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if t.DeletedAt != nil {
+		return transaction.ErrNotFound
+	}
+	now := time.Now()
+	t.DeletedAt = &now
+	t.UpdatedAt = now
+	if err := s.transactionRepo.Update(ctx, t); err != nil {
+		return fmt.Errorf("trash transaction: %w", err)
+	}
+	return s.subtractFromBudgets(ctx, t)
+}
+
+// RestoreTransaction brings a trashed transaction back, re-adding its
+// expense contribution to the budgets covering it. Restoring a live
+// transaction is a no-op.
+func (s *TransactionService) RestoreTransaction(ctx context.Context, id uuid.UUID) (*transaction.Transaction, error) {
+	t, err := s.transactionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.DeletedAt == nil {
+		return t, nil
+	}
+	t.DeletedAt = nil
+	t.UpdatedAt = time.Now()
+	if err := s.transactionRepo.Update(ctx, t); err != nil {
+		return nil, fmt.Errorf("restore transaction: %w", err)
+	}
+	if err := s.addToBudgets(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// PurgeExpired hard-deletes trashed transactions older than the trash
+// retention window, attachments included. It implements the scheduler's
+// RetentionPurger so the purge runs on the scheduler tick.
+func (s *TransactionService) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	retention := s.trashRetention
+	if retention <= 0 {
+		retention = DefaultTrashRetention
+	}
+	trashed, err := s.transactionRepo.GetByFilter(ctx, transaction.Filter{IncludeDeleted: true})
+	if err != nil {
+		return 0, fmt.Errorf("load transactions: %w", err)
+	}
+	purged := 0
+	for _, t := range trashed {
+		if t.DeletedAt == nil || now.Sub(*t.DeletedAt) < retention {
+			continue
+		}
+		if err := s.transactionRepo.Delete(ctx, t.ID); err != nil {
+			return purged, fmt.Errorf("purge transaction %s: %w", t.ID, err)
+		}
+		if s.attachments != nil {
+			if err := s.attachments.DeleteTransactionAttachments(ctx, t.ID); err != nil {
+				return purged, fmt.Errorf("purge attachments of %s: %w", t.ID, err)
+			}
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// addToBudgets mirrors subtractFromBudgets for restores: a restored
+// expense contributes to the active budgets covering it again.
+func (s *TransactionService) addToBudgets(ctx context.Context, t *transaction.Transaction) error {
+	if s.budgetRepo == nil || t.Type != transaction.TypeExpense {
+		return nil
+	}
+	budgets, err := s.budgetRepo.GetActiveBudgets(ctx, t.FamilyID)
+	if err != nil {
+		return fmt.Errorf("load active budgets: %w", err)
+	}
+	for _, b := range budgets {
+		if t.Date.Before(b.StartDate) || t.Date.After(b.EndDate) {
+			continue
+		}
+		amount := budgetContribution(b, t, t.CategoryID)
+		if amount == 0 {
+			continue
+		}
+		b.Spent = AddAmounts(b.Spent, amount)
+		b.UpdatedAt = time.Now()
+		if err := s.budgetRepo.Update(ctx, b); err != nil {
+			return fmt.Errorf("update budget spend: %w", err)
+		}
+	}
+	return nil
+}
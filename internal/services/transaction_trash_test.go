@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func newTrashFixture(t *testing.T) (*TransactionService, *memory.BudgetRepository, uuid.UUID, uuid.UUID) {
+	t.Helper()
+	users := memory.NewUserRepository()
+	budgets := memory.NewBudgetRepository()
+	svc := NewTransactionService(memory.NewTransactionRepository(), users)
+	svc.SetBulkCategorization(memory.NewCategoryRepository(), budgets)
+	familyID := uuid.New()
+	member := seedRoleMember(t, users, familyID, "member")
+	return svc, budgets, familyID, member.ID
+}
+
+func TestTrash_DeleteHidesFromList(t *testing.T) {
+	svc, _, familyID, userID := newTrashFixture(t)
+	tx := expenseFor(familyID, userID)
+	if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+
+	if err := svc.DeleteTransaction(context.Background(), tx.ID); err != nil {
+		t.Fatalf("DeleteTransaction: %v", err)
+	}
+	listed, err := svc.GetTransactionsByFamily(context.Background(), familyID)
+	if err != nil || len(listed) != 0 {
+		t.Fatalf("list after delete = %d, %v; want empty", len(listed), err)
+	}
+	// Trashing twice reports not found, not a silent success.
+	if err := svc.DeleteTransaction(context.Background(), tx.ID); !errors.Is(err, transaction.ErrNotFound) {
+		t.Errorf("second delete err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestTrash_RestoreBringsBack(t *testing.T) {
+	svc, _, familyID, userID := newTrashFixture(t)
+	tx := expenseFor(familyID, userID)
+	if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+	if err := svc.DeleteTransaction(context.Background(), tx.ID); err != nil {
+		t.Fatalf("DeleteTransaction: %v", err)
+	}
+
+	restored, err := svc.RestoreTransaction(context.Background(), tx.ID)
+	if err != nil || restored.DeletedAt != nil {
+		t.Fatalf("RestoreTransaction = %+v, %v; want live transaction", restored, err)
+	}
+	listed, err := svc.GetTransactionsByFamily(context.Background(), familyID)
+	if err != nil || len(listed) != 1 {
+		t.Fatalf("list after restore = %d, %v; want the transaction back", len(listed), err)
+	}
+}
+
+func TestTrash_BudgetRecalculatedOnDeleteAndRestore(t *testing.T) {
+	svc, budgets, familyID, userID := newTrashFixture(t)
+	categoryID := uuid.New()
+	b := seedLimitBudget(t, budgets, familyID, categoryID, 500, 0)
+
+	tx := expenseFor(familyID, userID)
+	tx.CategoryID = categoryID
+	tx.Amount = 80
+	if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+	// The budget already carries the expense's contribution.
+	b.Spent = 80
+	if err := budgets.Update(context.Background(), b); err != nil {
+		t.Fatalf("seed budget spend: %v", err)
+	}
+	spent := func() float64 {
+		t.Helper()
+		current, err := budgets.GetByID(context.Background(), b.ID)
+		if err != nil {
+			t.Fatalf("load budget: %v", err)
+		}
+		return current.Spent
+	}
+
+	if err := svc.DeleteTransaction(context.Background(), tx.ID); err != nil {
+		t.Fatalf("DeleteTransaction: %v", err)
+	}
+	if got := spent(); got != 0 {
+		t.Errorf("spent after delete = %v, want 0", got)
+	}
+	if _, err := svc.RestoreTransaction(context.Background(), tx.ID); err != nil {
+		t.Fatalf("RestoreTransaction: %v", err)
+	}
+	if got := spent(); got != 80 {
+		t.Errorf("spent after restore = %v, want 80", got)
+	}
+}
+
+func TestTrash_PurgeRemovesOldItems(t *testing.T) {
+	svc, _, familyID, userID := newTrashFixture(t)
+	tx := expenseFor(familyID, userID)
+	if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+	if err := svc.DeleteTransaction(context.Background(), tx.ID); err != nil {
+		t.Fatalf("DeleteTransaction: %v", err)
+	}
+
+	// Not old enough yet: nothing purged, still restorable.
+	if n, err := svc.PurgeExpired(context.Background(), time.Now()); err != nil || n != 0 {
+		t.Fatalf("early purge = %d, %v; want 0", n, err)
+	}
+	// Past the retention window the purge removes it for good.
+	n, err := svc.PurgeExpired(context.Background(), time.Now().Add(DefaultTrashRetention+time.Hour))
+	if err != nil || n != 1 {
+		t.Fatalf("purge = %d, %v; want 1", n, err)
+	}
+	if _, err := svc.RestoreTransaction(context.Background(), tx.ID); !errors.Is(err, transaction.ErrNotFound) {
+		t.Errorf("restore after purge err = %v, want ErrNotFound", err)
+	}
+}
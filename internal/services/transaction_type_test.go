@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services/dto"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestCreateTransaction_RejectsInvalidTypes(t *testing.T) {
+	svc, users, _ := newTransactionServiceFixture(t)
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+
+	unknown := expenseFor(familyID, u.ID)
+	unknown.Type = "refund"
+	if err := svc.CreateTransaction(context.Background(), unknown); !errors.Is(err, transaction.ErrInvalidType) {
+		t.Errorf("unknown type err = %v, want ErrInvalidType", err)
+	}
+
+	from, to := uuid.New(), uuid.New()
+	categorized := expenseFor(familyID, u.ID)
+	categorized.Type = transaction.TypeTransfer
+	categorized.AccountID = &from
+	categorized.ToAccountID = &to
+	if err := svc.CreateTransaction(context.Background(), categorized); !errors.Is(err, transaction.ErrInvalidType) {
+		t.Errorf("categorized transfer err = %v, want ErrInvalidType", err)
+	}
+
+	unanchored := expenseFor(familyID, u.ID)
+	unanchored.Type = transaction.TypeTransfer
+	unanchored.CategoryID = uuid.Nil
+	if err := svc.CreateTransaction(context.Background(), unanchored); !errors.Is(err, transaction.ErrInvalidType) {
+		t.Errorf("transfer without accounts err = %v, want ErrInvalidType", err)
+	}
+}
+
+func TestTransfer_DoesNotChangeReportTotals(t *testing.T) {
+	users := memory.NewUserRepository()
+	transactions := memory.NewTransactionRepository()
+	txService := NewTransactionService(transactions, users)
+	accountService := NewAccountService(memory.NewAccountRepository(), txService)
+	reportService := NewReportService(memory.NewReportRepository(), transactions, nil, nil, users)
+
+	familyID := uuid.New()
+	u := seedFamilyUser(t, users, familyID)
+	checking := seedAccount(t, accountService, familyID, "checking", 500)
+	cash := seedAccount(t, accountService, familyID, "cash", 0)
+	groceries := expenseFor(familyID, u.ID)
+	groceries.Amount = 75
+	if err := txService.CreateTransaction(context.Background(), groceries); err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+
+	req := dto.ReportRequestDTO{
+		FamilyID:  familyID,
+		StartDate: time.Now().AddDate(0, 0, -1),
+		EndDate:   time.Now().AddDate(0, 0, 1),
+	}
+	before, err := reportService.GenerateExpenseReport(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GenerateExpenseReport: %v", err)
+	}
+
+	if _, err := accountService.Transfer(context.Background(), checking.ID, cash.ID, u.ID, 200, time.Now()); err != nil {
+		t.Fatalf("Transfer: %v", err)
+	}
+
+	after, err := reportService.GenerateExpenseReport(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GenerateExpenseReport: %v", err)
+	}
+	if before.TotalExpenses != after.TotalExpenses || after.TotalExpenses != 75 {
+		t.Errorf("expenses before/after transfer = %v/%v, want 75 both times", before.TotalExpenses, after.TotalExpenses)
+	}
+}
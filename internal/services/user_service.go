@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/notification"
+	"family-budget-service/pkg/uuid"
+)
+
+// ReportDeletionStrategy selects what happens to a user's saved reports
+// when the user is removed from a family.
+type ReportDeletionStrategy string
+
+const (
+	// ReportsReassignToFamily hands the reports to another family admin
+	// so they stay attributed to a real member.
+	ReportsReassignToFamily ReportDeletionStrategy = "reassign"
+	// ReportsAnonymize keeps the reports but clears the author.
+	ReportsAnonymize ReportDeletionStrategy = "anonymize"
+	// ReportsDelete removes the reports together with the user.
+	ReportsDelete ReportDeletionStrategy = "delete"
+)
+
+// Valid reports whether s is a known strategy.
+func (s ReportDeletionStrategy) Valid() bool {
+	switch s {
+	case ReportsReassignToFamily, ReportsAnonymize, ReportsDelete:
+		return true
+	}
+	return false
+}
+
+// ErrUnknownReportStrategy is returned when a deletion request carries an
+// unrecognized report strategy.
+var ErrUnknownReportStrategy = fmt.Errorf("unknown report deletion strategy")
+
+// UserService manages family members and their lifecycle.
+type UserService struct {
+	userRepo   user.Repository
+	reportRepo report.Repository
+
+	// defaultReportStrategy applies when DeleteUser is called with an
+	// empty strategy. Configurable at construction time.
+	defaultReportStrategy ReportDeletionStrategy
+
+	// Pending email changes are process-local: a change requested on one
+	// instance is confirmed on the same one. emailSender is nil until
+	// wired via SetEmailSender.
+	emailChangeMu       sync.Mutex
+	pendingEmailChanges map[string]pendingEmailChange
+	invitations         map[string]invitation
+	emailSender         notification.EmailSender
+}
+
+// NewUserService builds a UserService. defaultStrategy selects the report
+// handling used when callers don't specify one; an empty value falls back
+// to ReportsReassignToFamily.
+func NewUserService(
+	userRepo user.Repository,
+	reportRepo report.Repository,
+	defaultStrategy ReportDeletionStrategy,
+) *UserService {
+	if defaultStrategy == "" {
+		defaultStrategy = ReportsReassignToFamily
+	}
+	return &UserService{
+		userRepo:              userRepo,
+		reportRepo:            reportRepo,
+		defaultReportStrategy: defaultStrategy,
+	}
+}
+
+// GetUserByID returns a single user.
+func (s *UserService) GetUserByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return s.userRepo.GetByID(ctx, id)
+}
+
+// GetFamilyMembers returns every user in a family.
+func (s *UserService) GetFamilyMembers(ctx context.Context, familyID uuid.UUID) ([]*user.User, error) {
+	return s.userRepo.GetByFamilyID(ctx, familyID)
+}
+
+// DeleteUser removes a user and applies the given strategy to the reports
+// they authored. An empty strategy uses the service default.
+func (s *UserService) DeleteUser(ctx context.Context, id uuid.UUID, strategy ReportDeletionStrategy) error {
+	if strategy == "" {
+		strategy = s.defaultReportStrategy
+	}
+	if !strategy.Valid() {
+		return fmt.Errorf("%w: %q", ErrUnknownReportStrategy, strategy)
+	}
+
+	u, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("load user: %w", err)
+	}
+
+	if err := s.applyReportStrategy(ctx, u, strategy); err != nil {
+		return err
+	}
+
+	if err := s.userRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("delete user: %w", err)
+	}
+	return nil
+}
+
+func (s *UserService) applyReportStrategy(ctx context.Context, u *user.User, strategy ReportDeletionStrategy) error {
+	reports, err := s.reportRepo.GetByUserID(ctx, u.ID)
+	if err != nil {
+		return fmt.Errorf("load user reports: %w", err)
+	}
+	if len(reports) == 0 {
+		return nil
+	}
+
+	switch strategy {
+	case ReportsDelete:
+		for _, r := range reports {
+			if err := s.reportRepo.Delete(ctx, r.ID); err != nil {
+				return fmt.Errorf("delete report %s: %w", r.ID, err)
+			}
+		}
+	case ReportsAnonymize:
+		for _, r := range reports {
+			r.UserID = uuid.Nil
+			if err := s.reportRepo.Update(ctx, r); err != nil {
+				return fmt.Errorf("anonymize report %s: %w", r.ID, err)
+			}
+		}
+	case ReportsReassignToFamily:
+		heir, err := s.findReportHeir(ctx, u)
+		if err != nil {
+			return err
+		}
+		for _, r := range reports {
+			r.UserID = heir
+			if err := s.reportRepo.Update(ctx, r); err != nil {
+				return fmt.Errorf("reassign report %s: %w", r.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// findReportHeir picks the family member reports are reassigned to:
+// the first remaining admin, falling back to any remaining member, and
+// finally to the anonymous author when the family has nobody else.
+func (s *UserService) findReportHeir(ctx context.Context, deleted *user.User) (uuid.UUID, error) {
+	members, err := s.userRepo.GetByFamilyID(ctx, deleted.FamilyID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("load family members: %w", err)
+	}
+	var fallback uuid.UUID
+	for _, m := range members {
+		if m.ID == deleted.ID {
+			continue
+		}
+		if m.Role == user.RoleAdmin {
+			return m.ID, nil
+		}
+		if fallback.IsNil() {
+			fallback = m.ID
+		}
+	}
+	return fallback, nil
+}
@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/pkg/uuid"
+)
+
+// fakeUserRepo is a minimal map-backed user.Repository for service tests.
+type fakeUserRepo struct {
+	users map[uuid.UUID]*user.User
+}
+
+func newFakeUserRepo() *fakeUserRepo {
+	return &fakeUserRepo{users: make(map[uuid.UUID]*user.User)}
+}
+
+func (f *fakeUserRepo) Create(_ context.Context, u *user.User) error {
+	f.users[u.ID] = u
+	return nil
+}
+
+func (f *fakeUserRepo) GetByID(_ context.Context, id uuid.UUID) (*user.User, error) {
+	u, ok := f.users[id]
+	if !ok {
+		return nil, user.ErrNotFound
+	}
+	return u, nil
+}
+
+func (f *fakeUserRepo) GetByEmail(_ context.Context, email string) (*user.User, error) {
+	for _, u := range f.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, user.ErrNotFound
+}
+
+func (f *fakeUserRepo) GetByFamilyID(_ context.Context, familyID uuid.UUID) ([]*user.User, error) {
+	var out []*user.User
+	for _, u := range f.users {
+		if u.FamilyID == familyID {
+			out = append(out, u)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeUserRepo) Update(_ context.Context, u *user.User) error {
+	if _, ok := f.users[u.ID]; !ok {
+		return user.ErrNotFound
+	}
+	f.users[u.ID] = u
+	return nil
+}
+
+func (f *fakeUserRepo) Delete(_ context.Context, id uuid.UUID) error {
+	if _, ok := f.users[id]; !ok {
+		return user.ErrNotFound
+	}
+	delete(f.users, id)
+	return nil
+}
+
+// fakeReportRepo is a minimal map-backed report.Repository.
+type fakeReportRepo struct {
+	reports map[uuid.UUID]*report.Report
+}
+
+func newFakeReportRepo() *fakeReportRepo {
+	return &fakeReportRepo{reports: make(map[uuid.UUID]*report.Report)}
+}
+
+func (f *fakeReportRepo) Create(_ context.Context, r *report.Report) error {
+	f.reports[r.ID] = r
+	return nil
+}
+
+func (f *fakeReportRepo) GetByID(_ context.Context, id uuid.UUID) (*report.Report, error) {
+	r, ok := f.reports[id]
+	if !ok {
+		return nil, report.ErrNotFound
+	}
+	return r, nil
+}
+
+func (f *fakeReportRepo) GetByFamilyID(_ context.Context, familyID uuid.UUID) ([]*report.Report, error) {
+	var out []*report.Report
+	for _, r := range f.reports {
+		if r.FamilyID == familyID {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeReportRepo) GetByUserID(_ context.Context, userID uuid.UUID) ([]*report.Report, error) {
+	var out []*report.Report
+	for _, r := range f.reports {
+		if r.UserID == userID {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeReportRepo) Update(_ context.Context, r *report.Report) error {
+	if _, ok := f.reports[r.ID]; !ok {
+		return report.ErrNotFound
+	}
+	f.reports[r.ID] = r
+	return nil
+}
+
+func (f *fakeReportRepo) Delete(_ context.Context, id uuid.UUID) error {
+	if _, ok := f.reports[id]; !ok {
+		return report.ErrNotFound
+	}
+	delete(f.reports, id)
+	return nil
+}
+
+func seedUserWithReports(t *testing.T, users *fakeUserRepo, reports *fakeReportRepo) (victim, admin *user.User, reportID uuid.UUID) {
+	t.Helper()
+	familyID := uuid.New()
+	admin = &user.User{ID: uuid.New(), Email: "admin@example.com", Role: user.RoleAdmin, FamilyID: familyID}
+	victim = &user.User{ID: uuid.New(), Email: "member@example.com", Role: user.RoleMember, FamilyID: familyID}
+	users.users[admin.ID] = admin
+	users.users[victim.ID] = victim
+
+	r := &report.Report{
+		ID:          uuid.New(),
+		Name:        "March expenses",
+		Type:        report.TypeExpenses,
+		Period:      report.PeriodMonthly,
+		FamilyID:    familyID,
+		UserID:      victim.ID,
+		GeneratedAt: time.Now(),
+	}
+	reports.reports[r.ID] = r
+	return victim, admin, r.ID
+}
+
+func TestDeleteUser_ReassignsReportsToFamilyAdmin(t *testing.T) {
+	users := newFakeUserRepo()
+	reports := newFakeReportRepo()
+	victim, admin, reportID := seedUserWithReports(t, users, reports)
+
+	svc := NewUserService(users, reports, "")
+	if err := svc.DeleteUser(context.Background(), victim.ID, ReportsReassignToFamily); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+
+	r, err := reports.GetByID(context.Background(), reportID)
+	if err != nil {
+		t.Fatalf("report should survive reassignment: %v", err)
+	}
+	if r.UserID != admin.ID {
+		t.Errorf("report UserID = %s, want admin %s", r.UserID, admin.ID)
+	}
+	if _, err := users.GetByID(context.Background(), victim.ID); !errors.Is(err, user.ErrNotFound) {
+		t.Errorf("user should be deleted, got err %v", err)
+	}
+}
+
+func TestDeleteUser_AnonymizesReports(t *testing.T) {
+	users := newFakeUserRepo()
+	reports := newFakeReportRepo()
+	victim, _, reportID := seedUserWithReports(t, users, reports)
+
+	svc := NewUserService(users, reports, "")
+	if err := svc.DeleteUser(context.Background(), victim.ID, ReportsAnonymize); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+
+	r, err := reports.GetByID(context.Background(), reportID)
+	if err != nil {
+		t.Fatalf("report should survive anonymization: %v", err)
+	}
+	if !r.UserID.IsNil() {
+		t.Errorf("report UserID = %s, want Nil", r.UserID)
+	}
+}
+
+func TestDeleteUser_DeletesReports(t *testing.T) {
+	users := newFakeUserRepo()
+	reports := newFakeReportRepo()
+	victim, _, reportID := seedUserWithReports(t, users, reports)
+
+	svc := NewUserService(users, reports, "")
+	if err := svc.DeleteUser(context.Background(), victim.ID, ReportsDelete); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+
+	if _, err := reports.GetByID(context.Background(), reportID); !errors.Is(err, report.ErrNotFound) {
+		t.Errorf("report should be deleted, got err %v", err)
+	}
+}
+
+func TestDeleteUser_DefaultStrategyFromConfig(t *testing.T) {
+	users := newFakeUserRepo()
+	reports := newFakeReportRepo()
+	victim, _, reportID := seedUserWithReports(t, users, reports)
+
+	svc := NewUserService(users, reports, ReportsAnonymize)
+	if err := svc.DeleteUser(context.Background(), victim.ID, ""); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+
+	r, err := reports.GetByID(context.Background(), reportID)
+	if err != nil {
+		t.Fatalf("report should survive: %v", err)
+	}
+	if !r.UserID.IsNil() {
+		t.Errorf("default strategy should anonymize, UserID = %s", r.UserID)
+	}
+}
+
+func TestDeleteUser_RejectsUnknownStrategy(t *testing.T) {
+	users := newFakeUserRepo()
+	reports := newFakeReportRepo()
+	victim, _, _ := seedUserWithReports(t, users, reports)
+
+	svc := NewUserService(users, reports, "")
+	err := svc.DeleteUser(context.Background(), victim.ID, "shred")
+	if !errors.Is(err, ErrUnknownReportStrategy) {
+		t.Fatalf("err = %v, want ErrUnknownReportStrategy", err)
+	}
+	if _, getErr := users.GetByID(context.Background(), victim.ID); getErr != nil {
+		t.Errorf("user must not be deleted on invalid strategy: %v", getErr)
+	}
+}
@@ -0,0 +1,16 @@
+// Package version carries build identification injected at link time:
+//
+//	go build -ldflags "\
+//	  -X family-budget-service/internal/version.Version=v1.2.3 \
+//	  -X family-budget-service/internal/version.Commit=$(git rev-parse --short HEAD) \
+//	  -X family-budget-service/internal/version.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// Unset values keep their development defaults.
+package version
+
+// Build identification, overridden via -ldflags -X.
+var (
+	Version   = "dev"
+	Commit    = "unknown"
+	BuildTime = "unknown"
+)
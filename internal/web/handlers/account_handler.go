@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"family-budget-service/internal/domain/account"
+	"family-budget-service/internal/services"
+)
+
+// AccountHandler serves account (wallet) routes.
+type AccountHandler struct {
+	accountService *services.AccountService
+}
+
+// NewAccountHandler builds the account handler.
+func NewAccountHandler(accountService *services.AccountService) *AccountHandler {
+	return &AccountHandler{accountService: accountService}
+}
+
+// Register wires the account routes onto mux.
+func (h *AccountHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/accounts", h.accounts)
+	mux.HandleFunc("/api/v1/accounts/balance", h.Balance)
+	mux.HandleFunc("/api/v1/accounts/transfer", h.Transfer)
+}
+
+// accounts dispatches the account collection routes by method.
+func (h *AccountHandler) accounts(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.Accounts(w, r)
+	case http.MethodPost:
+		h.Create(w, r)
+	case http.MethodDelete:
+		h.Delete(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+	}
+}
+
+type accountResponse struct {
+	ID             string  `json:"id"`
+	Name           string  `json:"name"`
+	Type           string  `json:"type"`
+	OpeningBalance float64 `json:"opening_balance"`
+	Currency       string  `json:"currency,omitempty"`
+	FamilyID       string  `json:"family_id"`
+}
+
+func toAccountResponse(a *account.Account) accountResponse {
+	return accountResponse{
+		ID:             a.ID.String(),
+		Name:           a.Name,
+		Type:           string(a.Type),
+		OpeningBalance: a.OpeningBalance,
+		Currency:       a.Currency,
+		FamilyID:       a.FamilyID.String(),
+	}
+}
+
+// Accounts lists a family's accounts.
+func (h *AccountHandler) Accounts(w http.ResponseWriter, r *http.Request) {
+	familyID, ok := parseUUIDParam(w, r.URL.Query().Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	accounts, err := h.accountService.GetAccountsByFamily(r.Context(), familyID)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := make([]accountResponse, 0, len(accounts))
+	for _, a := range accounts {
+		out = append(out, toAccountResponse(a))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
+
+// Create persists a new account.
+func (h *AccountHandler) Create(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	var opening float64
+	if raw := query.Get("opening_balance"); raw != "" {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "opening_balance", Message: "must be a number"})
+			return
+		}
+		opening = parsed
+	}
+
+	a := &account.Account{
+		FamilyID:       familyID,
+		Name:           query.Get("name"),
+		Type:           account.Type(query.Get("type")),
+		OpeningBalance: opening,
+		Currency:       query.Get("currency"),
+	}
+	if err := h.accountService.CreateAccount(r.Context(), a); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]any{"data": toAccountResponse(a)})
+}
+
+// Delete removes an account by ID.
+func (h *AccountHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r.URL.Query().Get("id"), "id")
+	if !ok {
+		return
+	}
+	if err := h.accountService.DeleteAccount(r.Context(), id); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Balance reports an account's derived current balance.
+func (h *AccountHandler) Balance(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	id, ok := parseUUIDParam(w, r.URL.Query().Get("id"), "id")
+	if !ok {
+		return
+	}
+	balance, err := h.accountService.GetAccountBalance(r.Context(), id)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": map[string]any{
+		"account_id": id.String(),
+		"balance":    balance,
+	}})
+}
+
+// Transfer records a money movement between two family accounts.
+func (h *AccountHandler) Transfer(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	fromID, ok := parseUUIDParam(w, query.Get("from_account_id"), "from_account_id")
+	if !ok {
+		return
+	}
+	toID, ok := parseUUIDParam(w, query.Get("to_account_id"), "to_account_id")
+	if !ok {
+		return
+	}
+	userID, ok := parseUUIDParam(w, query.Get("user_id"), "user_id")
+	if !ok {
+		return
+	}
+	amount, err := strconv.ParseFloat(query.Get("amount"), 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "amount", Message: "must be a number"})
+		return
+	}
+	date := time.Now()
+	if raw := query.Get("date"); raw != "" {
+		parsed, parseErr := time.Parse("2006-01-02", raw)
+		if parseErr != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "date", Message: "must be a YYYY-MM-DD date"})
+			return
+		}
+		date = parsed
+	}
+
+	t, err := h.accountService.Transfer(r.Context(), fromID, toID, userID, amount, date)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]any{"data": map[string]any{
+		"transaction_id":  t.ID.String(),
+		"from_account_id": fromID.String(),
+		"to_account_id":   toID.String(),
+		"amount":          t.Amount,
+	}})
+}
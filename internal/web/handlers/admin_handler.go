@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"family-budget-service/internal/services"
+)
+
+// AdminHandler serves operator-only analytics routes. It authenticates
+// with a dedicated admin token, never a family session: family users —
+// admins included — have no business seeing other families' volume.
+type AdminHandler struct {
+	adminService *services.AdminService
+	// token is the shared operator secret; an empty token disables the
+	// routes entirely.
+	token string
+}
+
+// NewAdminHandler builds the admin handler.
+func NewAdminHandler(adminService *services.AdminService, token string) *AdminHandler {
+	return &AdminHandler{adminService: adminService, token: token}
+}
+
+// Register wires the admin routes onto mux.
+func (h *AdminHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/admin/stats", h.Stats)
+}
+
+// authorized checks the X-Admin-Token header against the configured
+// token in constant time.
+func (h *AdminHandler) authorized(r *http.Request) bool {
+	if h.token == "" {
+		return false
+	}
+	provided := r.Header.Get("X-Admin-Token")
+	return subtle.ConstantTimeCompare([]byte(provided), []byte(h.token)) == 1
+}
+
+type systemCategoryResponse struct {
+	CategoryID string  `json:"category_id"`
+	Amount     float64 `json:"amount"`
+	Count      int     `json:"count"`
+}
+
+type systemStatsResponse struct {
+	Families      int                      `json:"families"`
+	Users         int                      `json:"users"`
+	Transactions  int                      `json:"transactions"`
+	TotalIncome   float64                  `json:"total_income"`
+	TotalExpenses float64                  `json:"total_expenses"`
+	TopCategories []systemCategoryResponse `json:"top_categories"`
+}
+
+// Stats returns system-wide aggregate counts and volume.
+func (h *AdminHandler) Stats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	if !h.authorized(r) {
+		writeError(w, http.StatusForbidden, CodeForbidden, "operator token required")
+		return
+	}
+
+	stats, err := h.adminService.GetSystemStats(r.Context())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := systemStatsResponse{
+		Families:      stats.Families,
+		Users:         stats.Users,
+		Transactions:  stats.Transactions,
+		TotalIncome:   stats.TotalIncome,
+		TotalExpenses: stats.TotalExpenses,
+		TopCategories: make([]systemCategoryResponse, 0, len(stats.TopCategories)),
+	}
+	for _, c := range stats.TopCategories {
+		out.TopCategories = append(out.TopCategories, systemCategoryResponse{
+			CategoryID: c.CategoryID.String(),
+			Amount:     c.Amount,
+			Count:      c.Count,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
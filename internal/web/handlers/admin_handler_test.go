@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services"
+	"family-budget-service/pkg/uuid"
+)
+
+func newAdminFixture(t *testing.T, token string) *http.ServeMux {
+	t.Helper()
+	families := memory.NewFamilyRepository()
+	users := memory.NewUserRepository()
+	transactions := memory.NewTransactionRepository()
+	ctx := context.Background()
+
+	for i, name := range []string{"Smith", "Jones"} {
+		f := &user.Family{ID: uuid.New(), Name: name, Currency: "USD"}
+		if err := families.Create(ctx, f); err != nil {
+			t.Fatalf("seed family: %v", err)
+		}
+		u := &user.User{
+			ID: uuid.New(), Email: name + "@example.com",
+			Role: user.RoleAdmin, FamilyID: f.ID,
+		}
+		if err := users.Create(ctx, u); err != nil {
+			t.Fatalf("seed user: %v", err)
+		}
+		for j := 0; j < 3; j++ {
+			tx := &transaction.Transaction{
+				ID: uuid.New(), FamilyID: f.ID, CategoryID: uuid.New(),
+				UserID: u.ID, Type: transaction.TypeExpense,
+				Amount: float64(10 * (i + j + 1)), Date: time.Now(),
+			}
+			if err := transactions.Create(ctx, tx); err != nil {
+				t.Fatalf("seed transaction: %v", err)
+			}
+		}
+	}
+
+	h := NewAdminHandler(services.NewAdminService(families, users, transactions), token)
+	mux := http.NewServeMux()
+	h.Register(mux)
+	return mux
+}
+
+func TestAdminStats_RequiresOperatorToken(t *testing.T) {
+	mux := newAdminFixture(t, "operator-secret")
+
+	// A normal family user has no operator token and is refused.
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/admin/stats?user_id="+uuid.New().String(), nil))
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("without token: status = %d, want 403", rec.Code)
+	}
+
+	// A wrong token is refused too.
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/stats", nil)
+	req.Header.Set("X-Admin-Token", "guess")
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("wrong token: status = %d, want 403", rec.Code)
+	}
+}
+
+func TestAdminStats_OperatorGetsAggregates(t *testing.T) {
+	mux := newAdminFixture(t, "operator-secret")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/stats", nil)
+	req.Header.Set("X-Admin-Token", "operator-secret")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body.String())
+	}
+
+	var resp struct {
+		Data struct {
+			Families      int     `json:"families"`
+			Users         int     `json:"users"`
+			Transactions  int     `json:"transactions"`
+			TotalExpenses float64 `json:"total_expenses"`
+			TopCategories []struct {
+				Amount float64 `json:"amount"`
+			} `json:"top_categories"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if resp.Data.Families != 2 || resp.Data.Users != 2 || resp.Data.Transactions != 6 {
+		t.Errorf("counts = %d families, %d users, %d transactions; want 2, 2, 6",
+			resp.Data.Families, resp.Data.Users, resp.Data.Transactions)
+	}
+	// 10+20+30 for the first family, 20+30+40 for the second.
+	if resp.Data.TotalExpenses != 150 {
+		t.Errorf("TotalExpenses = %v, want 150", resp.Data.TotalExpenses)
+	}
+	if len(resp.Data.TopCategories) == 0 {
+		t.Errorf("expected top categories in the aggregate")
+	}
+}
+
+func TestAdminStats_DisabledWithoutConfiguredToken(t *testing.T) {
+	mux := newAdminFixture(t, "")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/stats", nil)
+	req.Header.Set("X-Admin-Token", "")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want 403 when no token is configured", rec.Code)
+	}
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/services"
+)
+
+// RoleGuard enforces role requirements on mutating routes. The acting
+// user comes from the user_id query parameter, following the settings
+// handler; routes decide which roles they admit when registering.
+type RoleGuard struct {
+	userService *services.UserService
+}
+
+// NewRoleGuard builds a role guard backed by the user service.
+func NewRoleGuard(userService *services.UserService) *RoleGuard {
+	return &RoleGuard{userService: userService}
+}
+
+// Require wraps next so only the listed roles reach it. Anyone else —
+// including a valid user with a disallowed role — gets a 403.
+func (g *RoleGuard) Require(next http.HandlerFunc, allowed ...user.Role) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := parseUUIDParam(w, r.URL.Query().Get("user_id"), "user_id")
+		if !ok {
+			return
+		}
+		actor, err := g.userService.GetUserByID(r.Context(), userID)
+		if errors.Is(err, user.ErrNotFound) {
+			writeError(w, http.StatusNotFound, CodeNotFound, "user not found")
+			return
+		}
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		for _, role := range allowed {
+			if actor.Role == role {
+				next(w, r)
+				return
+			}
+		}
+		writeError(w, http.StatusForbidden, CodeForbidden, "role not allowed for this operation")
+	}
+}
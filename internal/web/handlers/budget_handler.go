@@ -0,0 +1,438 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/services"
+)
+
+// BudgetHandler serves budget management routes. Reads are open to every
+// family member; creating a budget requires the admin or member role.
+type BudgetHandler struct {
+	budgetService *services.BudgetService
+	guard         *RoleGuard
+}
+
+// NewBudgetHandler builds the budget handler.
+func NewBudgetHandler(budgetService *services.BudgetService, guard *RoleGuard) *BudgetHandler {
+	return &BudgetHandler{budgetService: budgetService, guard: guard}
+}
+
+// Register wires the budget routes onto mux.
+func (h *BudgetHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/budgets", h.budgets)
+	mux.HandleFunc("/api/v1/budgets/clone", h.Clone)
+	mux.HandleFunc("/api/v1/budgets/alerts", h.alerts)
+	mux.HandleFunc("/api/v1/budgets/alerts/status", h.AlertStatus)
+	mux.HandleFunc("/api/v1/budgets/utilization", h.Utilization)
+	mux.HandleFunc("/api/v1/budgets/suggestions", h.Suggestions)
+}
+
+// budgets dispatches the budget collection routes by method. Children
+// are read-only: only admins and members may create budgets.
+func (h *BudgetHandler) budgets(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.Budgets(w, r)
+	case http.MethodPost:
+		h.guard.Require(h.Create, user.RoleAdmin, user.RoleMember)(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+	}
+}
+
+// alerts dispatches the alert collection routes by method.
+func (h *BudgetHandler) alerts(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		h.CreateAlert(w, r)
+	case http.MethodGet:
+		h.Alerts(w, r)
+	case http.MethodDelete:
+		h.DeleteAlert(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+	}
+}
+
+type budgetResponse struct {
+	ID             string  `json:"id"`
+	Name           string  `json:"name"`
+	Amount         float64 `json:"amount"`
+	Spent          float64 `json:"spent"`
+	Period         string  `json:"period"`
+	CategoryID     string  `json:"category_id,omitempty"`
+	FamilyID       string  `json:"family_id"`
+	StartDate      string  `json:"start_date"`
+	EndDate        string  `json:"end_date"`
+	IsActive       bool    `json:"is_active"`
+	Rollover       bool    `json:"rollover"`
+	RolloverAmount float64 `json:"rollover_amount"`
+}
+
+func toBudgetResponse(b *budget.Budget) budgetResponse {
+	out := budgetResponse{
+		ID:             b.ID.String(),
+		Name:           b.Name,
+		Amount:         b.Amount,
+		Spent:          b.Spent,
+		Period:         string(b.Period),
+		FamilyID:       b.FamilyID.String(),
+		StartDate:      b.StartDate.Format("2006-01-02"),
+		EndDate:        b.EndDate.Format("2006-01-02"),
+		IsActive:       b.IsActive,
+		Rollover:       b.Rollover,
+		RolloverAmount: b.RolloverAmount,
+	}
+	if b.CategoryID != nil {
+		out.CategoryID = b.CategoryID.String()
+	}
+	return out
+}
+
+// Create persists a new budget for the acting user's family.
+func (h *BudgetHandler) Create(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	name := query.Get("name")
+	if name == "" {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "name", Message: "budget name is required"})
+		return
+	}
+	amount, err := strconv.ParseFloat(query.Get("amount"), 64)
+	if err != nil || amount < 0 {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "amount", Message: "must be a non-negative number"})
+		return
+	}
+	startDate, err := time.Parse("2006-01-02", query.Get("start_date"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "start_date", Message: "must be a YYYY-MM-DD date"})
+		return
+	}
+	endDate, err := time.Parse("2006-01-02", query.Get("end_date"))
+	if err != nil || endDate.Before(startDate) {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "end_date", Message: "must be a YYYY-MM-DD date on or after start_date"})
+		return
+	}
+
+	b := &budget.Budget{
+		Name:      name,
+		Amount:    amount,
+		Period:    budget.Period(query.Get("period")),
+		FamilyID:  familyID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		IsActive:  true,
+		Rollover:  strings.EqualFold(query.Get("rollover"), "true"),
+	}
+	if b.Period == "" {
+		b.Period = budget.PeriodMonthly
+	}
+	if raw := query.Get("category_id"); raw != "" {
+		categoryID, ok := parseUUIDParam(w, raw, "category_id")
+		if !ok {
+			return
+		}
+		b.CategoryID = &categoryID
+	}
+
+	if err := h.budgetService.CreateBudget(r.Context(), b); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]any{"data": toBudgetResponse(b)})
+}
+
+// Clone copies the family's active budgets into their next periods with
+// spent reset to zero. Like creating budgets, it requires the admin or
+// member role.
+func (h *BudgetHandler) Clone(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	h.guard.Require(h.cloneBudgets, user.RoleAdmin, user.RoleMember)(w, r)
+}
+
+func (h *BudgetHandler) cloneBudgets(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	var adjustPercent float64
+	if raw := query.Get("adjust_percent"); raw != "" {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "adjust_percent", Message: "must be a number"})
+			return
+		}
+		adjustPercent = parsed
+	}
+
+	created, err := h.budgetService.CloneBudgetsToNextPeriod(r.Context(), familyID, adjustPercent)
+	if errors.Is(err, services.ErrInvalidAdjustPercent) {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "adjust_percent", Message: "must be greater than -100"})
+		return
+	}
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := make([]budgetResponse, 0, len(created))
+	for _, b := range created {
+		out = append(out, toBudgetResponse(b))
+	}
+	writeJSON(w, http.StatusCreated, map[string]any{"data": out})
+}
+
+// Budgets serves single-budget and family-list reads. Both use the same
+// {"data": ...} envelope: an object for ?id=, an array for ?family_id=.
+func (h *BudgetHandler) Budgets(w http.ResponseWriter, r *http.Request) {
+	if id := r.URL.Query().Get("id"); id != "" {
+		budgetID, ok := parseUUIDParam(w, id, "id")
+		if !ok {
+			return
+		}
+		b, err := h.budgetService.GetBudgetByID(r.Context(), budgetID)
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"data": toBudgetResponse(b)})
+		return
+	}
+	familyID, ok := parseUUIDParam(w, r.URL.Query().Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	budgets, err := h.budgetService.GetBudgetsByFamily(r.Context(), familyID)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := make([]budgetResponse, 0, len(budgets))
+	for _, b := range budgets {
+		out = append(out, toBudgetResponse(b))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
+
+type alertResponse struct {
+	ID               string     `json:"id"`
+	BudgetID         string     `json:"budget_id"`
+	ThresholdPercent float64    `json:"threshold_percent"`
+	NotifyEnabled    bool       `json:"notify_enabled"`
+	Triggered        bool       `json:"triggered"`
+	TriggeredAt      *time.Time `json:"triggered_at,omitempty"`
+}
+
+func toAlertResponse(a *budget.Alert) alertResponse {
+	return alertResponse{
+		ID:               a.ID.String(),
+		BudgetID:         a.BudgetID.String(),
+		ThresholdPercent: a.ThresholdPercent,
+		NotifyEnabled:    a.NotifyEnabled,
+		Triggered:        a.Triggered,
+		TriggeredAt:      a.TriggeredAt,
+	}
+}
+
+// CreateAlert persists a new alert threshold for a budget.
+func (h *BudgetHandler) CreateAlert(w http.ResponseWriter, r *http.Request) {
+	budgetID, ok := parseUUIDParam(w, r.URL.Query().Get("budget_id"), "budget_id")
+	if !ok {
+		return
+	}
+	threshold, err := strconv.ParseFloat(r.URL.Query().Get("threshold"), 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "threshold", Message: "must be a number"})
+		return
+	}
+	notify := strings.EqualFold(r.URL.Query().Get("notify"), "true")
+
+	alert, err := h.budgetService.CreateAlert(r.Context(), budgetID, threshold, notify)
+	switch {
+	case errors.Is(err, budget.ErrDuplicateAlertThreshold):
+		writeError(w, http.StatusConflict, CodeConflict, "an alert at this threshold already exists")
+	case errors.Is(err, services.ErrInvalidAlertThreshold):
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "threshold", Message: "must be between 0 and 200"})
+	case errors.Is(err, budget.ErrNotFound):
+		writeError(w, http.StatusNotFound, CodeNotFound, "budget not found")
+	case err != nil:
+		writeServiceError(w, err)
+	default:
+		writeJSON(w, http.StatusCreated, map[string]any{"data": toAlertResponse(alert)})
+	}
+}
+
+// Alerts lists the configured alerts of a budget.
+func (h *BudgetHandler) Alerts(w http.ResponseWriter, r *http.Request) {
+	budgetID, ok := parseUUIDParam(w, r.URL.Query().Get("budget_id"), "budget_id")
+	if !ok {
+		return
+	}
+	alerts, err := h.budgetService.GetAlertsByBudget(r.Context(), budgetID)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := make([]alertResponse, 0, len(alerts))
+	for _, a := range alerts {
+		out = append(out, toAlertResponse(a))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
+
+type budgetAlertStatusResponse struct {
+	BudgetID            string    `json:"budget_id"`
+	BudgetName          string    `json:"budget_name"`
+	SpentPercent        float64   `json:"spent_percent"`
+	Thresholds          []float64 `json:"thresholds"`
+	CustomThresholds    bool      `json:"custom_thresholds"`
+	TriggeredThresholds []float64 `json:"triggered_thresholds,omitempty"`
+	Triggered           bool      `json:"triggered"`
+}
+
+type alertEvaluationResponse struct {
+	Budgets        []budgetAlertStatusResponse `json:"budgets"`
+	TriggeredCount int                         `json:"triggered_count"`
+	HealthyCount   int                         `json:"healthy_count"`
+}
+
+// AlertStatus evaluates a family's active budgets against their configured
+// alert thresholds; budgets without custom alerts use the global defaults.
+func (h *BudgetHandler) AlertStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	familyID, ok := parseUUIDParam(w, r.URL.Query().Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	eval, err := h.budgetService.EvaluateAlerts(r.Context(), familyID)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := alertEvaluationResponse{
+		Budgets:        make([]budgetAlertStatusResponse, 0, len(eval.Budgets)),
+		TriggeredCount: eval.TriggeredCount,
+		HealthyCount:   eval.HealthyCount,
+	}
+	for _, b := range eval.Budgets {
+		out.Budgets = append(out.Budgets, budgetAlertStatusResponse{
+			BudgetID:            b.BudgetID.String(),
+			BudgetName:          b.BudgetName,
+			SpentPercent:        b.SpentPercent,
+			Thresholds:          b.Thresholds,
+			CustomThresholds:    b.CustomThresholds,
+			TriggeredThresholds: b.TriggeredThresholds,
+			Triggered:           b.Triggered,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
+
+type budgetUtilizationResponse struct {
+	BudgetID       string  `json:"budget_id"`
+	Spent          float64 `json:"spent"`
+	Remaining      float64 `json:"remaining"`
+	PercentUsed    float64 `json:"percent_used"`
+	PercentElapsed float64 `json:"percent_elapsed"`
+	Pace           string  `json:"pace"`
+}
+
+// Utilization reports a budget's spending against the time elapsed in
+// its period, including the on-pace/over-pace indicator.
+func (h *BudgetHandler) Utilization(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	budgetID, ok := parseUUIDParam(w, r.URL.Query().Get("budget_id"), "budget_id")
+	if !ok {
+		return
+	}
+	u, err := h.budgetService.CalculateBudgetUtilization(r.Context(), budgetID)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := budgetUtilizationResponse{
+		BudgetID:       u.BudgetID.String(),
+		Spent:          u.Spent,
+		Remaining:      u.Remaining,
+		PercentUsed:    u.PercentUsed,
+		PercentElapsed: u.PercentElapsed,
+		Pace:           u.Pace,
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
+
+type budgetSuggestionResponse struct {
+	CategoryID      string  `json:"category_id"`
+	SuggestedAmount float64 `json:"suggested_amount"`
+}
+
+// Suggestions returns the new-budget form's amount prefills: per
+// category, the family's average monthly spend over the last three
+// months. Categories without recent spending are simply absent.
+func (h *BudgetHandler) Suggestions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	familyID, ok := parseUUIDParam(w, r.URL.Query().Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	suggestions, err := h.budgetService.SuggestBudgetAmounts(r.Context(), familyID, time.Now())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := make([]budgetSuggestionResponse, 0, len(suggestions))
+	for _, s := range suggestions {
+		out = append(out, budgetSuggestionResponse{
+			CategoryID:      s.CategoryID.String(),
+			SuggestedAmount: s.SuggestedAmount,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
+
+// DeleteAlert removes a configured alert by ID.
+func (h *BudgetHandler) DeleteAlert(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r.URL.Query().Get("id"), "id")
+	if !ok {
+		return
+	}
+	err := h.budgetService.DeleteAlert(r.Context(), id)
+	switch {
+	case errors.Is(err, budget.ErrAlertNotFound):
+		writeError(w, http.StatusNotFound, CodeNotFound, "alert not found")
+	case err != nil:
+		writeServiceError(w, err)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services"
+	"family-budget-service/pkg/uuid"
+)
+
+func newBudgetHandlerFixture(t *testing.T) (*http.ServeMux, *memory.BudgetRepository, *services.BudgetService) {
+	mux, budgets, svc, _ := newBudgetHandlerAuthzFixture(t)
+	return mux, budgets, svc
+}
+
+func newBudgetHandlerAuthzFixture(t *testing.T) (*http.ServeMux, *memory.BudgetRepository, *services.BudgetService, *memory.UserRepository) {
+	t.Helper()
+	budgets := memory.NewBudgetRepository()
+	svc := services.NewBudgetService(budgets, memory.NewAlertRepository(), memory.NewCategoryRepository())
+	users := memory.NewUserRepository()
+	guard := NewRoleGuard(services.NewUserService(users, memory.NewReportRepository(), ""))
+	mux := http.NewServeMux()
+	NewBudgetHandler(svc, guard).Register(mux)
+	return mux, budgets, svc, users
+}
+
+func seedBudget(t *testing.T, budgets *memory.BudgetRepository, familyID uuid.UUID) *budget.Budget {
+	t.Helper()
+	b := &budget.Budget{
+		ID: uuid.New(), Name: "Groceries", Amount: 500, FamilyID: familyID,
+		Period: budget.PeriodMonthly, IsActive: true,
+		StartDate: time.Now().AddDate(0, 0, -10), EndDate: time.Now().AddDate(0, 0, 20),
+	}
+	if err := budgets.Create(context.Background(), b); err != nil {
+		t.Fatalf("seed budget: %v", err)
+	}
+	return b
+}
+
+func TestBudgets_SingleAndListShareEnvelope(t *testing.T) {
+	mux, budgets, _ := newBudgetHandlerFixture(t)
+	familyID := uuid.New()
+	b := seedBudget(t, budgets, familyID)
+	b.Spent = 120
+	if err := budgets.Update(context.Background(), b); err != nil {
+		t.Fatalf("update budget: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/budgets?id="+b.ID.String(), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("single status = %d", rec.Code)
+	}
+	var single map[string]json.RawMessage
+	if err := json.Unmarshal(rec.Body.Bytes(), &single); err != nil {
+		t.Fatalf("decode single: %v", err)
+	}
+	if _, ok := single["spent"]; ok {
+		t.Error("single budget leaks fields at the top level, want them under data")
+	}
+	var singleBudget struct {
+		Spent float64 `json:"spent"`
+	}
+	if err := json.Unmarshal(single["data"], &singleBudget); err != nil {
+		t.Fatalf("decode single data: %v", err)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/budgets?family_id="+familyID.String(), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("list status = %d", rec.Code)
+	}
+	var list struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &list); err != nil {
+		t.Fatalf("decode list: %v", err)
+	}
+	if len(list.Data) != 1 {
+		t.Fatalf("list = %d budgets, want 1", len(list.Data))
+	}
+	// The list element and the single read are byte-identical: one
+	// envelope, one entity shape.
+	if string(list.Data[0]) != string(single["data"]) {
+		t.Errorf("list entity %s != single entity %s", list.Data[0], single["data"])
+	}
+	if singleBudget.Spent != 120 {
+		t.Errorf("data.spent = %v, want 120", singleBudget.Spent)
+	}
+}
+
+func TestBudgetAlerts_CreatePersists(t *testing.T) {
+	mux, budgets, svc := newBudgetHandlerFixture(t)
+	b := seedBudget(t, budgets, uuid.New())
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/api/v1/budgets/alerts?budget_id="+b.ID.String()+"&threshold=75&notify=true", nil))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, body %s", rec.Code, rec.Body.String())
+	}
+
+	stored, err := svc.GetAlertsByBudget(context.Background(), b.ID)
+	if err != nil || len(stored) != 1 {
+		t.Fatalf("stored alerts = %d, %v; want 1", len(stored), err)
+	}
+	if stored[0].ThresholdPercent != 75 || !stored[0].NotifyEnabled {
+		t.Errorf("stored alert = %+v, want 75%% with notify", stored[0])
+	}
+}
+
+func TestBudgetAlerts_DuplicateThresholdRejected(t *testing.T) {
+	mux, budgets, _ := newBudgetHandlerFixture(t)
+	b := seedBudget(t, budgets, uuid.New())
+	url := "/api/v1/budgets/alerts?budget_id=" + b.ID.String() + "&threshold=80"
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, url, nil))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("first create status = %d", rec.Code)
+	}
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, url, nil))
+	if rec.Code != http.StatusConflict {
+		t.Errorf("duplicate create status = %d, want 409", rec.Code)
+	}
+}
+
+func TestBudgetAlerts_DeleteActuallyDeletes(t *testing.T) {
+	mux, budgets, svc := newBudgetHandlerFixture(t)
+	b := seedBudget(t, budgets, uuid.New())
+
+	alert, err := svc.CreateAlert(context.Background(), b.ID, 90, false)
+	if err != nil {
+		t.Fatalf("CreateAlert: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete,
+		"/api/v1/budgets/alerts?id="+alert.ID.String(), nil))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("delete status = %d", rec.Code)
+	}
+
+	remaining, err := svc.GetAlertsByBudget(context.Background(), b.ID)
+	if err != nil || len(remaining) != 0 {
+		t.Errorf("alerts after delete = %d, %v; want none", len(remaining), err)
+	}
+
+	// Deleting again is a 404, not a silent success.
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete,
+		"/api/v1/budgets/alerts?id="+alert.ID.String(), nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("second delete status = %d, want 404", rec.Code)
+	}
+}
+
+func TestBudgetAlerts_ListReadsConfiguredThresholds(t *testing.T) {
+	mux, budgets, svc := newBudgetHandlerFixture(t)
+	b := seedBudget(t, budgets, uuid.New())
+	for _, threshold := range []float64{50, 95} {
+		if _, err := svc.CreateAlert(context.Background(), b.ID, threshold, true); err != nil {
+			t.Fatalf("CreateAlert(%v): %v", threshold, err)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/api/v1/budgets/alerts?budget_id="+b.ID.String(), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("list status = %d", rec.Code)
+	}
+	var listed struct {
+		Data []struct {
+			ThresholdPercent float64 `json:"threshold_percent"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &listed); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(listed.Data) != 2 || listed.Data[0].ThresholdPercent != 50 || listed.Data[1].ThresholdPercent != 95 {
+		t.Errorf("listed = %+v, want configured 50 and 95", listed.Data)
+	}
+}
+
+func TestCreateBudget_RoleEnforcement(t *testing.T) {
+	mux, budgets, _, users := newBudgetHandlerAuthzFixture(t)
+	familyID := uuid.New()
+	admin := seedRoleUser(t, users, familyID, user.RoleAdmin)
+	child := seedRoleUser(t, users, familyID, user.RoleChild)
+
+	url := "/api/v1/budgets?family_id=" + familyID.String() +
+		"&name=Groceries&amount=400&period=monthly&start_date=2026-08-01&end_date=2026-08-31&user_id="
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, url+child.ID.String(), nil))
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("child create status = %d, want 403", rec.Code)
+	}
+	if stored, err := budgets.GetByFamilyID(context.Background(), familyID); err != nil || len(stored) != 0 {
+		t.Fatalf("budgets after denied create = %d, %v; want none", len(stored), err)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, url+admin.ID.String(), nil))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("admin create status = %d, body %s", rec.Code, rec.Body.String())
+	}
+	stored, err := budgets.GetByFamilyID(context.Background(), familyID)
+	if err != nil || len(stored) != 1 || stored[0].Name != "Groceries" {
+		t.Fatalf("budgets after admin create = %+v, %v; want the new budget", stored, err)
+	}
+}
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/services"
+	"family-budget-service/pkg/uuid"
+)
+
+// CategoryHandler serves category routes. Reads are open to every family
+// member; mutations require the admin or member role — children are
+// read-only.
+type CategoryHandler struct {
+	categoryService *services.CategoryService
+	guard           *RoleGuard
+}
+
+// NewCategoryHandler builds the category handler.
+func NewCategoryHandler(categoryService *services.CategoryService, guard *RoleGuard) *CategoryHandler {
+	return &CategoryHandler{categoryService: categoryService, guard: guard}
+}
+
+// Register wires the category routes onto mux.
+func (h *CategoryHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/categories", h.categories)
+}
+
+// categories dispatches the category collection routes by method.
+func (h *CategoryHandler) categories(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.Categories(w, r)
+	case http.MethodPost:
+		h.guard.Require(h.Create, user.RoleAdmin, user.RoleMember)(w, r)
+	case http.MethodDelete:
+		h.guard.Require(h.Delete, user.RoleAdmin, user.RoleMember)(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+	}
+}
+
+type categoryResponse struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Color    string `json:"color,omitempty"`
+	Icon     string `json:"icon,omitempty"`
+	ParentID string `json:"parent_id,omitempty"`
+	FamilyID string `json:"family_id"`
+	IsActive bool   `json:"is_active"`
+}
+
+func toCategoryResponse(c *category.Category) categoryResponse {
+	out := categoryResponse{
+		ID:       c.ID.String(),
+		Name:     c.Name,
+		Type:     string(c.Type),
+		Color:    c.Color,
+		Icon:     c.Icon,
+		FamilyID: c.FamilyID.String(),
+		IsActive: c.IsActive,
+	}
+	if c.ParentID != nil {
+		out.ParentID = c.ParentID.String()
+	}
+	return out
+}
+
+// Categories lists a family's categories.
+func (h *CategoryHandler) Categories(w http.ResponseWriter, r *http.Request) {
+	familyID, ok := parseUUIDParam(w, r.URL.Query().Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	categories, err := h.categoryService.GetCategoriesByFamily(r.Context(), familyID)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := make([]categoryResponse, 0, len(categories))
+	for _, c := range categories {
+		out = append(out, toCategoryResponse(c))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
+
+// Create persists a new category.
+func (h *CategoryHandler) Create(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	name := query.Get("name")
+	if name == "" {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "name", Message: "category name is required"})
+		return
+	}
+	categoryType := category.Type(query.Get("type"))
+	if categoryType != category.TypeIncome && categoryType != category.TypeExpense {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "type", Message: "must be income or expense"})
+		return
+	}
+
+	now := time.Now()
+	c := &category.Category{
+		ID:        uuid.New(),
+		Name:      name,
+		Type:      categoryType,
+		Color:     query.Get("color"),
+		Icon:      query.Get("icon"),
+		FamilyID:  familyID,
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if raw := query.Get("parent_id"); raw != "" {
+		parentID, ok := parseUUIDParam(w, raw, "parent_id")
+		if !ok {
+			return
+		}
+		c.ParentID = &parentID
+	}
+
+	if err := h.categoryService.CreateCategory(r.Context(), c); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]any{"data": toCategoryResponse(c)})
+}
+
+// Delete removes a category, optionally reassigning its references to
+// another category via reassign_to.
+func (h *CategoryHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	id, ok := parseUUIDParam(w, query.Get("id"), "id")
+	if !ok {
+		return
+	}
+	var reassignTo *uuid.UUID
+	if raw := query.Get("reassign_to"); raw != "" {
+		target, ok := parseUUIDParam(w, raw, "reassign_to")
+		if !ok {
+			return
+		}
+		reassignTo = &target
+	}
+
+	if err := h.categoryService.DeleteCategory(r.Context(), id, reassignTo); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
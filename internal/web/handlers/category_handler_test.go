@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services"
+	"family-budget-service/pkg/uuid"
+)
+
+func newCategoryHandlerFixture(t *testing.T) (*http.ServeMux, *memory.CategoryRepository, *memory.UserRepository) {
+	t.Helper()
+	categories := memory.NewCategoryRepository()
+	svc := services.NewCategoryService(categories)
+	users := memory.NewUserRepository()
+	guard := NewRoleGuard(services.NewUserService(users, memory.NewReportRepository(), ""))
+	mux := http.NewServeMux()
+	NewCategoryHandler(svc, guard).Register(mux)
+	return mux, categories, users
+}
+
+func TestCreateCategory_RoleEnforcement(t *testing.T) {
+	mux, categories, users := newCategoryHandlerFixture(t)
+	familyID := uuid.New()
+	member := seedRoleUser(t, users, familyID, user.RoleMember)
+	child := seedRoleUser(t, users, familyID, user.RoleChild)
+
+	url := "/api/v1/categories?family_id=" + familyID.String() + "&name=Food&type=expense&user_id="
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, url+child.ID.String(), nil))
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("child create status = %d, want 403", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, url+member.ID.String(), nil))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("member create status = %d, body %s", rec.Code, rec.Body.String())
+	}
+	stored, err := categories.GetByFamilyID(context.Background(), familyID)
+	if err != nil || len(stored) != 1 || stored[0].Name != "Food" {
+		t.Fatalf("categories after member create = %+v, %v; want the new category", stored, err)
+	}
+
+	// Children stay read-only but can still list.
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/api/v1/categories?family_id="+familyID.String(), nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("child-visible list status = %d, want 200", rec.Code)
+	}
+
+	// Deletes are guarded the same way.
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete,
+		"/api/v1/categories?id="+stored[0].ID.String()+"&user_id="+child.ID.String(), nil))
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("child delete status = %d, want 403", rec.Code)
+	}
+}
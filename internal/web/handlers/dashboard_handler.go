@@ -0,0 +1,274 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"family-budget-service/internal/services"
+)
+
+// DashboardHandler serves the family dashboard route.
+type DashboardHandler struct {
+	dashboardService *services.DashboardService
+}
+
+// NewDashboardHandler builds the dashboard handler.
+func NewDashboardHandler(dashboardService *services.DashboardService) *DashboardHandler {
+	return &DashboardHandler{dashboardService: dashboardService}
+}
+
+// Register wires the dashboard routes onto mux.
+func (h *DashboardHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/dashboard", h.Dashboard)
+	mux.HandleFunc("/api/v1/dashboard/yearly", h.Yearly)
+	mux.HandleFunc("/api/v1/dashboard/sparkline", h.Sparkline)
+}
+
+type sparklinePointResponse struct {
+	Month string  `json:"month"`
+	Net   float64 `json:"net"`
+}
+
+// Sparkline returns the last twelve months of net income as a compact
+// series for the dashboard's inline trend. The window ends at the
+// current month.
+func (h *DashboardHandler) Sparkline(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	familyID, ok := parseUUIDParam(w, r.URL.Query().Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+
+	points, err := h.dashboardService.GetNetSparkline(r.Context(), familyID, time.Now())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := make([]sparklinePointResponse, 0, len(points))
+	for _, p := range points {
+		out = append(out, sparklinePointResponse{
+			Month: time.Date(p.Year, p.Month, 1, 0, 0, 0, 0, time.UTC).Format("2006-01"),
+			Net:   p.Net,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
+
+type dashboardCategoryResponse struct {
+	CategoryID string  `json:"category_id"`
+	Amount     float64 `json:"amount"`
+	Count      int     `json:"count"`
+}
+
+type dashboardTransactionResponse struct {
+	ID          string    `json:"id"`
+	Amount      float64   `json:"amount"`
+	Type        string    `json:"type"`
+	Description string    `json:"description"`
+	Date        time.Time `json:"date"`
+}
+
+type dashboardChangesResponse struct {
+	PreviousFrom   string  `json:"previous_from"`
+	PreviousTo     string  `json:"previous_to"`
+	IncomeChange   float64 `json:"income_change_pct"`
+	ExpensesChange float64 `json:"expenses_change_pct"`
+}
+
+type dashboardResponse struct {
+	FamilyID         string                         `json:"family_id"`
+	From             string                         `json:"from"`
+	To               string                         `json:"to"`
+	TotalIncome      float64                        `json:"total_income"`
+	TotalExpenses    float64                        `json:"total_expenses"`
+	NetIncome        float64                        `json:"net_income"`
+	TransactionCount int                            `json:"transaction_count"`
+	TopCategories    []dashboardCategoryResponse    `json:"top_categories"`
+	Recent           []dashboardTransactionResponse `json:"recent_transactions"`
+	Changes          dashboardChangesResponse       `json:"changes"`
+}
+
+type monthlyTotalResponse struct {
+	Month    int     `json:"month"`
+	Income   float64 `json:"income"`
+	Expenses float64 `json:"expenses"`
+}
+
+type yearlyOverviewResponse struct {
+	FamilyID      string                 `json:"family_id"`
+	Year          int                    `json:"year"`
+	Months        []monthlyTotalResponse `json:"months"`
+	TotalIncome   float64                `json:"total_income"`
+	TotalExpenses float64                `json:"total_expenses"`
+}
+
+// Yearly returns twelve month buckets of income and expenses for one
+// year. The year parameter defaults to the current year.
+func (h *DashboardHandler) Yearly(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	year := time.Now().Year()
+	if raw := query.Get("year"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "year", Message: "must be a positive year"})
+			return
+		}
+		year = parsed
+	}
+
+	overview, err := h.dashboardService.GetYearlyOverview(r.Context(), familyID, year)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := yearlyOverviewResponse{
+		FamilyID:      overview.FamilyID.String(),
+		Year:          overview.Year,
+		Months:        make([]monthlyTotalResponse, 0, len(overview.Months)),
+		TotalIncome:   overview.TotalIncome,
+		TotalExpenses: overview.TotalExpenses,
+	}
+	for _, m := range overview.Months {
+		out.Months = append(out.Months, monthlyTotalResponse{
+			Month:    int(m.Month),
+			Income:   m.Income,
+			Expenses: m.Expenses,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
+
+// Dashboard returns the family overview for a period. Without from/to
+// parameters the window defaults to the current calendar month.
+func (h *DashboardHandler) Dashboard(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+
+	// All day boundaries are computed in the family's configured
+	// timezone, so "today" means the user's today; transactions stay
+	// stored in UTC and the window instants compare correctly.
+	loc := h.dashboardService.FamilyLocation(r.Context(), familyID)
+	now := time.Now().In(loc)
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+	to := from.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	// The default window is the current-month preset; explicit from/to
+	// below turn the request into a custom range.
+	preset := services.PeriodCurrentMonth
+	custom := false
+	if raw := query.Get("period"); raw != "" {
+		preset = services.DashboardPeriod(raw)
+		if !preset.Valid() {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "period", Message: "unknown period preset"})
+			return
+		}
+		var err error
+		from, to, err = preset.GetPeriodDates(now)
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+	}
+	if raw := query.Get("from"); raw != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", raw, loc)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "from", Message: "must be a YYYY-MM-DD date"})
+			return
+		}
+		from = parsed
+		custom = true
+	}
+	if raw := query.Get("to"); raw != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", raw, loc)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "to", Message: "must be a YYYY-MM-DD date"})
+			return
+		}
+		to = parsed.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		custom = true
+	}
+	if to.Before(from) {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "to", Message: "must not be before from"})
+		return
+	}
+
+	// Presets compare calendar-aware (March against all of February, not
+	// a 31-day slice of it); custom ranges compare against the same
+	// duration immediately before.
+	var prevFrom, prevTo time.Time
+	if custom {
+		prevFrom, prevTo = services.PreviousWindow(from, to)
+	} else {
+		var err error
+		prevFrom, prevTo, err = preset.GetPreviousPeriodDates(now)
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+	}
+
+	d, changes, err := h.dashboardService.GetDashboardWithChanges(
+		r.Context(), familyID, from, to, prevFrom, prevTo)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	out := dashboardResponse{
+		FamilyID:         d.FamilyID.String(),
+		From:             d.From.Format("2006-01-02"),
+		To:               d.To.Format("2006-01-02"),
+		TotalIncome:      d.TotalIncome,
+		TotalExpenses:    d.TotalExpenses,
+		NetIncome:        d.NetIncome,
+		TransactionCount: d.TransactionCount,
+		TopCategories:    make([]dashboardCategoryResponse, 0, len(d.TopCategories)),
+		Recent:           make([]dashboardTransactionResponse, 0, len(d.Recent)),
+		Changes: dashboardChangesResponse{
+			PreviousFrom:   changes.PreviousFrom.Format("2006-01-02"),
+			PreviousTo:     changes.PreviousTo.Format("2006-01-02"),
+			IncomeChange:   changes.IncomeChange,
+			ExpensesChange: changes.ExpensesChange,
+		},
+	}
+	for _, c := range d.TopCategories {
+		out.TopCategories = append(out.TopCategories, dashboardCategoryResponse{
+			CategoryID: c.CategoryID.String(),
+			Amount:     c.Amount,
+			Count:      c.Count,
+		})
+	}
+	for _, t := range d.Recent {
+		out.Recent = append(out.Recent, dashboardTransactionResponse{
+			ID:          t.ID.String(),
+			Amount:      t.Amount,
+			Type:        string(t.Type),
+			Description: t.Description,
+			Date:        t.Date,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
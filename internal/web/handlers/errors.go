@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"family-budget-service/internal/domain/account"
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/preferences"
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/domain/savings"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/services"
+	"family-budget-service/internal/web/validation"
+)
+
+// Error codes of the JSON API error envelope. Clients branch on the code,
+// not the human-readable message.
+const (
+	CodeValidation  = "validation_error"
+	CodeNotFound    = "not_found"
+	CodeConflict    = "conflict"
+	CodeForbidden   = "forbidden"
+	CodeTooLarge    = "payload_too_large"
+	CodeRateLimited = "rate_limited"
+	CodeInternal    = "internal_error"
+)
+
+// FieldError points a validation failure at the offending input field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// errorBody is the envelope payload under the "error" key.
+type errorBody struct {
+	Code    string       `json:"code"`
+	Message string       `json:"message"`
+	Fields  []FieldError `json:"fields,omitempty"`
+}
+
+// writeError renders the consolidated API error envelope:
+//
+//	{"error": {"code": "...", "message": "...", "fields": [...]}}
+func writeError(w http.ResponseWriter, status int, code, message string, fields ...FieldError) {
+	writeJSON(w, status, map[string]any{"error": errorBody{
+		Code:    code,
+		Message: message,
+		Fields:  fields,
+	}})
+}
+
+// writeServiceError maps domain sentinel and service validation errors
+// onto the envelope, defaulting to a 500 for anything unrecognized.
+func writeServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, user.ErrNotFound),
+		errors.Is(err, account.ErrNotFound),
+		errors.Is(err, user.ErrFamilyNotFound),
+		errors.Is(err, budget.ErrNotFound),
+		errors.Is(err, budget.ErrAlertNotFound),
+		errors.Is(err, category.ErrNotFound),
+		errors.Is(err, transaction.ErrNotFound),
+		errors.Is(err, transaction.ErrRecurringNotFound),
+		errors.Is(err, transaction.ErrAttachmentNotFound),
+		errors.Is(err, report.ErrNotFound),
+		errors.Is(err, preferences.ErrNotFound),
+		errors.Is(err, savings.ErrNotFound):
+		writeError(w, http.StatusNotFound, CodeNotFound, err.Error())
+	case errors.Is(err, budget.ErrDuplicateAlertThreshold),
+		errors.Is(err, budget.ErrPeriodOverlap),
+		errors.Is(err, budget.ErrVersionConflict),
+		errors.Is(err, user.ErrEmailTaken),
+		errors.Is(err, services.ErrCategoryInUse),
+		errors.Is(err, services.ErrBudgetExceeded):
+		writeError(w, http.StatusConflict, CodeConflict, err.Error())
+	case errors.Is(err, services.ErrInvalidAlertThreshold),
+		errors.Is(err, services.ErrNegativeGoal),
+		errors.Is(err, services.ErrInvalidWeekStart),
+		errors.Is(err, services.ErrInvalidRetention),
+		errors.Is(err, services.ErrInvalidTimezone),
+		errors.Is(err, services.ErrInvalidRecurringFrequency),
+		errors.Is(err, services.ErrUserNotInFamily),
+		errors.Is(err, services.ErrUnsupportedExportFormat),
+		errors.Is(err, services.ErrIncompatibleReassignTarget),
+		errors.Is(err, services.ErrNegativeSpendingLimit),
+		errors.Is(err, services.ErrSpendingLimitExceeded),
+		errors.Is(err, transaction.ErrSplitSumMismatch),
+		errors.Is(err, transaction.ErrInvalidType),
+		errors.Is(err, services.ErrInvalidEmailChangeToken),
+		errors.Is(err, services.ErrInvalidInvitationToken),
+		errors.Is(err, services.ErrInvalidRole),
+		errors.Is(err, services.ErrInvalidSavingsGoal),
+		errors.Is(err, services.ErrInvalidAccount),
+		errors.Is(err, services.ErrInvalidTransfer),
+		errors.Is(err, validation.ErrInvalidHexColor),
+		errors.Is(err, validation.ErrInvalidCategoryIcon),
+		errors.Is(err, validation.ErrInvalidEmail):
+		writeError(w, http.StatusBadRequest, CodeValidation, err.Error())
+	case errors.Is(err, services.ErrNotFamilyAdmin):
+		writeError(w, http.StatusForbidden, CodeForbidden, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, CodeInternal, "internal error")
+	}
+}
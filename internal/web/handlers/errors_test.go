@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"family-budget-service/pkg/uuid"
+)
+
+type errorEnvelope struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+		Fields  []struct {
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"fields"`
+	} `json:"error"`
+}
+
+func decodeErrorEnvelope(t *testing.T, rec *httptest.ResponseRecorder) errorEnvelope {
+	t.Helper()
+	var env errorEnvelope
+	if err := json.Unmarshal(rec.Body.Bytes(), &env); err != nil {
+		t.Fatalf("decode error envelope: %v, body %s", err, rec.Body.String())
+	}
+	return env
+}
+
+func TestErrorEnvelope_ValidationFailureShape(t *testing.T) {
+	mux, _, _ := newBudgetHandlerFixture(t)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/api/v1/budgets/alerts?budget_id=not-a-uuid", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400", rec.Code)
+	}
+
+	env := decodeErrorEnvelope(t, rec)
+	if env.Error.Code != CodeValidation {
+		t.Errorf("code = %q, want %q", env.Error.Code, CodeValidation)
+	}
+	if env.Error.Message == "" {
+		t.Error("message is empty")
+	}
+	if len(env.Error.Fields) != 1 || env.Error.Fields[0].Field != "budget_id" {
+		t.Errorf("fields = %+v, want one entry for budget_id", env.Error.Fields)
+	}
+}
+
+func TestErrorEnvelope_TransactionCreateFieldErrors(t *testing.T) {
+	mux, _ := newExportFixture(t)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/api/v1/transactions?family_id=bad&user_id=bad&category_id=bad&amount=-5&type=refund", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400, body %s", rec.Code, rec.Body.String())
+	}
+
+	env := decodeErrorEnvelope(t, rec)
+	if env.Error.Code != CodeValidation {
+		t.Errorf("code = %q, want %q", env.Error.Code, CodeValidation)
+	}
+	got := make(map[string]string, len(env.Error.Fields))
+	for _, f := range env.Error.Fields {
+		if f.Message == "" {
+			t.Errorf("field %q has empty message", f.Field)
+		}
+		got[f.Field] = f.Message
+	}
+	for _, field := range []string{"family_id", "user_id", "category_id", "amount", "type"} {
+		if _, ok := got[field]; !ok {
+			t.Errorf("fields missing entry for %q, got %+v", field, env.Error.Fields)
+		}
+	}
+}
+
+func TestErrorEnvelope_NotFoundShape(t *testing.T) {
+	mux, _, _ := newBudgetHandlerFixture(t)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete,
+		"/api/v1/budgets/alerts?id="+uuid.New().String(), nil))
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want 404", rec.Code)
+	}
+
+	env := decodeErrorEnvelope(t, rec)
+	if env.Error.Code != CodeNotFound {
+		t.Errorf("code = %q, want %q", env.Error.Code, CodeNotFound)
+	}
+	if env.Error.Message == "" {
+		t.Error("message is empty")
+	}
+	if len(env.Error.Fields) != 0 {
+		t.Errorf("fields = %+v, want none", env.Error.Fields)
+	}
+}
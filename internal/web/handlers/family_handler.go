@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"family-budget-service/internal/services"
+)
+
+// FamilyHandler serves family-level overview routes.
+type FamilyHandler struct {
+	transactionService *services.TransactionService
+}
+
+// NewFamilyHandler builds the family handler.
+func NewFamilyHandler(transactionService *services.TransactionService) *FamilyHandler {
+	return &FamilyHandler{transactionService: transactionService}
+}
+
+// Register wires the family routes onto mux.
+func (h *FamilyHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/families/statistics", h.Statistics)
+}
+
+type familyStatisticsResponse struct {
+	TransactionCount   int        `json:"transaction_count"`
+	TotalIncome        float64    `json:"total_income"`
+	TotalExpenses      float64    `json:"total_expenses"`
+	MemberCount        int        `json:"member_count"`
+	OldestTransaction  *time.Time `json:"oldest_transaction,omitempty"`
+	MostUsedCategoryID string     `json:"most_used_category_id,omitempty"`
+}
+
+// Statistics returns lifetime aggregates for the family overview page.
+func (h *FamilyHandler) Statistics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	familyID, ok := parseUUIDParam(w, r.URL.Query().Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	stats, err := h.transactionService.GetFamilyStatistics(r.Context(), familyID)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := familyStatisticsResponse{
+		TransactionCount:  stats.TransactionCount,
+		TotalIncome:       stats.TotalIncome,
+		TotalExpenses:     stats.TotalExpenses,
+		MemberCount:       stats.MemberCount,
+		OldestTransaction: stats.OldestTransaction,
+	}
+	if !stats.MostUsedCategoryID.IsNil() {
+		out.MostUsedCategoryID = stats.MostUsedCategoryID.String()
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
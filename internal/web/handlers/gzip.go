@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// DefaultGzipMinBytes is the response size below which compression isn't
+// worth the CPU and header overhead.
+const DefaultGzipMinBytes = 1 << 10 // 1 KiB
+
+// incompressibleTypes lists content-type prefixes that are already
+// compressed; gzipping them again only wastes CPU.
+var incompressibleTypes = []string{
+	"application/zip",
+	"application/gzip",
+	"application/pdf",
+	"application/vnd.openxmlformats", // xlsx and friends
+	"image/",
+	"audio/",
+	"video/",
+}
+
+// Gzip compresses responses larger than minBytes for clients that send
+// Accept-Encoding: gzip. All other response headers — content type,
+// Content-Disposition on exports — pass through unchanged. Responses in
+// already-compressed formats are left alone.
+func Gzip(next http.Handler, minBytes int) http.Handler {
+	if minBytes <= 0 {
+		minBytes = DefaultGzipMinBytes
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		buffered := &bufferedResponse{header: make(http.Header), status: http.StatusOK}
+		next.ServeHTTP(buffered, r)
+		buffered.flushTo(w, minBytes)
+	})
+}
+
+// bufferedResponse holds the downstream handler's full response so the
+// middleware can decide afterwards whether compressing it pays off.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header { return b.header }
+
+func (b *bufferedResponse) WriteHeader(status int) { b.status = status }
+
+func (b *bufferedResponse) Write(p []byte) (int, error) { return b.body.Write(p) }
+
+// flushTo writes the buffered response to the real writer, gzipped when
+// it is large enough and in a compressible format.
+func (b *bufferedResponse) flushTo(w http.ResponseWriter, minBytes int) {
+	for key, values := range b.header {
+		w.Header()[key] = values
+	}
+	if b.body.Len() < minBytes || !compressible(b.header.Get("Content-Type")) {
+		w.Header().Set("Content-Length", strconv.Itoa(b.body.Len()))
+		w.WriteHeader(b.status)
+		_, _ = w.Write(b.body.Bytes())
+		return
+	}
+	w.Header().Del("Content-Length")
+	w.Header().Set("Content-Encoding", "gzip")
+	w.Header().Add("Vary", "Accept-Encoding")
+	w.WriteHeader(b.status)
+	gz := gzip.NewWriter(w)
+	_, _ = gz.Write(b.body.Bytes())
+	_ = gz.Close()
+}
+
+// compressible reports whether a content type benefits from gzip.
+func compressible(contentType string) bool {
+	for _, prefix := range incompressibleTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return false
+		}
+	}
+	return true
+}
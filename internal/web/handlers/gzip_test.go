@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGzip_LargeCSVCompressedAndDecodable(t *testing.T) {
+	payload := strings.Repeat("category,amount\nGroceries,123.45\n", 200)
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="report.csv"`)
+		_, _ = w.Write([]byte(payload))
+	})
+	wrapped := Gzip(next, 0)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/export?id=x", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	wrapped.ServeHTTP(rec, req)
+
+	if enc := rec.Header().Get("Content-Encoding"); enc != "gzip" {
+		t.Fatalf("Content-Encoding = %q, want gzip", enc)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "text/csv" {
+		t.Errorf("Content-Type = %q, want text/csv", ct)
+	}
+	if cd := rec.Header().Get("Content-Disposition"); !strings.Contains(cd, "report.csv") {
+		t.Errorf("Content-Disposition = %q, want the export filename kept", cd)
+	}
+	gz, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("gzip.NewReader: %v", err)
+	}
+	decoded, err := io.ReadAll(gz)
+	if err != nil {
+		t.Fatalf("decompress: %v", err)
+	}
+	if string(decoded) != payload {
+		t.Errorf("decompressed body differs from original (%d vs %d bytes)", len(decoded), len(payload))
+	}
+	if rec.Body.Len() >= len(payload) {
+		t.Errorf("compressed body (%d bytes) not smaller than original (%d bytes)", rec.Body.Len(), len(payload))
+	}
+}
+
+func TestGzip_SmallAndIncompressibleResponsesUntouched(t *testing.T) {
+	small := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	})
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	Gzip(small, 0).ServeHTTP(rec, req)
+	if enc := rec.Header().Get("Content-Encoding"); enc != "" {
+		t.Errorf("small response Content-Encoding = %q, want none", enc)
+	}
+	if rec.Body.String() != `{"data":{}}` {
+		t.Errorf("small body altered: %q", rec.Body.String())
+	}
+
+	pdf := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		_, _ = w.Write([]byte(strings.Repeat("x", 4096)))
+	})
+	rec = httptest.NewRecorder()
+	Gzip(pdf, 0).ServeHTTP(rec, req.Clone(req.Context()))
+	if enc := rec.Header().Get("Content-Encoding"); enc != "" {
+		t.Errorf("pdf response Content-Encoding = %q, want none", enc)
+	}
+
+	noGzip := httptest.NewRequest(http.MethodGet, "/api/v1/reports", nil)
+	large := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = w.Write([]byte(strings.Repeat("x", 4096)))
+	})
+	rec = httptest.NewRecorder()
+	Gzip(large, 0).ServeHTTP(rec, noGzip)
+	if enc := rec.Header().Get("Content-Encoding"); enc != "" {
+		t.Errorf("without Accept-Encoding, Content-Encoding = %q, want none", enc)
+	}
+}
@@ -0,0 +1,30 @@
+// Package handlers contains the HTTP handlers of the service. Handlers
+// parse and validate input, call services, and render JSON (API routes)
+// or HTML (web routes); they hold no business logic of their own.
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// writeJSON renders v as a JSON response with the given status.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// parseUUIDParam reads a UUID from a query or path value, writing a 400
+// validation envelope on failure. The bool result reports success.
+func parseUUIDParam(w http.ResponseWriter, raw, name string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(raw)
+	if err != nil || id.IsNil() {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: name, Message: "must be a valid UUID"})
+		return uuid.Nil, false
+	}
+	return id, true
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"family-budget-service/internal/version"
+)
+
+// DefaultReadinessTimeout bounds how long a single readiness probe may
+// take; orchestrators poll frequently and expect fast answers.
+const DefaultReadinessTimeout = 2 * time.Second
+
+// ReadinessCheck probes one downstream dependency by name.
+type ReadinessCheck struct {
+	Name  string
+	Probe func(ctx context.Context) error
+}
+
+// HealthHandler serves the liveness and readiness probes. Liveness only
+// proves the process answers; readiness additionally pings every
+// registered dependency.
+type HealthHandler struct {
+	checks  []ReadinessCheck
+	timeout time.Duration
+}
+
+// NewHealthHandler builds the health handler over the given dependency
+// checks.
+func NewHealthHandler(checks ...ReadinessCheck) *HealthHandler {
+	return &HealthHandler{checks: checks, timeout: DefaultReadinessTimeout}
+}
+
+// SetReadinessTimeout overrides the per-probe timeout. Non-positive
+// values restore the default.
+func (h *HealthHandler) SetReadinessTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = DefaultReadinessTimeout
+	}
+	h.timeout = timeout
+}
+
+// Register wires the health routes onto mux.
+func (h *HealthHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/health", h.Live)
+	mux.HandleFunc("/health/ready", h.Ready)
+	mux.HandleFunc("/version", h.Version)
+}
+
+// versionResponse identifies the running build.
+type versionResponse struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"build_time"`
+}
+
+func buildVersion() versionResponse {
+	return versionResponse{
+		Version:   version.Version,
+		Commit:    version.Commit,
+		BuildTime: version.BuildTime,
+	}
+}
+
+// Live is the cheap liveness check: the process is up and serving. The
+// build identification rides along so a probe log names the deploy.
+func (h *HealthHandler) Live(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": map[string]any{
+		"status":  "ok",
+		"version": buildVersion(),
+	}})
+}
+
+// Version reports which build is live: version, commit and build time as
+// injected at link time.
+func (h *HealthHandler) Version(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": buildVersion()})
+}
+
+// checkResult is one dependency's outcome in the readiness response.
+type checkResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Ready pings every dependency with a short timeout and reports 503 with
+// the failing ones listed when any is down.
+func (h *HealthHandler) Ready(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+
+	results := make([]checkResult, 0, len(h.checks))
+	ready := true
+	for _, check := range h.checks {
+		ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+		err := check.Probe(ctx)
+		cancel()
+		result := checkResult{Name: check.Name, Status: "ok"}
+		if err != nil {
+			result.Status = "down"
+			result.Error = err.Error()
+			ready = false
+		}
+		results = append(results, result)
+	}
+
+	status := http.StatusOK
+	state := "ready"
+	if !ready {
+		status = http.StatusServiceUnavailable
+		state = "not_ready"
+	}
+	writeJSON(w, status, map[string]any{"data": map[string]any{
+		"status": state,
+		"checks": results,
+	}})
+}
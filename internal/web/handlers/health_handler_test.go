@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"family-budget-service/internal/version"
+)
+
+func TestHealthReady_AllDependenciesUp(t *testing.T) {
+	mux := http.NewServeMux()
+	NewHealthHandler(
+		ReadinessCheck{Name: "database", Probe: func(context.Context) error { return nil }},
+	).Register(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200, body %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), `"status":"ready"`) {
+		t.Errorf("body = %s, want ready status", rec.Body.String())
+	}
+}
+
+func TestHealthReady_NamesFailingDependency(t *testing.T) {
+	mux := http.NewServeMux()
+	NewHealthHandler(
+		ReadinessCheck{Name: "database", Probe: func(context.Context) error { return errors.New("connection refused") }},
+		ReadinessCheck{Name: "sessions", Probe: func(context.Context) error { return nil }},
+	).Register(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want 503, body %s", rec.Code, rec.Body.String())
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, `"name":"database"`) || !strings.Contains(body, `"status":"down"`) {
+		t.Errorf("body = %s, want the database check marked down", body)
+	}
+	if !strings.Contains(body, `"connection refused"`) {
+		t.Errorf("body = %s, want the probe error surfaced", body)
+	}
+
+	// Liveness stays cheap and green regardless.
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("liveness status = %d, want 200", rec.Code)
+	}
+}
+
+func TestVersion_DefaultsWhenUnset(t *testing.T) {
+	mux := http.NewServeMux()
+	NewHealthHandler().Register(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/version", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, `"version":"dev"`) || !strings.Contains(body, `"commit":"unknown"`) {
+		t.Errorf("body = %s, want development defaults", body)
+	}
+}
+
+func TestVersion_ReflectsInjectedValues(t *testing.T) {
+	oldVersion, oldCommit := version.Version, version.Commit
+	version.Version, version.Commit = "v9.9.9", "abc1234"
+	defer func() { version.Version, version.Commit = oldVersion, oldCommit }()
+
+	mux := http.NewServeMux()
+	NewHealthHandler().Register(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/version", nil))
+	body := rec.Body.String()
+	if !strings.Contains(body, `"version":"v9.9.9"`) || !strings.Contains(body, `"commit":"abc1234"`) {
+		t.Errorf("body = %s, want the injected build identification", body)
+	}
+}
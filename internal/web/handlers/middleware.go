@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"family-budget-service/internal/tracing"
+)
+
+// DefaultMaxBodyBytes caps write-request bodies when no explicit limit is
+// configured.
+const DefaultMaxBodyBytes int64 = 1 << 20 // 1 MiB
+
+// maxImportBodyBytes is the default for the separate, larger cap on
+// file imports, which legitimately carry whole bank statements.
+const maxImportBodyBytes int64 = 10 << 20 // 10 MiB
+
+// LimitRequestBody rejects write requests whose body exceeds the route's
+// limit with a 413 before the handler runs. Read requests pass through
+// untouched. The import and upload routes get the separate, larger
+// importMaxBytes; everything else gets maxBytes. Non-positive limits
+// fall back to the package defaults. Bodies without a declared length
+// are still capped via http.MaxBytesReader.
+func LimitRequestBody(next http.Handler, maxBytes, importMaxBytes int64) http.Handler {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBodyBytes
+	}
+	if importMaxBytes <= 0 {
+		importMaxBytes = maxImportBodyBytes
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+		default:
+			next.ServeHTTP(w, r)
+			return
+		}
+		limit := maxBytes
+		if isUploadRoute(r.URL.Path) {
+			limit = importMaxBytes
+		}
+		if r.ContentLength > limit {
+			writeError(w, http.StatusRequestEntityTooLarge, CodeTooLarge, "request body too large")
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isUploadRoute reports whether the path carries file payloads and so
+// gets the larger import body limit.
+func isUploadRoute(path string) bool {
+	return strings.HasPrefix(path, "/api/v1/transactions/import") ||
+		strings.HasPrefix(path, "/api/v1/transactions/attachments")
+}
+
+// CSRFProtection rejects write requests whose X-CSRF-Token header does
+// not match the current token of the session identified by the
+// session_id cookie. Read requests pass through. A nil manager disables
+// the check, for deployments that don't use cookie sessions.
+func CSRFProtection(next http.Handler, sessions *SessionManager) http.Handler {
+	if sessions == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			next.ServeHTTP(w, r)
+			return
+		}
+		cookie, err := r.Cookie("session_id")
+		if err != nil || !sessions.ValidateCSRF(cookie.Value, r.Header.Get("X-CSRF-Token")) {
+			writeError(w, http.StatusForbidden, CodeForbidden, "invalid or missing CSRF token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Traced wraps next so every request runs inside a span named after its
+// method and path. Service spans started further down become children
+// of it through the request context. A nil tracer passes requests
+// through untraced.
+func Traced(next http.Handler, tracer *tracing.Tracer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracer.StartSpan(r.Context(), r.Method+" "+r.URL.Path)
+		defer span.End()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLimitRequestBody_OversizeWriteRejected(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	limited := LimitRequestBody(next, 64, 0)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/budgets/alerts",
+		strings.NewReader(strings.Repeat("x", 128)))
+	limited.ServeHTTP(rec, req)
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("oversize POST status = %d, want 413", rec.Code)
+	}
+	env := decodeErrorEnvelope(t, rec)
+	if env.Error.Code != CodeTooLarge {
+		t.Errorf("code = %q, want %q", env.Error.Code, CodeTooLarge)
+	}
+}
+
+func TestLimitRequestBody_SmallWriteAndReadsPass(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	limited := LimitRequestBody(next, 64, 0)
+
+	rec := httptest.NewRecorder()
+	limited.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/budgets/alerts",
+		strings.NewReader("small")))
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("small POST status = %d, want 204", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	limited.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/budgets/alerts",
+		strings.NewReader(strings.Repeat("x", 128))))
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("GET status = %d, want 204 regardless of body size", rec.Code)
+	}
+}
+
+func TestLimitRequestBody_ImportGetsLargerLimit(t *testing.T) {
+	ran := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		ran = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	limited := LimitRequestBody(next, 64, 256)
+
+	// Over the API limit but under the import limit: allowed.
+	rec := httptest.NewRecorder()
+	limited.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/transactions/import",
+		strings.NewReader(strings.Repeat("x", 128))))
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("import POST status = %d, want 204 (larger limit applies)", rec.Code)
+	}
+
+	// Over the import limit: rejected before the handler runs.
+	ran = false
+	rec = httptest.NewRecorder()
+	limited.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/transactions/import",
+		strings.NewReader(strings.Repeat("x", 512))))
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("oversize import status = %d, want 413", rec.Code)
+	}
+	if ran {
+		t.Error("handler ran despite oversize body")
+	}
+	env := decodeErrorEnvelope(t, rec)
+	if env.Error.Code != CodeTooLarge {
+		t.Errorf("code = %q, want %q", env.Error.Code, CodeTooLarge)
+	}
+}
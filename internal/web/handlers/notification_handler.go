@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"family-budget-service/internal/notification"
+)
+
+// NotificationHandler serves the in-app notification inbox and the
+// webhook delivery failure queue.
+type NotificationHandler struct {
+	inbox   notification.InboxRepository
+	webhook *notification.RetryingNotifier
+}
+
+// NewNotificationHandler builds the inbox handler. webhook may be nil
+// when webhook delivery is not configured; the failure routes then
+// respond 404.
+func NewNotificationHandler(inbox notification.InboxRepository, webhook *notification.RetryingNotifier) *NotificationHandler {
+	return &NotificationHandler{inbox: inbox, webhook: webhook}
+}
+
+// Register wires the inbox routes onto mux.
+func (h *NotificationHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/notifications", h.List)
+	mux.HandleFunc("/api/v1/notifications/read", h.MarkRead)
+	mux.HandleFunc("/api/v1/notifications/webhook/failures", h.WebhookFailures)
+	mux.HandleFunc("/api/v1/notifications/webhook/retry", h.RetryWebhook)
+}
+
+type deadLetterResponse struct {
+	ID        string    `json:"id"`
+	Channel   string    `json:"channel"`
+	Title     string    `json:"title"`
+	Message   string    `json:"message"`
+	Attempts  int       `json:"attempts"`
+	LastError string    `json:"last_error"`
+	FailedAt  time.Time `json:"failed_at"`
+}
+
+// WebhookFailures lists dead-lettered webhook deliveries.
+func (h *NotificationHandler) WebhookFailures(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	if h.webhook == nil {
+		writeError(w, http.StatusNotFound, CodeNotFound, "webhook delivery not configured")
+		return
+	}
+	letters, err := h.webhook.DeadLetters(r.Context())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := make([]deadLetterResponse, 0, len(letters))
+	for _, d := range letters {
+		out = append(out, deadLetterResponse{
+			ID:        d.ID.String(),
+			Channel:   d.Channel,
+			Title:     d.Notification.Title,
+			Message:   d.Notification.Message,
+			Attempts:  d.Attempts,
+			LastError: d.LastError,
+			FailedAt:  d.FailedAt,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
+
+// RetryWebhook redelivers one dead-lettered webhook notification.
+func (h *NotificationHandler) RetryWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	if h.webhook == nil {
+		writeError(w, http.StatusNotFound, CodeNotFound, "webhook delivery not configured")
+		return
+	}
+	id, ok := parseUUIDParam(w, r.URL.Query().Get("id"), "id")
+	if !ok {
+		return
+	}
+	err := h.webhook.RetryDeadLetter(r.Context(), id)
+	switch {
+	case errors.Is(err, notification.ErrDeadLetterNotFound):
+		writeError(w, http.StatusNotFound, CodeNotFound, "dead letter not found")
+	case err != nil:
+		writeError(w, http.StatusBadGateway, CodeInternal, "redelivery failed")
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+type inboxItemResponse struct {
+	ID        string     `json:"id"`
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	Message   string     `json:"message"`
+	Read      bool       `json:"read"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// List returns the caller's inbox. ?unread=true restricts it to unread
+// items.
+func (h *NotificationHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	userID, ok := parseUUIDParam(w, r.URL.Query().Get("user_id"), "user_id")
+	if !ok {
+		return
+	}
+	unreadOnly := strings.EqualFold(r.URL.Query().Get("unread"), "true")
+
+	items, err := h.inbox.ListByUser(r.Context(), userID, unreadOnly)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := make([]inboxItemResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, inboxItemResponse{
+			ID:        item.ID.String(),
+			Type:      string(item.Type),
+			Title:     item.Title,
+			Message:   item.Message,
+			Read:      item.Read,
+			ReadAt:    item.ReadAt,
+			CreatedAt: item.CreatedAt,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
+
+// MarkRead flags one inbox item as read for the caller.
+func (h *NotificationHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	userID, ok := parseUUIDParam(w, r.URL.Query().Get("user_id"), "user_id")
+	if !ok {
+		return
+	}
+	id, ok := parseUUIDParam(w, r.URL.Query().Get("id"), "id")
+	if !ok {
+		return
+	}
+
+	err := h.inbox.MarkRead(r.Context(), id, userID)
+	switch {
+	case errors.Is(err, notification.ErrInboxItemNotFound):
+		writeError(w, http.StatusNotFound, CodeNotFound, "notification not found")
+	case err != nil:
+		writeServiceError(w, err)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
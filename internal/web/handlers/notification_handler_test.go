@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"family-budget-service/internal/notification"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/pkg/uuid"
+)
+
+func TestNotificationInbox_DispatchedAlertIsUnreadThenRead(t *testing.T) {
+	inbox := memory.NewInboxRepository()
+	dispatcher := notification.NewDispatcher(notification.NewInAppNotifier(inbox))
+	userID := uuid.New()
+
+	alert := notification.NewBudgetThresholdAlert(uuid.New(), userID, "Groceries", 95)
+	if err := dispatcher.Dispatch(context.Background(), alert); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+
+	h := NewNotificationHandler(inbox, nil)
+	mux := http.NewServeMux()
+	h.Register(mux)
+
+	// The dispatched alert shows up unread.
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/notifications?user_id="+userID.String()+"&unread=true", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("list status = %d, body %s", rec.Code, rec.Body.String())
+	}
+	var listed struct {
+		Data []struct {
+			ID   string `json:"id"`
+			Read bool   `json:"read"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &listed); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(listed.Data) != 1 || listed.Data[0].Read {
+		t.Fatalf("unread inbox = %+v, want one unread item", listed.Data)
+	}
+
+	// Marking it read empties the unread view.
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/api/v1/notifications/read?user_id="+userID.String()+"&id="+listed.Data[0].ID, nil))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("mark read status = %d, body %s", rec.Code, rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/notifications?user_id="+userID.String()+"&unread=true", nil))
+	if err := json.Unmarshal(rec.Body.Bytes(), &listed); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(listed.Data) != 0 {
+		t.Errorf("unread inbox after mark-read = %+v, want empty", listed.Data)
+	}
+}
+
+func TestNotificationMarkRead_WrongUserIs404(t *testing.T) {
+	inbox := memory.NewInboxRepository()
+	owner := uuid.New()
+	alert := notification.NewBudgetThresholdAlert(uuid.New(), owner, "Transport", 85)
+	if err := inbox.Add(context.Background(), alert); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	h := NewNotificationHandler(inbox, nil)
+	mux := http.NewServeMux()
+	h.Register(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/api/v1/notifications/read?user_id="+uuid.New().String()+"&id="+alert.ID.String(), nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("cross-user mark-read status = %d, want 404", rec.Code)
+	}
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Rate limiting defaults for authentication endpoints: 5 attempts per
+// minute per client.
+const (
+	DefaultRateLimitAttempts = 5
+	DefaultRateLimitWindow   = time.Minute
+)
+
+// LimiterStore counts attempts per key inside a fixed window. The
+// in-memory implementation is the default; a Redis-backed one can plug
+// in for multi-instance deployments.
+type LimiterStore interface {
+	// Increment records one attempt for key and returns the attempt count
+	// within the current window plus the time the window resets.
+	Increment(key string, now time.Time, window time.Duration) (int, time.Time)
+}
+
+// MemoryLimiterStore is a process-local LimiterStore.
+type MemoryLimiterStore struct {
+	mu       sync.Mutex
+	counters map[string]windowCounter
+}
+
+type windowCounter struct {
+	count int
+	start time.Time
+}
+
+// NewMemoryLimiterStore builds an empty in-memory limiter store.
+func NewMemoryLimiterStore() *MemoryLimiterStore {
+	return &MemoryLimiterStore{counters: make(map[string]windowCounter)}
+}
+
+func (s *MemoryLimiterStore) Increment(key string, now time.Time, window time.Duration) (int, time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.counters[key]
+	if !ok || !now.Before(c.start.Add(window)) {
+		c = windowCounter{start: now}
+	}
+	c.count++
+	s.counters[key] = c
+	return c.count, c.start.Add(window)
+}
+
+// RateLimiter throttles brute-force-prone endpoints per client IP and,
+// when the request carries an email parameter, per email as well so an
+// attacker rotating IPs still cannot hammer one account.
+type RateLimiter struct {
+	store  LimiterStore
+	limit  int
+	window time.Duration
+	now    func() time.Time
+}
+
+// NewRateLimiter builds a RateLimiter. Non-positive limit or window fall
+// back to the defaults.
+func NewRateLimiter(store LimiterStore, limit int, window time.Duration) *RateLimiter {
+	if limit <= 0 {
+		limit = DefaultRateLimitAttempts
+	}
+	if window <= 0 {
+		window = DefaultRateLimitWindow
+	}
+	return &RateLimiter{store: store, limit: limit, window: window, now: time.Now}
+}
+
+// Limit wraps next, rejecting requests over the configured rate with a
+// 429 and a Retry-After header.
+func (l *RateLimiter) Limit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		now := l.now()
+		for _, key := range l.clientKeys(r) {
+			count, reset := l.store.Increment(key, now, l.window)
+			if count > l.limit {
+				retryAfter := int(reset.Sub(now).Seconds() + 0.999)
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+				writeError(w, http.StatusTooManyRequests, CodeRateLimited, "too many attempts, retry later")
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// clientKeys derives the limiter keys for a request: always the client
+// IP, plus the email parameter when present.
+func (l *RateLimiter) clientKeys(r *http.Request) []string {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		ip = host
+	}
+	keys := []string{"ip:" + ip}
+	if email := r.URL.Query().Get("email"); email != "" {
+		keys = append(keys, "email:"+email)
+	}
+	return keys
+}
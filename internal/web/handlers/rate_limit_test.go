@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newLimitedHandler(limit int, window time.Duration) (*RateLimiter, http.Handler) {
+	limiter := NewRateLimiter(NewMemoryLimiterStore(), limit, window)
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	return limiter, limiter.Limit(next)
+}
+
+func TestRateLimiter_RejectsOverLimit(t *testing.T) {
+	_, handler := newLimitedHandler(3, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/login?email=a@b.c", nil)
+		req.RemoteAddr = "10.0.0.1:4000"
+		handler.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("attempt %d status = %d, want 200", i+1, rec.Code)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/login?email=a@b.c", nil)
+	req.RemoteAddr = "10.0.0.1:4000"
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want 429 over the limit", rec.Code)
+	}
+	if rec.Header().Get("Retry-After") == "" {
+		t.Errorf("Retry-After header missing")
+	}
+
+	// Another IP targeting the same email is throttled too.
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/login?email=a@b.c", nil)
+	req.RemoteAddr = "10.0.0.2:4000"
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusTooManyRequests {
+		t.Errorf("status = %d, want 429 for the same email from a new IP", rec.Code)
+	}
+}
+
+func TestRateLimiter_CounterResetsAfterWindow(t *testing.T) {
+	limiter, handler := newLimitedHandler(1, time.Minute)
+	current := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return current }
+
+	send := func() int {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/login", nil)
+		req.RemoteAddr = "10.0.0.1:4000"
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := send(); code != http.StatusOK {
+		t.Fatalf("first attempt status = %d, want 200", code)
+	}
+	if code := send(); code != http.StatusTooManyRequests {
+		t.Fatalf("second attempt status = %d, want 429", code)
+	}
+
+	current = current.Add(time.Minute + time.Second)
+	if code := send(); code != http.StatusOK {
+		t.Errorf("status after the window = %d, want the counter reset", code)
+	}
+}
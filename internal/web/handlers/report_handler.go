@@ -0,0 +1,506 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/services"
+	"family-budget-service/internal/services/dto"
+)
+
+// ReportHandler serves the JSON report API.
+type ReportHandler struct {
+	reportService *services.ReportService
+}
+
+// NewReportHandler builds the report handler.
+func NewReportHandler(reportService *services.ReportService) *ReportHandler {
+	return &ReportHandler{reportService: reportService}
+}
+
+// Register wires the report routes onto mux.
+func (h *ReportHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/reports", h.Reports)
+	mux.HandleFunc("/api/v1/reports/generate", h.Generate)
+	mux.HandleFunc("/api/v1/reports/refresh", h.Refresh)
+	mux.HandleFunc("/api/v1/reports/compare", h.Compare)
+	mux.HandleFunc("/api/v1/reports/export", h.ExportCSV)
+}
+
+// Refresh re-runs a saved report against current data and returns the
+// updated snapshot.
+func (h *ReportHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	id, ok := parseUUIDParam(w, r.URL.Query().Get("id"), "id")
+	if !ok {
+		return
+	}
+	saved, err := h.reportService.RegenerateReport(r.Context(), id)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": toSavedReportResponse(saved)})
+}
+
+// Reports dispatches the collection route: an id parameter fetches one
+// saved report, otherwise the family's reports are listed.
+func (h *ReportHandler) Reports(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("id") != "" {
+		h.GetByID(w, r)
+		return
+	}
+	h.List(w, r)
+}
+
+type expenseReportResponse struct {
+	FamilyID      string                   `json:"family_id"`
+	StartDate     string                   `json:"start_date"`
+	EndDate       string                   `json:"end_date"`
+	TotalExpenses float64                  `json:"total_expenses"`
+	ByCategory    []categoryTotalResponse  `json:"by_category"`
+	Transactions  []reportTransactionEntry `json:"transactions"`
+}
+
+type categoryTotalResponse struct {
+	CategoryID string  `json:"category_id"`
+	Name       string  `json:"name,omitempty"`
+	Amount     float64 `json:"amount"`
+	Count      int     `json:"count"`
+}
+
+type reportTransactionEntry struct {
+	ID          string  `json:"id"`
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description,omitempty"`
+	Date        string  `json:"date"`
+}
+
+type reportDataResponse struct {
+	FamilyID      string  `json:"family_id"`
+	Type          string  `json:"type"`
+	StartDate     string  `json:"start_date"`
+	EndDate       string  `json:"end_date"`
+	TotalIncome   float64 `json:"total_income"`
+	TotalExpenses float64 `json:"total_expenses"`
+	NetIncome     float64 `json:"net_income"`
+}
+
+// etagFor derives a strong ETag from response content. Saved reports are
+// immutable, so a content hash is a stable validator.
+func etagFor(content []byte) string {
+	sum := sha256.Sum256(content)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// matchesETag reports whether an If-None-Match header matches etag,
+// ignoring weak validators of the same content.
+func matchesETag(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+	if header == "*" {
+		return true
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == etag || candidate == "W/"+etag {
+			return true
+		}
+	}
+	return false
+}
+
+type savedReportResponse struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	Type          string  `json:"type"`
+	Period        string  `json:"period"`
+	FamilyID      string  `json:"family_id"`
+	StartDate     string  `json:"start_date"`
+	EndDate       string  `json:"end_date"`
+	TotalIncome   float64 `json:"total_income"`
+	TotalExpenses float64 `json:"total_expenses"`
+	NetIncome     float64 `json:"net_income"`
+	GeneratedAt   string  `json:"generated_at"`
+}
+
+// toSavedReportResponse maps a saved report onto its JSON shape.
+func toSavedReportResponse(saved *report.Report) savedReportResponse {
+	return savedReportResponse{
+		ID:            saved.ID.String(),
+		Name:          saved.Name,
+		Type:          string(saved.Type),
+		Period:        string(saved.Period),
+		FamilyID:      saved.FamilyID.String(),
+		StartDate:     saved.StartDate.Format("2006-01-02"),
+		EndDate:       saved.EndDate.Format("2006-01-02"),
+		TotalIncome:   saved.Data.TotalIncome,
+		TotalExpenses: saved.Data.TotalExpenses,
+		NetIncome:     saved.Data.NetIncome,
+		GeneratedAt:   saved.GeneratedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// reportPageMeta is the pager block of the reports list response.
+type reportPageMeta struct {
+	Total  int `json:"total"`
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// List returns a page of the family's saved reports, newest first,
+// optionally filtered by type and generation date. The meta block
+// carries the total match count so clients can render pager controls.
+func (h *ReportHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	var opts services.ReportListOptions
+	if raw := query.Get("type"); raw != "" {
+		reportType := report.Type(raw)
+		if !reportType.Valid() {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "type", Message: "unknown report type"})
+			return
+		}
+		opts.Type = reportType
+	}
+	if raw := query.Get("generated_from"); raw != "" {
+		parsed, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "generated_from", Message: "must be a YYYY-MM-DD date"})
+			return
+		}
+		opts.GeneratedFrom = &parsed
+	}
+	if raw := query.Get("generated_to"); raw != "" {
+		parsed, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "generated_to", Message: "must be a YYYY-MM-DD date"})
+			return
+		}
+		// Include the whole end day, like the report window forms do.
+		parsed = parsed.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		opts.GeneratedTo = &parsed
+	}
+	if raw := query.Get("limit"); raw != "" {
+		opts.Limit, _ = strconv.Atoi(raw)
+	}
+	if raw := query.Get("offset"); raw != "" {
+		opts.Offset, _ = strconv.Atoi(raw)
+	}
+
+	page, err := h.reportService.ListReports(r.Context(), familyID, opts)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = services.DefaultReportPageSize
+	}
+	out := make([]savedReportResponse, 0, len(page.Reports))
+	for _, saved := range page.Reports {
+		out = append(out, toSavedReportResponse(saved))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"data": out,
+		"meta": reportPageMeta{Total: page.Total, Limit: limit, Offset: opts.Offset},
+	})
+}
+
+// GetByID returns one saved report as JSON. Reports are immutable, so
+// responses carry a content ETag and matching If-None-Match requests get
+// a 304 without a body.
+func (h *ReportHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	id, ok := parseUUIDParam(w, r.URL.Query().Get("id"), "id")
+	if !ok {
+		return
+	}
+	saved, err := h.reportService.GetReportByID(r.Context(), id)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	body, err := json.Marshal(map[string]any{"data": toSavedReportResponse(saved)})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, CodeInternal, "internal error")
+		return
+	}
+	etag := etagFor(body)
+	w.Header().Set("ETag", etag)
+	if matchesETag(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+// ExportCSV streams a saved report as CSV row by row, with the same
+// conditional-GET behavior as GetByID. The ETag derives from the report's
+// identity and generation time rather than the body, so the export never
+// has to be buffered just to hash it.
+func (h *ReportHandler) ExportCSV(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	id, ok := parseUUIDParam(w, r.URL.Query().Get("id"), "id")
+	if !ok {
+		return
+	}
+	currency := r.URL.Query().Get("currency")
+	saved, err := h.reportService.GetReportByID(r.Context(), id)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	etag := etagFor([]byte(saved.ID.String() + saved.GeneratedAt.UTC().Format(time.RFC3339Nano) + currency))
+	w.Header().Set("ETag", etag)
+	if matchesETag(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+services.ExportFilename("", saved)+`"`)
+	w.WriteHeader(http.StatusOK)
+	if _, err := h.reportService.ExportReportToWriter(r.Context(), id, "", currency, w); err != nil {
+		// Headers are already gone; all we can do is stop writing.
+		return
+	}
+}
+
+type categoryComparisonResponse struct {
+	CategoryID   string  `json:"category_id"`
+	Name         string  `json:"name,omitempty"`
+	FirstAmount  float64 `json:"first_amount"`
+	SecondAmount float64 `json:"second_amount"`
+	Delta        float64 `json:"delta"`
+}
+
+type reportComparisonResponse struct {
+	FamilyID       string                       `json:"family_id"`
+	FirstStart     string                       `json:"first_start"`
+	FirstEnd       string                       `json:"first_end"`
+	SecondStart    string                       `json:"second_start"`
+	SecondEnd      string                       `json:"second_end"`
+	FirstIncome    float64                      `json:"first_income"`
+	SecondIncome   float64                      `json:"second_income"`
+	IncomeDelta    float64                      `json:"income_delta"`
+	FirstExpenses  float64                      `json:"first_expenses"`
+	SecondExpenses float64                      `json:"second_expenses"`
+	ExpensesDelta  float64                      `json:"expenses_delta"`
+	Categories     []categoryComparisonResponse `json:"categories"`
+	BiggestMovers  []categoryComparisonResponse `json:"biggest_movers"`
+}
+
+// Compare runs the aggregates for two date ranges side by side, with
+// per-category deltas and the biggest movers between the periods.
+func (h *ReportHandler) Compare(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	parseWindow := func(startName, endName string) (time.Time, time.Time, bool) {
+		start, err := time.Parse("2006-01-02", query.Get(startName))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: startName, Message: "must be a YYYY-MM-DD date"})
+			return time.Time{}, time.Time{}, false
+		}
+		end, err := time.Parse("2006-01-02", query.Get(endName))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: endName, Message: "must be a YYYY-MM-DD date"})
+			return time.Time{}, time.Time{}, false
+		}
+		if end.Before(start) {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: endName, Message: "must not be before " + startName})
+			return time.Time{}, time.Time{}, false
+		}
+		return start, end.AddDate(0, 0, 1).Add(-time.Nanosecond), true
+	}
+	firstStart, firstEnd, ok := parseWindow("first_start", "first_end")
+	if !ok {
+		return
+	}
+	secondStart, secondEnd, ok := parseWindow("second_start", "second_end")
+	if !ok {
+		return
+	}
+
+	comparison, err := h.reportService.ComparePeriods(r.Context(), familyID,
+		firstStart, firstEnd, secondStart, secondEnd)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	toEntries := func(in []services.CategoryComparison) []categoryComparisonResponse {
+		out := make([]categoryComparisonResponse, 0, len(in))
+		for _, c := range in {
+			out = append(out, categoryComparisonResponse{
+				CategoryID:   c.CategoryID.String(),
+				Name:         c.Name,
+				FirstAmount:  c.FirstAmount,
+				SecondAmount: c.SecondAmount,
+				Delta:        c.Delta,
+			})
+		}
+		return out
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": reportComparisonResponse{
+		FamilyID:       comparison.FamilyID.String(),
+		FirstStart:     comparison.FirstStart.Format("2006-01-02"),
+		FirstEnd:       comparison.FirstEnd.Format("2006-01-02"),
+		SecondStart:    comparison.SecondStart.Format("2006-01-02"),
+		SecondEnd:      comparison.SecondEnd.Format("2006-01-02"),
+		FirstIncome:    comparison.FirstIncome,
+		SecondIncome:   comparison.SecondIncome,
+		IncomeDelta:    comparison.IncomeDelta,
+		FirstExpenses:  comparison.FirstExpenses,
+		SecondExpenses: comparison.SecondExpenses,
+		ExpensesDelta:  comparison.ExpensesDelta,
+		Categories:     toEntries(comparison.Categories),
+		BiggestMovers:  toEntries(comparison.BiggestMovers),
+	}})
+}
+
+// Generate computes a report synchronously and returns it as JSON
+// without persisting it. Expense reports return the full category and
+// transaction breakdown; the other types return the aggregate totals.
+func (h *ReportHandler) Generate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	reportType := report.Type(query.Get("type"))
+	if !reportType.Valid() {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "type", Message: "unknown report type"})
+		return
+	}
+	period := report.Period(query.Get("period"))
+	if period == "" {
+		period = report.PeriodCustom
+	}
+	if !period.Valid() {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "period", Message: "unknown report period"})
+		return
+	}
+	startDate, err := time.Parse("2006-01-02", query.Get("start_date"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "start_date", Message: "must be a YYYY-MM-DD date"})
+		return
+	}
+	endDate, err := time.Parse("2006-01-02", query.Get("end_date"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "end_date", Message: "must be a YYYY-MM-DD date"})
+		return
+	}
+	if endDate.Before(startDate) {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "end_date", Message: "must not be before start_date"})
+		return
+	}
+	// Make the end date inclusive of its whole day, matching how the web
+	// report form treats date-only input.
+	endDate = endDate.AddDate(0, 0, 1).Add(-time.Nanosecond)
+
+	if reportType == report.TypeExpenses {
+		h.generateExpenseJSON(w, r, dto.ReportRequestDTO{
+			Type:      reportType,
+			Period:    period,
+			FamilyID:  familyID,
+			StartDate: startDate,
+			EndDate:   endDate,
+		})
+		return
+	}
+
+	data, err := h.reportService.GenerateReportData(r.Context(), familyID, startDate, endDate)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": reportDataResponse{
+		FamilyID:      familyID.String(),
+		Type:          string(reportType),
+		StartDate:     startDate.Format("2006-01-02"),
+		EndDate:       endDate.Format("2006-01-02"),
+		TotalIncome:   data.TotalIncome,
+		TotalExpenses: data.TotalExpenses,
+		NetIncome:     data.NetIncome,
+	}})
+}
+
+// generateExpenseJSON renders the full expense report breakdown.
+func (h *ReportHandler) generateExpenseJSON(w http.ResponseWriter, r *http.Request, req dto.ReportRequestDTO) {
+	result, err := h.reportService.GenerateExpenseReport(r.Context(), req)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := expenseReportResponse{
+		FamilyID:      result.FamilyID.String(),
+		StartDate:     result.StartDate.Format("2006-01-02"),
+		EndDate:       result.EndDate.Format("2006-01-02"),
+		TotalExpenses: result.TotalExpenses,
+		ByCategory:    make([]categoryTotalResponse, 0, len(result.ByCategory)),
+		Transactions:  make([]reportTransactionEntry, 0, len(result.Transactions)),
+	}
+	for _, c := range result.ByCategory {
+		out.ByCategory = append(out.ByCategory, categoryTotalResponse{
+			CategoryID: c.CategoryID.String(),
+			Name:       c.Name,
+			Amount:     c.Amount,
+			Count:      c.Count,
+		})
+	}
+	for _, t := range result.Transactions {
+		out.Transactions = append(out.Transactions, reportTransactionEntry{
+			ID:          t.ID.String(),
+			Amount:      t.Amount,
+			Description: t.Description,
+			Date:        t.Date.Format("2006-01-02"),
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
@@ -0,0 +1,359 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/report"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services"
+	"family-budget-service/pkg/uuid"
+)
+
+func newReportHandlerFixture(t *testing.T) (*http.ServeMux, *services.TransactionService, *memory.UserRepository, *memory.ReportRepository) {
+	t.Helper()
+	users := memory.NewUserRepository()
+	transactions := memory.NewTransactionRepository()
+	reports := memory.NewReportRepository()
+	reportService := services.NewReportService(reports, transactions, nil, nil, users)
+	mux := http.NewServeMux()
+	NewReportHandler(reportService).Register(mux)
+	return mux, services.NewTransactionService(transactions, users), users, reports
+}
+
+func seedReportTransactions(t *testing.T, svc *services.TransactionService, familyID uuid.UUID, userID uuid.UUID) {
+	t.Helper()
+	salary := &transaction.Transaction{
+		Amount: 900, Type: transaction.TypeIncome, CategoryID: uuid.New(),
+		UserID: userID, FamilyID: familyID, Date: time.Now(),
+	}
+	groceries := &transaction.Transaction{
+		Amount: 250, Type: transaction.TypeExpense, CategoryID: uuid.New(),
+		UserID: userID, FamilyID: familyID, Date: time.Now(),
+	}
+	for _, tx := range []*transaction.Transaction{salary, groceries} {
+		if err := svc.CreateTransaction(context.Background(), tx); err != nil {
+			t.Fatalf("seed transaction: %v", err)
+		}
+	}
+}
+
+func reportWindow() string {
+	return "&start_date=" + time.Now().AddDate(0, 0, -1).Format("2006-01-02") +
+		"&end_date=" + time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+}
+
+func TestGenerateReport_ExpenseJSONShape(t *testing.T) {
+	mux, txService, users, _ := newReportHandlerFixture(t)
+	familyID := uuid.New()
+	u := seedRoleUser(t, users, familyID, user.RoleMember)
+	seedReportTransactions(t, txService, familyID, u.ID)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/api/v1/reports/generate?family_id="+familyID.String()+"&type=expenses&period=custom"+reportWindow(), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body.String())
+	}
+	var body struct {
+		Data struct {
+			TotalExpenses float64 `json:"total_expenses"`
+			ByCategory    []struct {
+				Amount float64 `json:"amount"`
+				Count  int     `json:"count"`
+			} `json:"by_category"`
+			Transactions []struct {
+				Amount float64 `json:"amount"`
+			} `json:"transactions"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if body.Data.TotalExpenses != 250 || len(body.Data.ByCategory) != 1 || len(body.Data.Transactions) != 1 {
+		t.Errorf("expense report = %+v, want 250 in one category and one transaction", body.Data)
+	}
+}
+
+func TestGenerateReport_CashFlowJSONShape(t *testing.T) {
+	mux, txService, users, _ := newReportHandlerFixture(t)
+	familyID := uuid.New()
+	u := seedRoleUser(t, users, familyID, user.RoleMember)
+	seedReportTransactions(t, txService, familyID, u.ID)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/api/v1/reports/generate?family_id="+familyID.String()+"&type=cash_flow"+reportWindow(), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body.String())
+	}
+	var body struct {
+		Data struct {
+			TotalIncome   float64 `json:"total_income"`
+			TotalExpenses float64 `json:"total_expenses"`
+			NetIncome     float64 `json:"net_income"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if body.Data.TotalIncome != 900 || body.Data.TotalExpenses != 250 || body.Data.NetIncome != 650 {
+		t.Errorf("cash flow = %+v, want 900 / 250 / 650", body.Data)
+	}
+}
+
+func TestGenerateReport_Validation(t *testing.T) {
+	mux, _, _, _ := newReportHandlerFixture(t)
+	familyID := uuid.New().String()
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/api/v1/reports/generate?family_id="+familyID+"&type=bogus"+reportWindow(), nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("unknown type status = %d, want 400", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/api/v1/reports/generate?family_id="+familyID+"&type=expenses&start_date=2024-05-10&end_date=2024-05-01", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("inverted range status = %d, want 400", rec.Code)
+	}
+}
+
+func seedSavedReport(t *testing.T, reports *memory.ReportRepository, familyID uuid.UUID, income float64) *report.Report {
+	t.Helper()
+	saved := &report.Report{
+		ID:        uuid.New(),
+		Name:      "Monthly recap",
+		Type:      report.TypeCashFlow,
+		Period:    report.PeriodMonthly,
+		FamilyID:  familyID,
+		StartDate: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC),
+		Data:      report.Data{TotalIncome: income, TotalExpenses: 100, NetIncome: income - 100},
+	}
+	if err := reports.Create(context.Background(), saved); err != nil {
+		t.Fatalf("seed report: %v", err)
+	}
+	return saved
+}
+
+// seedTypedReport saves one report with an explicit type and generation
+// time, for list filtering and paging tests.
+func seedTypedReport(t *testing.T, reports *memory.ReportRepository, familyID uuid.UUID, reportType report.Type, generatedAt time.Time) *report.Report {
+	t.Helper()
+	saved := &report.Report{
+		ID:          uuid.New(),
+		Name:        "listed",
+		Type:        reportType,
+		Period:      report.PeriodMonthly,
+		FamilyID:    familyID,
+		StartDate:   generatedAt.AddDate(0, -1, 0),
+		EndDate:     generatedAt,
+		GeneratedAt: generatedAt,
+	}
+	if err := reports.Create(context.Background(), saved); err != nil {
+		t.Fatalf("seed report: %v", err)
+	}
+	return saved
+}
+
+type reportListResponse struct {
+	Data []struct {
+		ID          string `json:"id"`
+		Type        string `json:"type"`
+		GeneratedAt string `json:"generated_at"`
+	} `json:"data"`
+	Meta struct {
+		Total  int `json:"total"`
+		Limit  int `json:"limit"`
+		Offset int `json:"offset"`
+	} `json:"meta"`
+}
+
+func decodeReportList(t *testing.T, rec *httptest.ResponseRecorder) reportListResponse {
+	t.Helper()
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body.String())
+	}
+	var body reportListResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	return body
+}
+
+func TestListReports_TypeFilterReturnsOnlyMatches(t *testing.T) {
+	mux, _, _, reports := newReportHandlerFixture(t)
+	familyID := uuid.New()
+	base := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		seedTypedReport(t, reports, familyID, report.TypeCashFlow, base.AddDate(0, 0, i))
+	}
+	for i := 0; i < 2; i++ {
+		seedTypedReport(t, reports, familyID, report.TypeExpenses, base.AddDate(0, 0, 10+i))
+	}
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/api/v1/reports?family_id="+familyID.String()+"&type=expenses", nil))
+	body := decodeReportList(t, rec)
+	if body.Meta.Total != 2 || len(body.Data) != 2 {
+		t.Fatalf("total = %d with %d rows, want 2 and 2", body.Meta.Total, len(body.Data))
+	}
+	for _, row := range body.Data {
+		if row.Type != "expenses" {
+			t.Errorf("row type = %q, want only expenses", row.Type)
+		}
+	}
+}
+
+func TestListReports_OffsetPagingReturnsCorrectPage(t *testing.T) {
+	mux, _, _, reports := newReportHandlerFixture(t)
+	familyID := uuid.New()
+	base := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+	var seeded []*report.Report
+	for i := 0; i < 5; i++ {
+		seeded = append(seeded, seedTypedReport(t, reports, familyID, report.TypeCashFlow, base.AddDate(0, 0, i)))
+	}
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/api/v1/reports?family_id="+familyID.String()+"&limit=2&offset=2", nil))
+	body := decodeReportList(t, rec)
+	if body.Meta.Total != 5 || body.Meta.Limit != 2 || body.Meta.Offset != 2 {
+		t.Fatalf("meta = %+v, want total 5 limit 2 offset 2", body.Meta)
+	}
+	if len(body.Data) != 2 {
+		t.Fatalf("rows = %d, want 2", len(body.Data))
+	}
+	// Newest first: the third and fourth newest are seeded[2] and
+	// seeded[1].
+	if body.Data[0].ID != seeded[2].ID.String() || body.Data[1].ID != seeded[1].ID.String() {
+		t.Errorf("page ids = %s, %s; want %s, %s",
+			body.Data[0].ID, body.Data[1].ID, seeded[2].ID, seeded[1].ID)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/api/v1/reports?family_id="+familyID.String()+"&limit=2&offset=10", nil))
+	body = decodeReportList(t, rec)
+	if body.Meta.Total != 5 || len(body.Data) != 0 {
+		t.Errorf("past-the-end page: total = %d with %d rows, want 5 and 0", body.Meta.Total, len(body.Data))
+	}
+}
+
+func TestRefreshReport_ReflectsNewTransactions(t *testing.T) {
+	mux, txService, users, reports := newReportHandlerFixture(t)
+	familyID := uuid.New()
+	u := seedRoleUser(t, users, familyID, user.RoleMember)
+
+	saved := &report.Report{
+		ID:        uuid.New(),
+		Name:      "refresh me",
+		Type:      report.TypeCashFlow,
+		Period:    report.PeriodCustom,
+		FamilyID:  familyID,
+		StartDate: time.Now().AddDate(0, 0, -1),
+		EndDate:   time.Now().AddDate(0, 0, 1),
+	}
+	if err := reports.Create(context.Background(), saved); err != nil {
+		t.Fatalf("seed report: %v", err)
+	}
+
+	// Transactions recorded after the original generation.
+	seedReportTransactions(t, txService, familyID, u.ID)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/api/v1/reports/refresh?id="+saved.ID.String(), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body.String())
+	}
+	var body struct {
+		Data struct {
+			TotalIncome   float64 `json:"total_income"`
+			TotalExpenses float64 `json:"total_expenses"`
+			NetIncome     float64 `json:"net_income"`
+			GeneratedAt   string  `json:"generated_at"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if body.Data.TotalIncome != 900 || body.Data.TotalExpenses != 250 || body.Data.NetIncome != 650 {
+		t.Errorf("refreshed totals = %+v, want income 900, expenses 250, net 650", body.Data)
+	}
+
+	stored, err := reports.GetByID(context.Background(), saved.ID)
+	if err != nil {
+		t.Fatalf("reload report: %v", err)
+	}
+	if stored.Data.TotalIncome != 900 {
+		t.Errorf("stored income = %v, want 900", stored.Data.TotalIncome)
+	}
+	if !stored.GeneratedAt.After(saved.GeneratedAt) {
+		t.Errorf("GeneratedAt = %v, want after original %v", stored.GeneratedAt, saved.GeneratedAt)
+	}
+}
+
+func TestGetReport_ConditionalGet(t *testing.T) {
+	mux, _, _, reports := newReportHandlerFixture(t)
+	familyID := uuid.New()
+	saved := seedSavedReport(t, reports, familyID, 1000)
+	other := seedSavedReport(t, reports, familyID, 2000)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/reports?id="+saved.ID.String(), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body.String())
+	}
+	etag := rec.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("response missing ETag")
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports?id="+saved.ID.String(), nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Errorf("conditional status = %d with %d body bytes, want empty 304", rec.Code, rec.Body.Len())
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/reports?id="+other.ID.String(), nil))
+	if otherTag := rec.Header().Get("ETag"); otherTag == etag {
+		t.Errorf("different reports share ETag %q", otherTag)
+	}
+}
+
+func TestExportReport_ConditionalGet(t *testing.T) {
+	mux, _, _, reports := newReportHandlerFixture(t)
+	saved := seedSavedReport(t, reports, uuid.New(), 1000)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/reports/export?id="+saved.ID.String(), nil))
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "text/csv" {
+		t.Fatalf("status = %d, content-type %q; want 200 text/csv", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	etag := rec.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("export missing ETag")
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/export?id="+saved.ID.String(), nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Errorf("conditional status = %d with %d body bytes, want empty 304", rec.Code, rec.Body.Len())
+	}
+}
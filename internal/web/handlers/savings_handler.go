@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"family-budget-service/internal/domain/savings"
+	"family-budget-service/internal/services"
+)
+
+// SavingsHandler serves savings-goal routes.
+type SavingsHandler struct {
+	savingsService *services.SavingsService
+}
+
+// NewSavingsHandler builds the savings handler.
+func NewSavingsHandler(savingsService *services.SavingsService) *SavingsHandler {
+	return &SavingsHandler{savingsService: savingsService}
+}
+
+// Register wires the savings routes onto mux.
+func (h *SavingsHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/savings-goals", h.goals)
+	mux.HandleFunc("/api/v1/savings-goals/progress", h.Progress)
+}
+
+// goals dispatches the goal collection routes by method.
+func (h *SavingsHandler) goals(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.Goals(w, r)
+	case http.MethodPost:
+		h.Create(w, r)
+	case http.MethodDelete:
+		h.Delete(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+	}
+}
+
+type goalResponse struct {
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	TargetAmount float64 `json:"target_amount"`
+	TargetDate   string  `json:"target_date"`
+	StartDate    string  `json:"start_date"`
+	CategoryID   string  `json:"category_id,omitempty"`
+	FamilyID     string  `json:"family_id"`
+}
+
+func toGoalResponse(g *savings.Goal) goalResponse {
+	out := goalResponse{
+		ID:           g.ID.String(),
+		Name:         g.Name,
+		TargetAmount: g.TargetAmount,
+		TargetDate:   g.TargetDate.Format("2006-01-02"),
+		StartDate:    g.StartDate.Format("2006-01-02"),
+		FamilyID:     g.FamilyID.String(),
+	}
+	if g.CategoryID != nil {
+		out.CategoryID = g.CategoryID.String()
+	}
+	return out
+}
+
+// Goals lists a family's savings goals.
+func (h *SavingsHandler) Goals(w http.ResponseWriter, r *http.Request) {
+	familyID, ok := parseUUIDParam(w, r.URL.Query().Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	goals, err := h.savingsService.GetGoalsByFamily(r.Context(), familyID)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := make([]goalResponse, 0, len(goals))
+	for _, g := range goals {
+		out = append(out, toGoalResponse(g))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
+
+// Create persists a new savings goal.
+func (h *SavingsHandler) Create(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	target, err := strconv.ParseFloat(query.Get("target_amount"), 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "target_amount", Message: "must be a number"})
+		return
+	}
+	targetDate, err := time.Parse("2006-01-02", query.Get("target_date"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "target_date", Message: "must be a YYYY-MM-DD date"})
+		return
+	}
+
+	g := &savings.Goal{
+		FamilyID:     familyID,
+		Name:         query.Get("name"),
+		TargetAmount: target,
+		TargetDate:   targetDate,
+	}
+	if raw := query.Get("category_id"); raw != "" {
+		categoryID, ok := parseUUIDParam(w, raw, "category_id")
+		if !ok {
+			return
+		}
+		g.CategoryID = &categoryID
+	}
+
+	if err := h.savingsService.CreateGoal(r.Context(), g); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]any{"data": toGoalResponse(g)})
+}
+
+// Delete removes a savings goal by ID.
+func (h *SavingsHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r.URL.Query().Get("id"), "id")
+	if !ok {
+		return
+	}
+	if err := h.savingsService.DeleteGoal(r.Context(), id); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type goalProgressResponse struct {
+	Goal                goalResponse `json:"goal"`
+	Saved               float64      `json:"saved"`
+	Percent             float64      `json:"percent"`
+	ProjectedCompletion string       `json:"projected_completion,omitempty"`
+	OnTrack             bool         `json:"on_track"`
+}
+
+// Progress reports a goal's derived progress and projected completion.
+func (h *SavingsHandler) Progress(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	id, ok := parseUUIDParam(w, r.URL.Query().Get("id"), "id")
+	if !ok {
+		return
+	}
+	progress, err := h.savingsService.GetGoalProgress(r.Context(), id, time.Now())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := goalProgressResponse{
+		Goal:    toGoalResponse(progress.Goal),
+		Saved:   progress.Saved,
+		Percent: progress.Percent,
+		OnTrack: progress.OnTrack,
+	}
+	if progress.ProjectedCompletion != nil {
+		out.ProjectedCompletion = progress.ProjectedCompletion.Format("2006-01-02")
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"family-budget-service/pkg/uuid"
+)
+
+// ErrSessionNotFound is returned when a session ID is unknown, typically
+// because the session was logged out.
+var ErrSessionNotFound = errors.New("session not found")
+
+// Session is one authenticated browser session. The CSRF token is bound
+// to the session: it is minted fresh at login, replaced whenever the
+// user's privileges change, and dies with the session at logout, so a
+// captured token cannot be replayed across sessions or after logout.
+type Session struct {
+	ID        string
+	UserID    uuid.UUID
+	CSRFToken string
+	CreatedAt time.Time
+}
+
+// SessionManager tracks sessions and their CSRF tokens in memory, like
+// the other process-local state in this service (pending email changes,
+// invitations).
+type SessionManager struct {
+	mu       sync.Mutex
+	sessions map[string]*Session
+	// byUser indexes session IDs per user so privilege changes can
+	// rotate every live session of that user.
+	byUser map[uuid.UUID]map[string]struct{}
+}
+
+// NewSessionManager builds an empty session manager.
+func NewSessionManager() *SessionManager {
+	return &SessionManager{
+		sessions: make(map[string]*Session),
+		byUser:   make(map[uuid.UUID]map[string]struct{}),
+	}
+}
+
+// Login starts a new session for the user with a freshly minted CSRF
+// token. Tokens are never reused across logins: each call produces a new
+// session ID and token pair.
+func (m *SessionManager) Login(userID uuid.UUID) (*Session, error) {
+	id, err := sessionToken()
+	if err != nil {
+		return nil, err
+	}
+	csrf, err := sessionToken()
+	if err != nil {
+		return nil, err
+	}
+	s := &Session{ID: id, UserID: userID, CSRFToken: csrf, CreatedAt: time.Now()}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sessions[id] = s
+	if m.byUser[userID] == nil {
+		m.byUser[userID] = make(map[string]struct{})
+	}
+	m.byUser[userID][id] = struct{}{}
+	copied := *s
+	return &copied, nil
+}
+
+// Logout ends a session. Its CSRF token stops validating immediately.
+func (m *SessionManager) Logout(sessionID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.sessions[sessionID]
+	if !ok {
+		return
+	}
+	delete(m.sessions, sessionID)
+	if ids := m.byUser[s.UserID]; ids != nil {
+		delete(ids, sessionID)
+		if len(ids) == 0 {
+			delete(m.byUser, s.UserID)
+		}
+	}
+}
+
+// ValidateCSRF reports whether token is the current CSRF token of the
+// session. Unknown sessions — including logged-out ones — never
+// validate. The comparison is constant-time.
+func (m *SessionManager) ValidateCSRF(sessionID, token string) bool {
+	m.mu.Lock()
+	s, ok := m.sessions[sessionID]
+	var current string
+	if ok {
+		current = s.CSRFToken
+	}
+	m.mu.Unlock()
+	if !ok || token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(current), []byte(token)) == 1
+}
+
+// RotateCSRF replaces the CSRF token of every live session of the user.
+// Call it on privilege changes (e.g. a role update) so tokens issued
+// under the old privileges stop working.
+func (m *SessionManager) RotateCSRF(userID uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id := range m.byUser[userID] {
+		csrf, err := sessionToken()
+		if err != nil {
+			return err
+		}
+		m.sessions[id].CSRFToken = csrf
+	}
+	return nil
+}
+
+// Get returns a copy of a session, or ErrSessionNotFound.
+func (m *SessionManager) Get(sessionID string) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.sessions[sessionID]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+	copied := *s
+	return &copied, nil
+}
+
+// sessionToken returns 32 random bytes hex-encoded, the format used for
+// both session IDs and CSRF tokens.
+func sessionToken() (string, error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("generate session token: %w", err)
+	}
+	return hex.EncodeToString(b[:]), nil
+}
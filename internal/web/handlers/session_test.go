@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"family-budget-service/pkg/uuid"
+)
+
+func TestSessionCSRF_ValidWithinSession(t *testing.T) {
+	sessions := NewSessionManager()
+	s, err := sessions.Login(uuid.New())
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if !sessions.ValidateCSRF(s.ID, s.CSRFToken) {
+		t.Error("token should validate within its own session")
+	}
+	if sessions.ValidateCSRF(s.ID, "forged") {
+		t.Error("forged token should not validate")
+	}
+	other, err := sessions.Login(uuid.New())
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if sessions.ValidateCSRF(other.ID, s.CSRFToken) {
+		t.Error("token is bound to its session and must not validate for another")
+	}
+}
+
+func TestSessionCSRF_InvalidAfterLogout(t *testing.T) {
+	sessions := NewSessionManager()
+	s, err := sessions.Login(uuid.New())
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	sessions.Logout(s.ID)
+	if sessions.ValidateCSRF(s.ID, s.CSRFToken) {
+		t.Error("token should stop validating after logout")
+	}
+	if _, err := sessions.Get(s.ID); err != ErrSessionNotFound {
+		t.Errorf("Get after logout error = %v, want ErrSessionNotFound", err)
+	}
+}
+
+func TestSessionCSRF_RotatedOnLogin(t *testing.T) {
+	sessions := NewSessionManager()
+	userID := uuid.New()
+	first, err := sessions.Login(userID)
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	second, err := sessions.Login(userID)
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if first.CSRFToken == second.CSRFToken {
+		t.Error("each login must mint a fresh CSRF token")
+	}
+	if sessions.ValidateCSRF(second.ID, first.CSRFToken) {
+		t.Error("the previous login's token must not validate for the new session")
+	}
+}
+
+func TestSessionCSRF_RotatedOnPrivilegeChange(t *testing.T) {
+	sessions := NewSessionManager()
+	userID := uuid.New()
+	s, err := sessions.Login(userID)
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if err := sessions.RotateCSRF(userID); err != nil {
+		t.Fatalf("RotateCSRF() error = %v", err)
+	}
+	if sessions.ValidateCSRF(s.ID, s.CSRFToken) {
+		t.Error("pre-rotation token should be rejected after a privilege change")
+	}
+	rotated, err := sessions.Get(s.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if rotated.CSRFToken == s.CSRFToken {
+		t.Error("rotation should have replaced the token")
+	}
+	if !sessions.ValidateCSRF(s.ID, rotated.CSRFToken) {
+		t.Error("the rotated token should validate")
+	}
+}
+
+func TestCSRFProtection_Middleware(t *testing.T) {
+	sessions := NewSessionManager()
+	s, err := sessions.Login(uuid.New())
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	handler := CSRFProtection(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}), sessions)
+
+	post := func(cookie, token string) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/transactions", nil)
+		if cookie != "" {
+			req.AddCookie(&http.Cookie{Name: "session_id", Value: cookie})
+		}
+		if token != "" {
+			req.Header.Set("X-CSRF-Token", token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if got := post(s.ID, s.CSRFToken); got != http.StatusNoContent {
+		t.Errorf("valid token status = %d, want %d", got, http.StatusNoContent)
+	}
+	if got := post(s.ID, ""); got != http.StatusForbidden {
+		t.Errorf("missing token status = %d, want %d", got, http.StatusForbidden)
+	}
+	if got := post("", s.CSRFToken); got != http.StatusForbidden {
+		t.Errorf("missing cookie status = %d, want %d", got, http.StatusForbidden)
+	}
+	sessions.Logout(s.ID)
+	if got := post(s.ID, s.CSRFToken); got != http.StatusForbidden {
+		t.Errorf("post-logout status = %d, want %d", got, http.StatusForbidden)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("GET status = %d, want %d (reads bypass CSRF)", rec.Code, http.StatusNoContent)
+	}
+}
@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"family-budget-service/internal/domain/preferences"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/services"
+	"family-budget-service/internal/web/validation"
+)
+
+// SettingsHandler serves the family settings routes. Everyone in the
+// family can read the settings; only admins can change them.
+type SettingsHandler struct {
+	preferencesService *services.PreferencesService
+	userService        *services.UserService
+}
+
+// NewSettingsHandler builds the settings handler.
+func NewSettingsHandler(preferencesService *services.PreferencesService, userService *services.UserService) *SettingsHandler {
+	return &SettingsHandler{preferencesService: preferencesService, userService: userService}
+}
+
+// Register wires the settings routes onto mux.
+func (h *SettingsHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/settings", h.settings)
+	mux.HandleFunc("/api/v1/settings/spending-limit", h.SetSpendingLimit)
+}
+
+// SetSpendingLimit stores the monthly spending cap of one family member.
+// Like settings updates, only family admins may set or override caps;
+// limit=0 clears the member's cap.
+func (h *SettingsHandler) SetSpendingLimit(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	userID, ok := parseUUIDParam(w, query.Get("user_id"), "user_id")
+	if !ok {
+		return
+	}
+	memberID, ok := parseUUIDParam(w, query.Get("member_id"), "member_id")
+	if !ok {
+		return
+	}
+	limit, err := strconv.ParseFloat(query.Get("limit"), 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "limit", Message: "must be a number"})
+		return
+	}
+
+	actor, err := h.userService.GetUserByID(r.Context(), userID)
+	if errors.Is(err, user.ErrNotFound) {
+		writeError(w, http.StatusNotFound, CodeNotFound, "user not found")
+		return
+	}
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	if actor.FamilyID != familyID || actor.Role != user.RoleAdmin {
+		writeError(w, http.StatusForbidden, CodeForbidden, "only family admins may set spending limits")
+		return
+	}
+
+	p, err := h.preferencesService.SetMemberSpendingLimit(r.Context(), familyID, memberID, limit)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": map[string]float64{
+		"limit": p.MemberSpendingLimits[memberID],
+	}})
+}
+
+func (h *SettingsHandler) settings(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.Settings(w, r)
+	case http.MethodPut:
+		h.UpdateSettings(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+	}
+}
+
+type settingsResponse struct {
+	Currency           string  `json:"currency"`
+	MonthlyIncomeGoal  float64 `json:"monthly_income_goal"`
+	MonthlyExpenseCap  float64 `json:"monthly_expense_cap"`
+	WeekStartDay       int     `json:"week_start_day"`
+	DashboardPeriod    string  `json:"dashboard_period"`
+	Timezone           string  `json:"timezone"`
+	AuditRetentionDays int     `json:"audit_retention_days"`
+}
+
+func toSettingsResponse(p *preferences.Preferences) settingsResponse {
+	return settingsResponse{
+		Currency:           p.Currency,
+		MonthlyIncomeGoal:  p.MonthlyIncomeGoal,
+		MonthlyExpenseCap:  p.MonthlyExpenseCap,
+		WeekStartDay:       p.WeekStartDay,
+		DashboardPeriod:    p.DashboardPeriod,
+		Timezone:           p.Timezone,
+		AuditRetentionDays: p.AuditRetentionDays,
+	}
+}
+
+// Settings returns the family's current settings.
+func (h *SettingsHandler) Settings(w http.ResponseWriter, r *http.Request) {
+	familyID, ok := parseUUIDParam(w, r.URL.Query().Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	p, err := h.preferencesService.GetPreferences(r.Context(), familyID)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": toSettingsResponse(p)})
+}
+
+// UpdateSettings stores new family settings. The acting user comes from
+// user_id and must be a family admin.
+func (h *SettingsHandler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	userID, ok := parseUUIDParam(w, query.Get("user_id"), "user_id")
+	if !ok {
+		return
+	}
+
+	actor, err := h.userService.GetUserByID(r.Context(), userID)
+	if errors.Is(err, user.ErrNotFound) {
+		writeError(w, http.StatusNotFound, CodeNotFound, "user not found")
+		return
+	}
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	if actor.FamilyID != familyID || actor.Role != user.RoleAdmin {
+		writeError(w, http.StatusForbidden, CodeForbidden, "only family admins may change settings")
+		return
+	}
+
+	settings := services.FamilySettings{
+		Currency:               query.Get("currency"),
+		DashboardPeriod:        query.Get("dashboard_period"),
+		Timezone:               query.Get("timezone"),
+		ExportFilenameTemplate: query.Get("export_template"),
+		ExcludeReimbursed:      strings.EqualFold(query.Get("exclude_reimbursed"), "true"),
+	}
+	if settings.Currency != "" {
+		if err := validation.ValidateCurrency(settings.Currency); err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "currency", Message: "must be an ISO 4217 code"})
+			return
+		}
+	}
+	if v := query.Get("income_goal"); v != "" {
+		if settings.MonthlyIncomeGoal, err = strconv.ParseFloat(v, 64); err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "income_goal", Message: "must be a number"})
+			return
+		}
+	}
+	if v := query.Get("expense_cap"); v != "" {
+		if settings.MonthlyExpenseCap, err = strconv.ParseFloat(v, 64); err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "expense_cap", Message: "must be a number"})
+			return
+		}
+	}
+	if v := query.Get("week_start"); v != "" {
+		if settings.WeekStartDay, err = strconv.Atoi(v); err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "week_start", Message: "must be an integer"})
+			return
+		}
+	}
+	if v := query.Get("audit_retention_days"); v != "" {
+		if settings.AuditRetentionDays, err = strconv.Atoi(v); err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "audit_retention_days", Message: "must be an integer"})
+			return
+		}
+	}
+
+	p, err := h.preferencesService.UpdateSettings(r.Context(), familyID, settings)
+	switch {
+	case errors.Is(err, services.ErrNegativeGoal), errors.Is(err, services.ErrInvalidWeekStart):
+		writeServiceError(w, err)
+	case err != nil:
+		writeServiceError(w, err)
+	default:
+		writeJSON(w, http.StatusOK, map[string]any{"data": toSettingsResponse(p)})
+	}
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services"
+	"family-budget-service/pkg/uuid"
+)
+
+func newSettingsFixture(t *testing.T) (*http.ServeMux, *memory.UserRepository, *services.PreferencesService) {
+	t.Helper()
+	users := memory.NewUserRepository()
+	prefs := services.NewPreferencesService(memory.NewPreferencesRepository(), memory.NewTransactionRepository())
+	mux := http.NewServeMux()
+	NewSettingsHandler(prefs, services.NewUserService(users, memory.NewReportRepository(), "")).Register(mux)
+	return mux, users, prefs
+}
+
+func seedRoleUser(t *testing.T, users *memory.UserRepository, familyID uuid.UUID, role user.Role) *user.User {
+	t.Helper()
+	u := &user.User{
+		ID: uuid.New(), Email: uuid.New().String() + "@example.com",
+		FirstName: "Pat", LastName: "Lee", Role: role, FamilyID: familyID,
+	}
+	if err := users.Create(context.Background(), u); err != nil {
+		t.Fatalf("seed user: %v", err)
+	}
+	return u
+}
+
+func TestUpdateSettings_AdminAllowed(t *testing.T) {
+	mux, users, prefs := newSettingsFixture(t)
+	familyID := uuid.New()
+	admin := seedRoleUser(t, users, familyID, user.RoleAdmin)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPut,
+		"/api/v1/settings?family_id="+familyID.String()+"&user_id="+admin.ID.String()+
+			"&currency=EUR&income_goal=4000&expense_cap=2500&week_start=1&dashboard_period=monthly", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body.String())
+	}
+
+	stored, err := prefs.GetPreferences(context.Background(), familyID)
+	if err != nil {
+		t.Fatalf("GetPreferences: %v", err)
+	}
+	if stored.Currency != "EUR" || stored.MonthlyIncomeGoal != 4000 || stored.WeekStartDay != 1 {
+		t.Errorf("stored = %+v, want the submitted settings", stored)
+	}
+}
+
+func TestUpdateSettings_MemberForbidden(t *testing.T) {
+	mux, users, prefs := newSettingsFixture(t)
+	familyID := uuid.New()
+	member := seedRoleUser(t, users, familyID, user.RoleMember)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPut,
+		"/api/v1/settings?family_id="+familyID.String()+"&user_id="+member.ID.String()+"&income_goal=1", nil))
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want 403", rec.Code)
+	}
+
+	stored, err := prefs.GetPreferences(context.Background(), familyID)
+	if err != nil {
+		t.Fatalf("GetPreferences: %v", err)
+	}
+	if stored.MonthlyIncomeGoal != 0 {
+		t.Errorf("member update persisted: %+v", stored)
+	}
+}
+
+func TestUpdateSettings_OtherFamilyAdminForbidden(t *testing.T) {
+	mux, users, _ := newSettingsFixture(t)
+	otherAdmin := seedRoleUser(t, users, uuid.New(), user.RoleAdmin)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPut,
+		"/api/v1/settings?family_id="+uuid.New().String()+"&user_id="+otherAdmin.ID.String()+"&income_goal=1", nil))
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want 403 for an admin of a different family", rec.Code)
+	}
+}
+
+func TestUpdateSettings_InvalidCurrencyRejected(t *testing.T) {
+	mux, users, _ := newSettingsFixture(t)
+	familyID := uuid.New()
+	admin := seedRoleUser(t, users, familyID, user.RoleAdmin)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPut,
+		"/api/v1/settings?family_id="+familyID.String()+"&user_id="+admin.ID.String()+"&currency=euros", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want 400 for a non ISO 4217 currency", rec.Code)
+	}
+}
+
+func TestSettings_ReadableByAnyone(t *testing.T) {
+	mux, _, prefs := newSettingsFixture(t)
+	familyID := uuid.New()
+	if _, err := prefs.UpdateSettings(context.Background(), familyID, services.FamilySettings{Currency: "USD"}); err != nil {
+		t.Fatalf("UpdateSettings: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/settings?family_id="+familyID.String(), nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200 for read access", rec.Code)
+	}
+}
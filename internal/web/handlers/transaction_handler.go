@@ -0,0 +1,549 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/services"
+	"family-budget-service/pkg/uuid"
+)
+
+// TransactionHandler serves transaction routes beyond plain CRUD.
+type TransactionHandler struct {
+	importService      *services.ImportService
+	exportService      *services.ExportService
+	transactionService *services.TransactionService
+	attachmentService  *services.AttachmentService
+}
+
+// NewTransactionHandler builds the transaction handler. attachmentService
+// may be nil when attachments are not configured; the routes then 404.
+func NewTransactionHandler(importService *services.ImportService, exportService *services.ExportService, transactionService *services.TransactionService, attachmentService *services.AttachmentService) *TransactionHandler {
+	return &TransactionHandler{
+		importService:      importService,
+		exportService:      exportService,
+		transactionService: transactionService,
+		attachmentService:  attachmentService,
+	}
+}
+
+// Register wires the transaction routes onto mux.
+func (h *TransactionHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/transactions", h.Transactions)
+	mux.HandleFunc("/api/v1/transactions/quick", h.QuickAdd)
+	mux.HandleFunc("/api/v1/transactions/import", h.ImportCSV)
+	mux.HandleFunc("/api/v1/transactions/export", h.Export)
+	mux.HandleFunc("/api/v1/transactions/bulk-categorize", h.BulkCategorize)
+	mux.HandleFunc("/api/v1/transactions/duplicates", h.Duplicates)
+	mux.HandleFunc("/api/v1/transactions/duplicates/resolve", h.ResolveDuplicates)
+	if h.attachmentService != nil {
+		mux.HandleFunc("/api/v1/transactions/attachments", h.Attachments)
+	}
+}
+
+// Transactions dispatches the collection route: POST records a
+// transaction, DELETE trashes a batch of them.
+func (h *TransactionHandler) Transactions(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		h.createTransaction(w, r)
+	case http.MethodDelete:
+		h.bulkDeleteTransactions(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+	}
+}
+
+// bulkDeleteTransactions trashes a batch of the family's transactions.
+// IDs repeat as id parameters; a single cross-family ID rejects the
+// whole batch, and affected budgets are recalculated once each.
+func (h *TransactionHandler) bulkDeleteTransactions(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	raw := query["id"]
+	if len(raw) == 0 {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "id", Message: "at least one transaction id is required"})
+		return
+	}
+	ids := make([]uuid.UUID, 0, len(raw))
+	for _, v := range raw {
+		id, ok := parseUUIDParam(w, v, "id")
+		if !ok {
+			return
+		}
+		ids = append(ids, id)
+	}
+
+	deleted, err := h.transactionService.BulkDeleteTransactions(r.Context(), familyID, ids)
+	if errors.Is(err, services.ErrCrossFamilyTransaction) {
+		writeError(w, http.StatusForbidden, CodeForbidden, err.Error())
+		return
+	}
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": map[string]int{"deleted": deleted}})
+}
+
+// createTransaction records a hand-entered transaction. All input
+// problems are collected into one field-level validation envelope
+// instead of reporting them one at a time.
+func (h *TransactionHandler) createTransaction(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var fields []FieldError
+	requireUUID := func(name string) uuid.UUID {
+		id, err := uuid.Parse(query.Get(name))
+		if err != nil {
+			fields = append(fields, FieldError{Field: name, Message: "must be a valid UUID"})
+		}
+		return id
+	}
+	familyID := requireUUID("family_id")
+	userID := requireUUID("user_id")
+	categoryID := requireUUID("category_id")
+
+	amount, err := strconv.ParseFloat(query.Get("amount"), 64)
+	if err != nil || amount <= 0 {
+		fields = append(fields, FieldError{Field: "amount", Message: "must be a positive number"})
+	}
+	txType := transaction.Type(query.Get("type"))
+	switch txType {
+	case transaction.TypeIncome, transaction.TypeExpense:
+	default:
+		fields = append(fields, FieldError{Field: "type", Message: "must be income or expense"})
+	}
+	date := time.Now()
+	if raw := query.Get("date"); raw != "" {
+		parsed, parseErr := time.Parse("2006-01-02", raw)
+		if parseErr != nil {
+			fields = append(fields, FieldError{Field: "date", Message: "must be YYYY-MM-DD"})
+		} else {
+			date = parsed
+		}
+	}
+	if len(fields) > 0 {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters", fields...)
+		return
+	}
+
+	t := &transaction.Transaction{
+		Amount:      amount,
+		Type:        txType,
+		Description: query.Get("description"),
+		CategoryID:  categoryID,
+		UserID:      userID,
+		FamilyID:    familyID,
+		Date:        date,
+	}
+	if err := h.transactionService.CreateTransaction(r.Context(), t); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]any{"data": map[string]any{
+		"id":     t.ID.String(),
+		"amount": t.Amount,
+		"type":   string(t.Type),
+		"date":   t.Date.Format("2006-01-02"),
+	}})
+}
+
+// QuickAdd records a transaction from the minimal mobile entry form:
+// amount, category and an optional note. The date defaults to today and
+// the type is inferred from the category — an income category yields an
+// income transaction — unless an explicit type parameter overrides it.
+func (h *TransactionHandler) QuickAdd(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	var fields []FieldError
+	requireUUID := func(name string) uuid.UUID {
+		id, err := uuid.Parse(query.Get(name))
+		if err != nil {
+			fields = append(fields, FieldError{Field: name, Message: "must be a valid UUID"})
+		}
+		return id
+	}
+	familyID := requireUUID("family_id")
+	userID := requireUUID("user_id")
+	categoryID := requireUUID("category_id")
+
+	amount, err := strconv.ParseFloat(query.Get("amount"), 64)
+	if err != nil || amount <= 0 {
+		fields = append(fields, FieldError{Field: "amount", Message: "must be a positive number"})
+	}
+	override := transaction.Type(query.Get("type"))
+	switch override {
+	case "", transaction.TypeIncome, transaction.TypeExpense:
+	default:
+		fields = append(fields, FieldError{Field: "type", Message: "must be income or expense"})
+	}
+	if len(fields) > 0 {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters", fields...)
+		return
+	}
+
+	t, err := h.transactionService.QuickAddTransaction(
+		r.Context(), familyID, userID, categoryID, amount, query.Get("note"), override)
+	if errors.Is(err, services.ErrCategoryNotInFamily) {
+		writeError(w, http.StatusForbidden, CodeForbidden, err.Error())
+		return
+	}
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]any{"data": map[string]any{
+		"id":     t.ID.String(),
+		"amount": t.Amount,
+		"type":   string(t.Type),
+		"date":   t.Date.Format("2006-01-02"),
+	}})
+}
+
+// attachmentResponse is the metadata returned for an uploaded receipt.
+type attachmentResponse struct {
+	ID            string `json:"id"`
+	TransactionID string `json:"transaction_id"`
+	FileName      string `json:"file_name"`
+	ContentType   string `json:"content_type"`
+	Size          int64  `json:"size"`
+}
+
+// Attachments uploads a receipt (POST) or downloads one (GET). Both
+// directions enforce family ownership.
+func (h *TransactionHandler) Attachments(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		h.uploadAttachment(w, r)
+	case http.MethodGet:
+		h.downloadAttachment(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+	}
+}
+
+func (h *TransactionHandler) uploadAttachment(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	transactionID, ok := parseUUIDParam(w, query.Get("transaction_id"), "transaction_id")
+	if !ok {
+		return
+	}
+
+	a, err := h.attachmentService.UploadAttachment(r.Context(), familyID, transactionID,
+		query.Get("filename"), r.Header.Get("Content-Type"), r.Body)
+	switch {
+	case errors.Is(err, services.ErrUnsupportedAttachmentType):
+		writeError(w, http.StatusBadRequest, CodeValidation, err.Error())
+	case errors.Is(err, services.ErrAttachmentTooLarge):
+		writeError(w, http.StatusRequestEntityTooLarge, CodeTooLarge, err.Error())
+	case errors.Is(err, services.ErrCrossFamilyTransaction):
+		writeError(w, http.StatusForbidden, CodeForbidden, err.Error())
+	case err != nil:
+		writeServiceError(w, err)
+	default:
+		writeJSON(w, http.StatusCreated, map[string]any{"data": toAttachmentResponse(a)})
+	}
+}
+
+func (h *TransactionHandler) downloadAttachment(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	attachmentID, ok := parseUUIDParam(w, query.Get("id"), "id")
+	if !ok {
+		return
+	}
+
+	a, blob, err := h.attachmentService.OpenAttachment(r.Context(), familyID, attachmentID)
+	if errors.Is(err, services.ErrCrossFamilyTransaction) {
+		writeError(w, http.StatusForbidden, CodeForbidden, err.Error())
+		return
+	}
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	defer blob.Close()
+
+	w.Header().Set("Content-Type", a.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", a.FileName))
+	_, _ = io.Copy(w, blob)
+}
+
+func toAttachmentResponse(a *transaction.Attachment) attachmentResponse {
+	return attachmentResponse{
+		ID:            a.ID.String(),
+		TransactionID: a.TransactionID.String(),
+		FileName:      a.FileName,
+		ContentType:   a.ContentType,
+		Size:          a.Size,
+	}
+}
+
+// duplicateTransactionResponse is one candidate inside a duplicate
+// group.
+type duplicateTransactionResponse struct {
+	ID          string  `json:"id"`
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description"`
+	Date        string  `json:"date"`
+	CategoryID  string  `json:"category_id"`
+}
+
+// Duplicates lists likely-duplicate transactions grouped for review.
+// window_days and distance tune the heuristic; both are optional.
+func (h *TransactionHandler) Duplicates(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	var opts services.DuplicateDetectionOptions
+	var err error
+	if v := query.Get("window_days"); v != "" {
+		if opts.DayWindow, err = strconv.Atoi(v); err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "window_days", Message: "must be an integer"})
+			return
+		}
+	}
+	if v := query.Get("distance"); v != "" {
+		if opts.MaxDescriptionDistance, err = strconv.Atoi(v); err != nil {
+			writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+				FieldError{Field: "distance", Message: "must be an integer"})
+			return
+		}
+	}
+
+	groups, err := h.transactionService.FindDuplicateTransactions(r.Context(), familyID, opts)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	out := make([][]duplicateTransactionResponse, 0, len(groups))
+	for _, group := range groups {
+		members := make([]duplicateTransactionResponse, 0, len(group.Transactions))
+		for _, t := range group.Transactions {
+			members = append(members, duplicateTransactionResponse{
+				ID:          t.ID.String(),
+				Amount:      t.Amount,
+				Description: t.Description,
+				Date:        t.Date.Format("2006-01-02"),
+				CategoryID:  t.CategoryID.String(),
+			})
+		}
+		out = append(out, members)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": map[string]any{"groups": out}})
+}
+
+// ResolveDuplicates deletes reviewed duplicates, keeping the transaction
+// named by keep. Duplicate IDs repeat as id parameters.
+func (h *TransactionHandler) ResolveDuplicates(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	keepID, ok := parseUUIDParam(w, query.Get("keep"), "keep")
+	if !ok {
+		return
+	}
+	raw := query["id"]
+	if len(raw) == 0 {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "id", Message: "at least one duplicate id is required"})
+		return
+	}
+	ids := make([]uuid.UUID, 0, len(raw))
+	for _, v := range raw {
+		id, ok := parseUUIDParam(w, v, "id")
+		if !ok {
+			return
+		}
+		ids = append(ids, id)
+	}
+
+	deleted, err := h.transactionService.ResolveDuplicates(r.Context(), familyID, keepID, ids)
+	if errors.Is(err, services.ErrCrossFamilyTransaction) {
+		writeError(w, http.StatusForbidden, CodeForbidden, err.Error())
+		return
+	}
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": map[string]int{"deleted": deleted}})
+}
+
+// BulkCategorize moves a batch of the family's transactions to one
+// category. IDs repeat as id parameters; a single cross-family ID
+// rejects the whole batch.
+func (h *TransactionHandler) BulkCategorize(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	categoryID, ok := parseUUIDParam(w, query.Get("category_id"), "category_id")
+	if !ok {
+		return
+	}
+	raw := query["id"]
+	if len(raw) == 0 {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "id", Message: "at least one transaction id is required"})
+		return
+	}
+	ids := make([]uuid.UUID, 0, len(raw))
+	for _, v := range raw {
+		id, ok := parseUUIDParam(w, v, "id")
+		if !ok {
+			return
+		}
+		ids = append(ids, id)
+	}
+
+	updated, err := h.transactionService.BulkCategorizeTransactions(r.Context(), familyID, ids, categoryID)
+	if errors.Is(err, services.ErrCrossFamilyTransaction) || errors.Is(err, services.ErrCategoryNotInFamily) {
+		writeError(w, http.StatusForbidden, CodeForbidden, err.Error())
+		return
+	}
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": map[string]int{"updated": updated}})
+}
+
+// Export streams the family's transactions in a date window as a file
+// download. Unsupported formats get a 400.
+func (h *TransactionHandler) Export(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	familyID, ok := parseUUIDParam(w, query.Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	format := query.Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	from, err := time.Parse("2006-01-02", query.Get("from"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "from", Message: "must be YYYY-MM-DD"})
+		return
+	}
+	to, err := time.Parse("2006-01-02", query.Get("to"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "to", Message: "must be YYYY-MM-DD"})
+		return
+	}
+	// The window is inclusive of the whole last day.
+	to = to.Add(24*time.Hour - time.Nanosecond)
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=%q", "transactions_"+query.Get("from")+"_"+query.Get("to")+".csv"))
+
+	_, err = h.exportService.ExportTransactions(r.Context(), familyID, format, from, to, w)
+	if errors.Is(err, services.ErrUnsupportedExportFormat) {
+		// Nothing has been written yet for a bad format: the check runs
+		// before the header row.
+		w.Header().Del("Content-Disposition")
+		writeError(w, http.StatusBadRequest, CodeValidation, err.Error())
+		return
+	}
+	if err != nil {
+		// Mid-stream failures cannot change the status line anymore.
+		return
+	}
+}
+
+type importRowResponse struct {
+	Line   int    `json:"line"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type importResultResponse struct {
+	Imported int                 `json:"imported"`
+	Skipped  int                 `json:"skipped"`
+	Rows     []importRowResponse `json:"rows"`
+}
+
+// ImportCSV ingests an uploaded CSV of transactions for the family. The
+// response lists every row with its outcome so partial imports are
+// transparent.
+func (h *TransactionHandler) ImportCSV(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	familyID, ok := parseUUIDParam(w, r.URL.Query().Get("family_id"), "family_id")
+	if !ok {
+		return
+	}
+	userID, ok := parseUUIDParam(w, r.URL.Query().Get("user_id"), "user_id")
+	if !ok {
+		return
+	}
+
+	// The middleware already caps declared lengths; this re-cap catches
+	// chunked bodies when the handler is mounted without it.
+	body := http.MaxBytesReader(w, r.Body, maxImportBodyBytes)
+	result, err := h.importService.ImportCSV(r.Context(), familyID, userID, body)
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		writeError(w, http.StatusRequestEntityTooLarge, CodeTooLarge, "import file too large")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, CodeValidation, "failed to import file")
+		return
+	}
+	out := importResultResponse{
+		Imported: result.Imported,
+		Skipped:  result.Skipped,
+		Rows:     make([]importRowResponse, 0, len(result.Rows)),
+	}
+	for _, row := range result.Rows {
+		out.Rows = append(out.Rows, importRowResponse(row))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": out})
+}
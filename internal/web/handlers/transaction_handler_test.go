@@ -0,0 +1,351 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"family-budget-service/internal/domain/budget"
+	"family-budget-service/internal/domain/category"
+	"family-budget-service/internal/domain/transaction"
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/repository/memory"
+	"family-budget-service/internal/services"
+	"family-budget-service/pkg/uuid"
+)
+
+func newExportFixture(t *testing.T) (*http.ServeMux, *memory.TransactionRepository) {
+	t.Helper()
+	users := memory.NewUserRepository()
+	transactions := memory.NewTransactionRepository()
+	categories := memory.NewCategoryRepository()
+	transactionService := services.NewTransactionService(transactions, users)
+	importService := services.NewImportService(transactionService, categories, memory.NewBudgetRepository())
+	exportService := services.NewExportService(transactions, categories, users, nil)
+	mux := http.NewServeMux()
+	NewTransactionHandler(importService, exportService, transactionService, nil).Register(mux)
+	return mux, transactions
+}
+
+func TestCreateTransaction_PersistsAndReturnsEnvelope(t *testing.T) {
+	users := memory.NewUserRepository()
+	transactions := memory.NewTransactionRepository()
+	transactionService := services.NewTransactionService(transactions, users)
+	mux := http.NewServeMux()
+	NewTransactionHandler(nil, nil, transactionService, nil).Register(mux)
+	familyID := uuid.New()
+	member := seedRoleUser(t, users, familyID, user.RoleMember)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/api/v1/transactions?family_id="+familyID.String()+
+			"&user_id="+member.ID.String()+
+			"&category_id="+uuid.New().String()+
+			"&amount=42.50&type=expense&date=2026-03-01&description=groceries", nil))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want 201, body %s", rec.Code, rec.Body.String())
+	}
+
+	var resp struct {
+		Data struct {
+			ID     string  `json:"id"`
+			Amount float64 `json:"amount"`
+			Type   string  `json:"type"`
+			Date   string  `json:"date"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if resp.Data.Amount != 42.50 || resp.Data.Type != "expense" || resp.Data.Date != "2026-03-01" {
+		t.Errorf("response data = %+v", resp.Data)
+	}
+	id, err := uuid.Parse(resp.Data.ID)
+	if err != nil {
+		t.Fatalf("response id %q is not a UUID: %v", resp.Data.ID, err)
+	}
+	stored, err := transactions.GetByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("stored transaction: %v", err)
+	}
+	if stored.Description != "groceries" || stored.FamilyID != familyID {
+		t.Errorf("stored = %+v", stored)
+	}
+}
+
+func TestTransactionExport_RowCountMatchesRange(t *testing.T) {
+	mux, transactions := newExportFixture(t)
+	familyID := uuid.New()
+
+	seed := func(date time.Time) {
+		t.Helper()
+		err := transactions.Create(context.Background(), &transaction.Transaction{
+			ID: uuid.New(), Amount: 10, Type: transaction.TypeExpense,
+			Description: "row", CategoryID: uuid.New(), UserID: uuid.New(),
+			FamilyID: familyID, Date: date,
+		})
+		if err != nil {
+			t.Fatalf("seed transaction: %v", err)
+		}
+	}
+	inRange := time.Date(2026, 2, 10, 12, 0, 0, 0, time.UTC)
+	seed(inRange)
+	seed(inRange.AddDate(0, 0, 5))
+	seed(inRange.AddDate(0, 2, 0)) // outside the window
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/api/v1/transactions/export?family_id="+familyID.String()+"&format=csv&from=2026-02-01&to=2026-02-28", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body.String())
+	}
+	if cd := rec.Header().Get("Content-Disposition"); !strings.Contains(cd, "attachment") {
+		t.Errorf("Content-Disposition = %q, want an attachment", cd)
+	}
+
+	rows, err := csv.NewReader(rec.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("parse csv: %v", err)
+	}
+	if len(rows) != 3 { // header + the two February transactions
+		t.Fatalf("rows = %d, want header plus 2 in-range transactions", len(rows))
+	}
+	if rows[0][5] != "category" || rows[0][6] != "user" {
+		t.Errorf("header = %v, want category and user columns", rows[0])
+	}
+}
+
+func TestTransactionExport_UnsupportedFormatRejected(t *testing.T) {
+	mux, _ := newExportFixture(t)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/api/v1/transactions/export?family_id="+uuid.New().String()+"&format=xlsx&from=2026-02-01&to=2026-02-28", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want 400 for xlsx", rec.Code)
+	}
+}
+
+func newBulkCategorizeFixture() (*http.ServeMux, *memory.TransactionRepository, *memory.CategoryRepository, *memory.BudgetRepository) {
+	users := memory.NewUserRepository()
+	transactions := memory.NewTransactionRepository()
+	categories := memory.NewCategoryRepository()
+	budgets := memory.NewBudgetRepository()
+	transactionService := services.NewTransactionService(transactions, users)
+	transactionService.SetBulkCategorization(categories, budgets)
+	importService := services.NewImportService(transactionService, categories, budgets)
+	exportService := services.NewExportService(transactions, categories, users, nil)
+	mux := http.NewServeMux()
+	NewTransactionHandler(importService, exportService, transactionService, nil).Register(mux)
+	return mux, transactions, categories, budgets
+}
+
+func TestBulkCategorize_AppliesBatchAndAdjustsBudget(t *testing.T) {
+	mux, transactions, categories, budgets := newBulkCategorizeFixture()
+	familyID := uuid.New()
+	oldCategory, newCategory := uuid.New(), uuid.New()
+	err := categories.Create(context.Background(), &category.Category{
+		ID: newCategory, Name: "Food", Type: category.TypeExpense, FamilyID: familyID, IsActive: true,
+	})
+	if err != nil {
+		t.Fatalf("seed category: %v", err)
+	}
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	ids := make([]string, 0, 2)
+	for i := 0; i < 2; i++ {
+		tr := &transaction.Transaction{
+			ID: uuid.New(), Amount: 50, Type: transaction.TypeExpense,
+			CategoryID: oldCategory, UserID: uuid.New(), FamilyID: familyID, Date: date,
+		}
+		if err := transactions.Create(context.Background(), tr); err != nil {
+			t.Fatalf("seed transaction: %v", err)
+		}
+		ids = append(ids, tr.ID.String())
+	}
+	b := &budget.Budget{
+		ID: uuid.New(), Name: "Food cap", Amount: 500, CategoryID: &newCategory,
+		FamilyID: familyID, IsActive: true,
+		StartDate: date.AddDate(0, 0, -9), EndDate: date.AddDate(0, 0, 21),
+	}
+	if err := budgets.Create(context.Background(), b); err != nil {
+		t.Fatalf("seed budget: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/api/v1/transactions/bulk-categorize?family_id="+familyID.String()+
+			"&category_id="+newCategory.String()+"&id="+ids[0]+"&id="+ids[1], nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), `"updated":2`) {
+		t.Errorf("body = %s, want updated count of 2", rec.Body.String())
+	}
+	reloaded, err := budgets.GetByID(context.Background(), b.ID)
+	if err != nil {
+		t.Fatalf("reload budget: %v", err)
+	}
+	if reloaded.Spent != 100 {
+		t.Errorf("budget spent = %.2f, want 100 after both moves", reloaded.Spent)
+	}
+}
+
+func TestBulkCategorize_CrossFamilyIDRejectsWholeBatch(t *testing.T) {
+	mux, transactions, categories, _ := newBulkCategorizeFixture()
+	familyID := uuid.New()
+	target := uuid.New()
+	err := categories.Create(context.Background(), &category.Category{
+		ID: target, Name: "Food", Type: category.TypeExpense, FamilyID: familyID, IsActive: true,
+	})
+	if err != nil {
+		t.Fatalf("seed category: %v", err)
+	}
+	mine := &transaction.Transaction{
+		ID: uuid.New(), Amount: 50, Type: transaction.TypeExpense,
+		CategoryID: uuid.New(), UserID: uuid.New(), FamilyID: familyID,
+	}
+	foreign := &transaction.Transaction{
+		ID: uuid.New(), Amount: 50, Type: transaction.TypeExpense,
+		CategoryID: uuid.New(), UserID: uuid.New(), FamilyID: uuid.New(),
+	}
+	for _, tr := range []*transaction.Transaction{mine, foreign} {
+		if err := transactions.Create(context.Background(), tr); err != nil {
+			t.Fatalf("seed transaction: %v", err)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost,
+		"/api/v1/transactions/bulk-categorize?family_id="+familyID.String()+
+			"&category_id="+target.String()+"&id="+mine.ID.String()+"&id="+foreign.ID.String(), nil))
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want 403, body %s", rec.Code, rec.Body.String())
+	}
+	untouched, err := transactions.GetByID(context.Background(), mine.ID)
+	if err != nil {
+		t.Fatalf("reload transaction: %v", err)
+	}
+	if untouched.CategoryID == target {
+		t.Errorf("in-family transaction was recategorized despite the batch failing")
+	}
+}
+
+func newAttachmentFixture(t *testing.T) (*http.ServeMux, *memory.TransactionRepository) {
+	t.Helper()
+	users := memory.NewUserRepository()
+	transactions := memory.NewTransactionRepository()
+	categories := memory.NewCategoryRepository()
+	transactionService := services.NewTransactionService(transactions, users)
+	attachmentService := services.NewAttachmentService(
+		memory.NewAttachmentRepository(), transactions, services.NewDiskStorage(t.TempDir()))
+	importService := services.NewImportService(transactionService, categories, memory.NewBudgetRepository())
+	exportService := services.NewExportService(transactions, categories, users, nil)
+	mux := http.NewServeMux()
+	NewTransactionHandler(importService, exportService, transactionService, attachmentService).Register(mux)
+	return mux, transactions
+}
+
+func seedAttachmentTransaction(t *testing.T, transactions *memory.TransactionRepository, familyID uuid.UUID) *transaction.Transaction {
+	t.Helper()
+	tr := &transaction.Transaction{
+		ID: uuid.New(), Amount: 25, Type: transaction.TypeExpense,
+		Description: "groceries", CategoryID: uuid.New(), UserID: uuid.New(),
+		FamilyID: familyID, Date: time.Now(),
+	}
+	if err := transactions.Create(context.Background(), tr); err != nil {
+		t.Fatalf("seed transaction: %v", err)
+	}
+	return tr
+}
+
+func TestAttachments_UploadThenDownloadRoundTrip(t *testing.T) {
+	mux, transactions := newAttachmentFixture(t)
+	familyID := uuid.New()
+	tr := seedAttachmentTransaction(t, transactions, familyID)
+
+	const receipt = "%PDF-1.4 receipt bytes"
+	req := httptest.NewRequest(http.MethodPost,
+		"/api/v1/transactions/attachments?family_id="+familyID.String()+
+			"&transaction_id="+tr.ID.String()+"&filename=receipt.pdf",
+		strings.NewReader(receipt))
+	req.Header.Set("Content-Type", "application/pdf")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("upload status = %d, body %s", rec.Code, rec.Body.String())
+	}
+	var uploaded struct {
+		Data attachmentResponse `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &uploaded); err != nil {
+		t.Fatalf("decode upload response: %v", err)
+	}
+	if uploaded.Data.TransactionID != tr.ID.String() || uploaded.Data.Size != int64(len(receipt)) {
+		t.Errorf("attachment = %+v, want reference to the transaction and the full size", uploaded.Data)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/api/v1/transactions/attachments?family_id="+familyID.String()+"&id="+uploaded.Data.ID, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("download status = %d, body %s", rec.Code, rec.Body.String())
+	}
+	if rec.Body.String() != receipt {
+		t.Errorf("downloaded bytes differ from the upload")
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/pdf" {
+		t.Errorf("Content-Type = %q, want application/pdf", ct)
+	}
+}
+
+func TestAttachments_CrossFamilyDownloadRejected(t *testing.T) {
+	mux, transactions := newAttachmentFixture(t)
+	familyID := uuid.New()
+	tr := seedAttachmentTransaction(t, transactions, familyID)
+
+	req := httptest.NewRequest(http.MethodPost,
+		"/api/v1/transactions/attachments?family_id="+familyID.String()+
+			"&transaction_id="+tr.ID.String()+"&filename=receipt.png",
+		strings.NewReader("png bytes"))
+	req.Header.Set("Content-Type", "image/png")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("upload status = %d, body %s", rec.Code, rec.Body.String())
+	}
+	var uploaded struct {
+		Data attachmentResponse `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &uploaded); err != nil {
+		t.Fatalf("decode upload response: %v", err)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/api/v1/transactions/attachments?family_id="+uuid.New().String()+"&id="+uploaded.Data.ID, nil))
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want 403 for another family", rec.Code)
+	}
+}
+
+func TestAttachments_RejectsUnsupportedType(t *testing.T) {
+	mux, transactions := newAttachmentFixture(t)
+	familyID := uuid.New()
+	tr := seedAttachmentTransaction(t, transactions, familyID)
+
+	req := httptest.NewRequest(http.MethodPost,
+		"/api/v1/transactions/attachments?family_id="+familyID.String()+
+			"&transaction_id="+tr.ID.String()+"&filename=run.exe",
+		strings.NewReader("MZ"))
+	req.Header.Set("Content-Type", "application/octet-stream")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want 400 for a non-image upload", rec.Code)
+	}
+}
@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"net/http"
+
+	"family-budget-service/internal/domain/user"
+	"family-budget-service/internal/services"
+)
+
+// UserHandler serves account routes: email change with verification.
+type UserHandler struct {
+	userService *services.UserService
+}
+
+// NewUserHandler builds the user handler.
+func NewUserHandler(userService *services.UserService) *UserHandler {
+	return &UserHandler{userService: userService}
+}
+
+// Register wires the user routes onto mux.
+func (h *UserHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/users/email-change", h.RequestEmailChange)
+	mux.HandleFunc("/api/v1/users/email-change/confirm", h.ConfirmEmailChange)
+	mux.HandleFunc("/api/v1/users/invitations", h.Invitations)
+	mux.HandleFunc("/api/v1/users/invitations/accept", h.AcceptInvitation)
+}
+
+// RequestEmailChange starts an email change for a user. The current
+// email stays active until the verification token sent to the new
+// address is confirmed.
+func (h *UserHandler) RequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	userID, ok := parseUUIDParam(w, query.Get("user_id"), "user_id")
+	if !ok {
+		return
+	}
+	email := query.Get("email")
+	if email == "" {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "email", Message: "new email is required"})
+		return
+	}
+
+	if _, err := h.userService.RequestEmailChange(r.Context(), userID, email); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusAccepted, map[string]any{"data": map[string]string{
+		"status": "verification_sent",
+	}})
+}
+
+// Invitations dispatches invitation management: POST creates an invite,
+// DELETE revokes an outstanding one.
+func (h *UserHandler) Invitations(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		h.createInvitation(w, r)
+	case http.MethodDelete:
+		h.revokeInvitation(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+	}
+}
+
+// createInvitation lets a family admin invite an email with a role. The
+// invitee receives a token and sets their own password on accept.
+func (h *UserHandler) createInvitation(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	adminID, ok := parseUUIDParam(w, query.Get("admin_id"), "admin_id")
+	if !ok {
+		return
+	}
+	email := query.Get("email")
+	if email == "" {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "email", Message: "invitee email is required"})
+		return
+	}
+
+	_, err := h.userService.InviteFamilyMember(r.Context(), adminID, email, user.Role(query.Get("role")))
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusAccepted, map[string]any{"data": map[string]string{
+		"status": "invitation_sent",
+	}})
+}
+
+// revokeInvitation withdraws an invitation by its token.
+func (h *UserHandler) revokeInvitation(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "token", Message: "token is required"})
+		return
+	}
+	if err := h.userService.RevokeInvitation(r.Context(), token); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": map[string]string{
+		"status": "revoked",
+	}})
+}
+
+// AcceptInvitation redeems an invitation token and creates the member.
+func (h *UserHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	token := query.Get("token")
+	password := query.Get("password")
+	var fields []FieldError
+	if token == "" {
+		fields = append(fields, FieldError{Field: "token", Message: "token is required"})
+	}
+	if password == "" {
+		fields = append(fields, FieldError{Field: "password", Message: "password is required"})
+	}
+	if len(fields) > 0 {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters", fields...)
+		return
+	}
+
+	u, err := h.userService.AcceptInvitation(r.Context(), token,
+		query.Get("first_name"), query.Get("last_name"), password)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]any{"data": map[string]string{
+		"user_id":   u.ID.String(),
+		"email":     u.Email,
+		"role":      string(u.Role),
+		"family_id": u.FamilyID.String(),
+	}})
+}
+
+// ConfirmEmailChange applies a pending email change by its token.
+func (h *UserHandler) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, CodeValidation, "method not allowed")
+		return
+	}
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		writeError(w, http.StatusBadRequest, CodeValidation, "invalid request parameters",
+			FieldError{Field: "token", Message: "token is required"})
+		return
+	}
+
+	u, err := h.userService.ConfirmEmailChange(r.Context(), token)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": map[string]string{
+		"user_id": u.ID.String(),
+		"email":   u.Email,
+	}})
+}
@@ -0,0 +1,110 @@
+// Package web runs the HTTP server in front of the handlers package.
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"family-budget-service/internal/config"
+)
+
+// DefaultShutdownTimeout bounds how long shutdown waits for in-flight
+// requests when no timeout is configured.
+const DefaultShutdownTimeout = 10 * time.Second
+
+// Default connection timeouts, applied when the corresponding config
+// value is zero. They keep slow-loris clients and hung connections from
+// pinning server resources indefinitely.
+const (
+	DefaultReadHeaderTimeout = 5 * time.Second
+	DefaultReadTimeout       = 15 * time.Second
+	DefaultWriteTimeout      = 30 * time.Second
+	DefaultIdleTimeout       = 60 * time.Second
+)
+
+// Server wraps http.Server with graceful shutdown: once told to stop it
+// closes the listener so new connections are refused, then drains
+// in-flight requests up to a configurable timeout.
+type Server struct {
+	httpServer      *http.Server
+	listener        net.Listener
+	shutdownTimeout time.Duration
+	serveErr        chan error
+}
+
+// NewServer builds a server from the web configuration. Zero timeouts
+// fall back to the package defaults above.
+func NewServer(cfg config.WebConfig, handler http.Handler) *Server {
+	return &Server{
+		httpServer: &http.Server{
+			Addr:              net.JoinHostPort(cfg.Host, cfg.Port),
+			Handler:           handler,
+			ReadHeaderTimeout: timeoutOrDefault(cfg.ReadHeaderTimeout, DefaultReadHeaderTimeout),
+			ReadTimeout:       timeoutOrDefault(cfg.ReadTimeout, DefaultReadTimeout),
+			WriteTimeout:      timeoutOrDefault(cfg.WriteTimeout, DefaultWriteTimeout),
+			IdleTimeout:       timeoutOrDefault(cfg.IdleTimeout, DefaultIdleTimeout),
+		},
+		shutdownTimeout: timeoutOrDefault(cfg.ShutdownTimeout, DefaultShutdownTimeout),
+		serveErr:        make(chan error, 1),
+	}
+}
+
+// timeoutOrDefault substitutes fallback for unset (non-positive) config
+// values.
+func timeoutOrDefault(v, fallback time.Duration) time.Duration {
+	if v <= 0 {
+		return fallback
+	}
+	return v
+}
+
+// Start binds the listener and begins serving in the background. After
+// Start returns, Addr reports the bound address (useful with port 0).
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.httpServer.Addr)
+	if err != nil {
+		return fmt.Errorf("bind %s: %w", s.httpServer.Addr, err)
+	}
+	s.listener = listener
+	go func() {
+		if err := s.httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.serveErr <- err
+		}
+	}()
+	return nil
+}
+
+// Addr is the listener's address; empty before Start.
+func (s *Server) Addr() string {
+	if s.listener == nil {
+		return ""
+	}
+	return s.listener.Addr().String()
+}
+
+// WaitForShutdown blocks until ctx is canceled or SIGTERM/SIGINT
+// arrives, then shuts the server down: the listener closes immediately
+// and in-flight requests get up to the shutdown timeout to finish.
+func (s *Server) WaitForShutdown(ctx context.Context) error {
+	sigCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	select {
+	case err := <-s.serveErr:
+		return fmt.Errorf("serve: %w", err)
+	case <-sigCtx.Done():
+	}
+
+	drainCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+	if err := s.httpServer.Shutdown(drainCtx); err != nil {
+		return fmt.Errorf("shutdown: %w", err)
+	}
+	return nil
+}
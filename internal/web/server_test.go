@@ -0,0 +1,60 @@
+package web
+
+import (
+	"testing"
+	"time"
+
+	"family-budget-service/internal/config"
+)
+
+func TestNewServer_AppliesConfiguredTimeouts(t *testing.T) {
+	cfg := config.WebConfig{
+		Host:              "127.0.0.1",
+		Port:              "8080",
+		ReadTimeout:       3 * time.Second,
+		WriteTimeout:      7 * time.Second,
+		IdleTimeout:       90 * time.Second,
+		ReadHeaderTimeout: 2 * time.Second,
+		ShutdownTimeout:   4 * time.Second,
+	}
+	s := NewServer(cfg, nil)
+
+	if got := s.httpServer.Addr; got != "127.0.0.1:8080" {
+		t.Errorf("Addr = %q, want %q", got, "127.0.0.1:8080")
+	}
+	if got := s.httpServer.ReadTimeout; got != cfg.ReadTimeout {
+		t.Errorf("ReadTimeout = %v, want %v", got, cfg.ReadTimeout)
+	}
+	if got := s.httpServer.WriteTimeout; got != cfg.WriteTimeout {
+		t.Errorf("WriteTimeout = %v, want %v", got, cfg.WriteTimeout)
+	}
+	if got := s.httpServer.IdleTimeout; got != cfg.IdleTimeout {
+		t.Errorf("IdleTimeout = %v, want %v", got, cfg.IdleTimeout)
+	}
+	if got := s.httpServer.ReadHeaderTimeout; got != cfg.ReadHeaderTimeout {
+		t.Errorf("ReadHeaderTimeout = %v, want %v", got, cfg.ReadHeaderTimeout)
+	}
+	if got := s.shutdownTimeout; got != cfg.ShutdownTimeout {
+		t.Errorf("shutdownTimeout = %v, want %v", got, cfg.ShutdownTimeout)
+	}
+}
+
+func TestNewServer_ZeroTimeoutsFallBackToDefaults(t *testing.T) {
+	s := NewServer(config.WebConfig{Host: "127.0.0.1", Port: "0"}, nil)
+
+	if got := s.httpServer.ReadTimeout; got != DefaultReadTimeout {
+		t.Errorf("ReadTimeout = %v, want default %v", got, DefaultReadTimeout)
+	}
+	if got := s.httpServer.WriteTimeout; got != DefaultWriteTimeout {
+		t.Errorf("WriteTimeout = %v, want default %v", got, DefaultWriteTimeout)
+	}
+	if got := s.httpServer.IdleTimeout; got != DefaultIdleTimeout {
+		t.Errorf("IdleTimeout = %v, want default %v", got, DefaultIdleTimeout)
+	}
+	if got := s.httpServer.ReadHeaderTimeout; got != DefaultReadHeaderTimeout {
+		t.Errorf("ReadHeaderTimeout = %v, want default %v", got, DefaultReadHeaderTimeout)
+	}
+	if got := s.shutdownTimeout; got != DefaultShutdownTimeout {
+		t.Errorf("shutdownTimeout = %v, want default %v", got, DefaultShutdownTimeout)
+	}
+}
@@ -0,0 +1,112 @@
+// Package validation holds input checks shared by web handlers.
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// ErrInvalidCurrency is returned for values that are not ISO 4217 codes.
+var ErrInvalidCurrency = errors.New("invalid currency code")
+
+// ValidateCurrency checks that code looks like an ISO 4217 currency code:
+// exactly three uppercase ASCII letters. Validity of the code against the
+// full ISO table is left to the client's picker.
+func ValidateCurrency(code string) error {
+	if len(code) != 3 {
+		return fmt.Errorf("%w: %q", ErrInvalidCurrency, code)
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return fmt.Errorf("%w: %q", ErrInvalidCurrency, code)
+		}
+	}
+	return nil
+}
+
+// ErrInvalidHexColor is returned for values that are not hex color codes.
+var ErrInvalidHexColor = errors.New("invalid hex color")
+
+// ValidateHexColor checks that color is a 3- or 6-digit hex code with a
+// leading '#', e.g. "#F53" or "#FF5733". Both cases are accepted.
+func ValidateHexColor(color string) error {
+	if len(color) != 4 && len(color) != 7 {
+		return fmt.Errorf("%w: %q", ErrInvalidHexColor, color)
+	}
+	if color[0] != '#' {
+		return fmt.Errorf("%w: %q", ErrInvalidHexColor, color)
+	}
+	for _, c := range color[1:] {
+		switch {
+		case c >= '0' && c <= '9', c >= 'a' && c <= 'f', c >= 'A' && c <= 'F':
+		default:
+			return fmt.Errorf("%w: %q", ErrInvalidHexColor, color)
+		}
+	}
+	return nil
+}
+
+// ErrInvalidCategoryIcon is returned for icons outside CategoryIcons.
+var ErrInvalidCategoryIcon = errors.New("invalid category icon")
+
+// CategoryIcons is the curated set of icon identifiers categories may
+// use. The web form renders its icon picker from this same slice so the
+// picker and the validator cannot drift apart.
+var CategoryIcons = []string{
+	"bolt",
+	"book",
+	"bus",
+	"car",
+	"child",
+	"coins",
+	"dollar-sign",
+	"file-invoice-dollar",
+	"film",
+	"gift",
+	"graduation-cap",
+	"heartbeat",
+	"home",
+	"laptop",
+	"money-bill",
+	"paw",
+	"plane",
+	"shield-alt",
+	"shopping-cart",
+	"tshirt",
+	"utensils",
+	"wrench",
+}
+
+// ValidateCategoryIcon checks that icon is one of the allow-listed
+// identifiers in CategoryIcons.
+func ValidateCategoryIcon(icon string) error {
+	for _, known := range CategoryIcons {
+		if icon == known {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q", ErrInvalidCategoryIcon, icon)
+}
+
+// ErrInvalidEmail is returned for values that do not look like email
+// addresses.
+var ErrInvalidEmail = errors.New("invalid email address")
+
+// SanitizeEmail normalizes an email for storage and comparison: trimmed
+// and lowercased, so "  Alice@Example.COM " and "alice@example.com" are
+// the same account.
+func SanitizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// ValidateEmail checks that email is a plain RFC 5322 address without a
+// display name.
+func ValidateEmail(email string) error {
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return fmt.Errorf("%w: %q", ErrInvalidEmail, email)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateHexColor(t *testing.T) {
+	tests := []struct {
+		color string
+		ok    bool
+	}{
+		{"#FF5733", true},
+		{"#ff5733", true},
+		{"#F53", true},
+		{"", false},
+		{"FF5733", false}, // missing '#'
+		{"#FF573", false}, // 5 digits
+		{"#GG5733", false},
+		{"#FF 733", false},
+	}
+	for _, tt := range tests {
+		err := ValidateHexColor(tt.color)
+		if tt.ok && err != nil {
+			t.Errorf("ValidateHexColor(%q) = %v, want nil", tt.color, err)
+		}
+		if !tt.ok && !errors.Is(err, ErrInvalidHexColor) {
+			t.Errorf("ValidateHexColor(%q) = %v, want ErrInvalidHexColor", tt.color, err)
+		}
+	}
+}
+
+func TestValidateCategoryIcon(t *testing.T) {
+	if err := ValidateCategoryIcon("utensils"); err != nil {
+		t.Errorf("known icon rejected: %v", err)
+	}
+	for _, icon := range []string{"", "💰", "sparkle-unicorn"} {
+		if err := ValidateCategoryIcon(icon); !errors.Is(err, ErrInvalidCategoryIcon) {
+			t.Errorf("ValidateCategoryIcon(%q) = %v, want ErrInvalidCategoryIcon", icon, err)
+		}
+	}
+}
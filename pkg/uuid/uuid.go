@@ -0,0 +1,82 @@
+// Package uuid provides RFC 4122 version 4 UUIDs without external
+// dependencies. Only the operations the service actually needs are
+// implemented: generation, parsing, string formatting and text
+// (un)marshalling for JSON payloads.
+package uuid
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// UUID is a 128-bit universally unique identifier.
+type UUID [16]byte
+
+// Nil is the zero-value UUID, used to mark "no reference".
+var Nil UUID
+
+// ErrInvalidFormat is returned by Parse for malformed input.
+var ErrInvalidFormat = errors.New("uuid: invalid format")
+
+// New returns a random (version 4, variant 10) UUID.
+func New() UUID {
+	var u UUID
+	if _, err := rand.Read(u[:]); err != nil {
+		// crypto/rand never fails on supported platforms; if it does
+		// the process cannot safely continue generating identifiers.
+		panic(fmt.Sprintf("uuid: rand.Read: %v", err))
+	}
+	u[6] = (u[6] & 0x0f) | 0x40
+	u[8] = (u[8] & 0x3f) | 0x80
+	return u
+}
+
+// IsNil reports whether u is the zero UUID.
+func (u UUID) IsNil() bool { return u == Nil }
+
+// String renders the canonical 8-4-4-4-12 lower-case form.
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:36], u[10:16])
+	return string(buf[:])
+}
+
+// Parse reads the canonical 36-character form produced by String.
+func Parse(s string) (UUID, error) {
+	var u UUID
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return Nil, ErrInvalidFormat
+	}
+	groups := []struct{ from, to, at int }{
+		{0, 8, 0}, {9, 13, 4}, {14, 18, 6}, {19, 23, 8}, {24, 36, 10},
+	}
+	for _, g := range groups {
+		if _, err := hex.Decode(u[g.at:], []byte(s[g.from:g.to])); err != nil {
+			return Nil, ErrInvalidFormat
+		}
+	}
+	return u, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u UUID) MarshalText() ([]byte, error) { return []byte(u.String()), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *UUID) UnmarshalText(b []byte) error {
+	parsed, err := Parse(string(b))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}